@@ -21,10 +21,12 @@ package v1alpha1
 
 //nolint:gci
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -40,8 +42,46 @@ const (
 	DefaultLabelValue = "llama-stack"
 	// DefaultMountPath is the default mount path for storage
 	DefaultMountPath = "/.llama"
+	// DefaultHealthCheckPath is the default path of the server health endpoint
+	DefaultHealthCheckPath = "/v1/health"
+	// DefaultMetricsPath is the default path of the server metrics scraped by the ServiceMonitor
+	DefaultMetricsPath = "/metrics"
+	// DefaultIngressPath is the default HTTP path prefix routed to the server by the Ingress
+	DefaultIngressPath = "/"
+	// DefaultHealthCheckTimeoutSeconds is the default timeout of a single health check
+	DefaultHealthCheckTimeoutSeconds int32 = 5
 	// LlamaStackDistributionKind is the kind name for LlamaStackDistribution resources
 	LlamaStackDistributionKind = "LlamaStackDistribution"
+	// ReferencePurposeUserConfig marks a reference to the user-provided run configuration
+	ReferencePurposeUserConfig = "UserConfig"
+	// ReferencePurposeCABundle marks a reference to a CA bundle
+	ReferencePurposeCABundle = "CABundle"
+	// ReferencePurposeAdditionalUserConfig marks a reference to a ConfigMap mounted next to the run configuration
+	ReferencePurposeAdditionalUserConfig = "AdditionalUserConfig"
+	// ReferencePurposeMountedUserConfig marks a reference to a ConfigMap of UserConfigs mounted at its own path
+	ReferencePurposeMountedUserConfig = "MountedUserConfig"
+	// ReferencePurposeUserSecret marks a reference to the Secret whose changes restart the server pods
+	ReferencePurposeUserSecret = "UserSecret"
+	// ReferencePurposeServerCert marks a reference to the Secret holding the certificate served by the server
+	ReferencePurposeServerCert = "ServerCert"
+	// AdoptExistingAnnotation requests that existing resources with the generated names are taken over by the instance
+	AdoptExistingAnnotation = "llamastack.io/adopt-existing"
+	// PVCProtectionFinalizer holds the deletion of an instance with storage until its PVC is deleted or retained
+	PVCProtectionFinalizer = "llama.x-k8s.io/pvc-protection"
+	// ClusterResourceCleanupFinalizer holds the deletion of an instance until the cluster-scoped resources created
+	// for it, which cannot be owned by a namespaced instance, are deleted
+	ClusterResourceCleanupFinalizer = "llama.x-k8s.io/cluster-resource-cleanup"
+	// InstanceNameLabel records the name of the instance a cluster-scoped resource was created for
+	InstanceNameLabel = "llamastack.io/instance-name"
+	// InstanceNamespaceLabel records the namespace of the instance a cluster-scoped resource was created for
+	InstanceNamespaceLabel = "llamastack.io/instance-namespace"
+	// RetainPVCAnnotation requests that the PVC is kept when the instance is deleted
+	RetainPVCAnnotation = "llama.x-k8s.io/retain-pvc"
+	// AcknowledgeStorageChangeAnnotation allows switching the storage between emptyDir and a PVC without migrating the
+	// data. Its value names the switch: emptyDir->pvc or pvc->emptyDir
+	AcknowledgeStorageChangeAnnotation = "llamastack.io/acknowledge-storage-change"
+	// ReconcilePausedAnnotation suspends the reconciliation of the instance while set to "true"
+	ReconcilePausedAnnotation = "llama.x-k8s.io/reconcile-paused"
 )
 
 // DefaultStorageSize is the default size for persistent storage
@@ -69,6 +109,70 @@ type LlamaStackDistributionSpec struct {
 	// +kubebuilder:default:=1
 	Replicas int32      `json:"replicas,omitempty"`
 	Server   ServerSpec `json:"server"`
+	// Autoscaling scales the server with a HorizontalPodAutoscaler. Replicas is ignored while it is set, and the
+	// replica count of the Deployment is left to the HorizontalPodAutoscaler as with the External policy
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+	// ReplicaManagementPolicy selects who owns the replica count of the server Deployment. With External,
+	// Replicas only sets the initial count and the Deployment may be scaled by another controller, e.g. KEDA
+	// (defaults to Managed)
+	// +optional
+	// +kubebuilder:default:=Managed
+	ReplicaManagementPolicy ReplicaManagementPolicy `json:"replicaManagementPolicy,omitempty"`
+	// DisruptionBudget limits the voluntary disruptions of the server pods, e.g. during node drains.
+	// Without it, a PodDisruptionBudget keeping one pod available is created when more than one replica runs
+	// +optional
+	DisruptionBudget *PodDisruptionBudgetSpec `json:"disruptionBudget,omitempty"`
+	// Labels are added to all the resources created for the distribution and to the server pods, e.g. for
+	// cost allocation. Labels managed by the operator take precedence
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to all the resources created for the distribution. Annotations managed by the
+	// operator or set for a specific resource, such as the Service annotations, take precedence
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// InitContainerImage runs the init containers of the operator for this distribution, such as the one fixing
+	// the PVC permissions, e.g. from a mirror in air-gapped clusters. It takes precedence over the utilityImage of
+	// the operator ConfigMap
+	// +optional
+	InitContainerImage string `json:"initContainerImage,omitempty"`
+}
+
+// PodDisruptionBudgetSpec defines the PodDisruptionBudget of the llama-stack server pods
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable are mutually exclusive"
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of server pods that must stay available during a disruption
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of server pods that may be unavailable during a disruption
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// AutoscalingSpec defines the HorizontalPodAutoscaler scaling the llama-stack server
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+type AutoscalingSpec struct {
+	// MinReplicas is the lower limit of the number of replicas (defaults to 1)
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit of the number of replicas
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of the server pods,
+	// relative to their CPU requests. The HorizontalPodAutoscaler defaults to 80 if no target is set
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// TargetMemoryUtilizationPercentage is the target average memory utilization of the server pods,
+	// relative to their memory requests
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	// Metrics are additional metrics of the HorizontalPodAutoscaler, such as Pods or External metrics
+	// served by a custom metrics adapter. They are combined with the utilization targets
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
 }
 
 // ServerSpec defines the desired state of llama server.
@@ -76,15 +180,290 @@ type ServerSpec struct {
 	Distribution  DistributionType `json:"distribution"`
 	ContainerSpec ContainerSpec    `json:"containerSpec,omitempty"`
 	PodOverrides  *PodOverrides    `json:"podOverrides,omitempty"` // Optional pod-level overrides
+	// ImagePullSecrets are the Secrets, in the same namespace as the CR, used to pull the images of the server pods
+	// from private registries
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// NodeSelector constrains the server pods to nodes with matching labels, e.g. a GPU node type.
+	// The NodeSelector of the pod overrides takes precedence
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the server pods to be scheduled on nodes with matching taints.
+	// The Tolerations of the pod overrides take precedence
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity defines the node and pod affinity rules of the server pods. Without it or TopologySpreadConstraints,
+	// the pods of a server running more than one replica prefer to be scheduled on different nodes.
+	// The Affinity of the pod overrides takes precedence
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints spread the server pods across topology domains, e.g. zones. They replace the
+	// default anti-affinity and zone spread of a server running more than one replica.
+	// The TopologySpreadConstraints of the pod overrides take precedence
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// RuntimeClassName selects the RuntimeClass of the server pods, e.g. nvidia on clusters with the NVIDIA GPU operator
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// SchedulerName selects the scheduler of the server pods, e.g. a GPU-aware scheduler. The default scheduler is
+	// used when unset
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+	// DeploymentLabels are added to the Deployment of the llama-stack server, next to the labels of spec.labels.
+	// Labels managed by the operator take precedence
+	// +optional
+	DeploymentLabels map[string]string `json:"deploymentLabels,omitempty"`
+	// PodAnnotations are added to the server pods, e.g. to enable sidecar injection. Annotations managed by the
+	// operator, such as the configuration hashes, take precedence
+	// +optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
 	// Storage defines the persistent storage configuration
 	// +optional
 	Storage *StorageSpec `json:"storage,omitempty"`
-	// UserConfig defines the user configuration for the llama-stack server
+	// UserConfig defines the user configuration for the llama-stack server.
+	// Deprecated: use UserConfigs, whose first entry holds the run configuration when UserConfig is not set
 	// +optional
 	UserConfig *UserConfigSpec `json:"userConfig,omitempty"`
+	// UserConfigs are ConfigMaps mounted into the server container, each at its own MountPath, e.g. to split the
+	// model definitions from the run configuration. Without UserConfig, the first entry holds the run configuration
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	UserConfigs []UserConfigSpec `json:"userConfigs,omitempty"`
+	// SecretRef identifies a Secret, such as the one holding the credentials read by the environment variables,
+	// whose changes restart the server pods
+	// +optional
+	SecretRef *SecretRefSpec `json:"secretRef,omitempty"`
 	// TLSConfig defines the TLS configuration for the llama-stack server
 	// +optional
 	TLSConfig *TLSConfig `json:"tlsConfig,omitempty"`
+	// Queue defines the Kueue queue used for admission of the server pods
+	// +optional
+	Queue *QueueSpec `json:"queue,omitempty"`
+	// Monitoring defines the monitoring integrations for the llama-stack server
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+	// HealthCheck overrides the endpoint used to check the health of the llama-stack server
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+	// MaintenanceJobs defines scheduled jobs run against the storage volume of the llama-stack server,
+	// e.g. to pre-warm or prune the model cache
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	MaintenanceJobs []MaintenanceJobSpec `json:"maintenanceJobs,omitempty"`
+	// Ingress exposes the llama-stack server outside of the cluster through an Ingress,
+	// or an OpenShift Route when the Route API is available
+	// +optional
+	Ingress *IngressSpec `json:"ingress,omitempty"`
+	// Route exposes the server through an OpenShift Route. It requires a cluster serving the OpenShift Route API
+	// and cannot be enabled together with the Ingress, which is exposed through a Route on such clusters already
+	// +optional
+	Route *RouteSpec `json:"route,omitempty"`
+	// Rollout configures how updates of the server pods are rolled out
+	// +optional
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
+	// Service overrides the type, annotations and session affinity of the Service of the llama-stack server
+	// +optional
+	Service *ServiceOverrides `json:"service,omitempty"`
+	// NetworkPolicy extends the NetworkPolicy created when the NetworkPolicy feature is enabled
+	// +optional
+	NetworkPolicy *NetworkPolicySpec `json:"networkPolicy,omitempty"`
+}
+
+// NetworkPolicySpec defines the traffic allowed by the NetworkPolicy of the llama-stack server
+type NetworkPolicySpec struct {
+	// EgressRules restricts the outbound traffic of the server pods to the listed destinations, e.g. the
+	// inference backends. DNS lookups stay allowed. Outbound traffic is not restricted when empty
+	// +optional
+	EgressRules []EgressRuleSpec `json:"egressRules,omitempty"`
+}
+
+// EgressRuleSpec defines a destination the server pods may connect to
+type EgressRuleSpec struct {
+	// Namespace is the namespace of the destination pods. Without Namespace and PodSelector, any
+	// destination is allowed, including addresses outside of the cluster
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// PodSelector selects the destination pods by their labels, in the namespace of the
+	// LlamaStackDistribution unless Namespace is set
+	// +optional
+	PodSelector map[string]string `json:"podSelector,omitempty"`
+	// Port is the destination port (defaults to all ports)
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// Protocol is the protocol of the destination port (defaults to TCP)
+	// +optional
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+}
+
+// ServiceOverrides defines how the Service of the llama-stack server is exposed
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerClass) || self.type == 'LoadBalancer'",message="loadBalancerClass requires type LoadBalancer"
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancerSourceRanges) || self.type == 'LoadBalancer'",message="loadBalancerSourceRanges requires type LoadBalancer"
+type ServiceOverrides struct {
+	// Type is the type of the Service (defaults to ClusterIP)
+	// +optional
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	Type corev1.ServiceType `json:"type,omitempty"`
+	// Annotations are added to the Service, e.g. to configure a cloud provider load balancer
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// LoadBalancerClass selects the load balancer implementation of a LoadBalancer Service
+	// +optional
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+	// LoadBalancerSourceRanges restricts the client CIDRs allowed to reach a LoadBalancer Service,
+	// when supported by the cloud provider
+	// +optional
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+	// SessionAffinity routes the requests of a client to the same server pod when set to ClientIP
+	// (defaults to None)
+	// +optional
+	// +kubebuilder:validation:Enum=None;ClientIP
+	SessionAffinity corev1.ServiceAffinity `json:"sessionAffinity,omitempty"`
+}
+
+// RolloutSpec defines how updates of the server pods are rolled out
+type RolloutSpec struct {
+	// AutoRollback reverts the Deployment to the last pod template that completed a rollout
+	// when the rollout of a new pod template exceeds its progress deadline
+	// +optional
+	AutoRollback bool `json:"autoRollback,omitempty"`
+	// ProgressDeadlineSeconds is the time a rollout may take to make progress before it is
+	// considered stalled (defaults to the Deployment default of 600)
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+}
+
+// RouteSpec defines the OpenShift Route routing external traffic to the llama-stack server Service
+type RouteSpec struct {
+	// Enabled creates the Route. The Route is deleted when disabled
+	Enabled bool `json:"enabled"`
+	// Host is the host name routed to the server (defaults to a host generated by the router). It must be a
+	// DNS name, optionally prefixed with a wildcard label, e.g. *.example.com
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Host string `json:"host,omitempty"`
+	// Path is the HTTP path prefix routed to the server (defaults to all paths)
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path,omitempty"`
+	// Annotations are added to the Route, e.g. to configure the router timeouts
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// EdgeTLS terminates TLS at the router with its default certificate and redirects HTTP to HTTPS
+	// +optional
+	EdgeTLS bool `json:"edgeTLS,omitempty"`
+}
+
+// IngressSpec defines the Ingress routing external traffic to the llama-stack server Service
+type IngressSpec struct {
+	// Enabled creates the Ingress. The Ingress is deleted when disabled
+	Enabled bool `json:"enabled"`
+	// Host is the host name routed to the server (defaults to all hosts). It must be a DNS name,
+	// optionally prefixed with a wildcard label, e.g. *.example.com
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Host string `json:"host,omitempty"`
+	// Path is the HTTP path prefix routed to the server (defaults to /)
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path,omitempty"`
+	// IngressClassName is the IngressClass handling the Ingress (defaults to the cluster default class)
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// TLSSecretName is the name of the Secret holding the TLS certificate for the host
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+	// Annotations are added to the Ingress, e.g. to configure the ingress controller
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// EdgeTLS terminates TLS at the OpenShift router with its default certificate and redirects HTTP to HTTPS.
+	// Only used when a Route is created instead of an Ingress
+	// +optional
+	EdgeTLS bool `json:"edgeTLS,omitempty"`
+}
+
+// MaintenanceJobSpec defines a CronJob mounting the storage volume of the llama-stack server
+type MaintenanceJobSpec struct {
+	// Name identifies the job. The CronJob is named <distribution name>-<name>
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=32
+	Name string `json:"name"`
+	// Schedule is the schedule of the job in Cron format
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// Image is the container image running the job (defaults to the llama-stack server image)
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Command is the entrypoint of the job container
+	// +kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+	// Args are the arguments of the job container
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Suspend stops scheduling new runs of the job
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// HealthCheckSpec defines the endpoint used to check the health of the llama-stack server
+type HealthCheckSpec struct {
+	// Path is the HTTP path of the health endpoint (defaults to /v1/health)
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path,omitempty"`
+	// Port is the port serving the health endpoint (defaults to the server port)
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// TimeoutSeconds is the timeout of a single health check, also applied to the requests
+	// the operator sends to the providers and version endpoints (defaults to 5)
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// MonitoringSpec defines the monitoring integrations for the llama-stack server
+type MonitoringSpec struct {
+	// Dashboard enables a Grafana dashboard ConfigMap labeled for discovery by the Grafana sidecar
+	// +optional
+	Dashboard bool `json:"dashboard,omitempty"`
+	// Metrics configures the scraping of the server metrics by the Prometheus Operator
+	// +optional
+	Metrics *MetricsSpec `json:"metrics,omitempty"`
+}
+
+// MetricsSpec configures the ServiceMonitor scraping the server metrics
+type MetricsSpec struct {
+	// Enabled creates a ServiceMonitor selecting the server Service, on clusters serving the Prometheus Operator API
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Port is the container port serving the metrics, the server port by default. A port other than the server
+	// and health ports is exposed by the Service as well
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// Path is the HTTP path of the metrics, /metrics by default
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path,omitempty"`
+}
+
+// QueueSpec defines how the server pods are admitted through Kueue
+type QueueSpec struct {
+	// Name is the name of the Kueue LocalQueue in the namespace of the distribution
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// SchedulingGate adds the Kueue admission scheduling gate to the pods so they are held until admitted
+	// +optional
+	SchedulingGate bool `json:"schedulingGate,omitempty"`
 }
 
 type UserConfigSpec struct {
@@ -93,6 +472,29 @@ type UserConfigSpec struct {
 	// ConfigMapNamespace is the namespace of the ConfigMap (defaults to the same namespace as the CR)
 	// +optional
 	ConfigMapNamespace string `json:"configMapNamespace,omitempty"`
+	// ConfigMapKey is the key of the ConfigMap holding the run configuration (defaults to run.yaml)
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	ConfigMapKey string `json:"configMapKey,omitempty"`
+	// MountPath is the directory the ConfigMap is mounted at in the server container. It defaults to
+	// /etc/llama-stack for the ConfigMap holding the run configuration and is required for the other ones
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/`
+	MountPath string `json:"mountPath,omitempty"`
+	// AdditionalConfigMaps are the names of ConfigMaps, in the namespace of the user ConfigMap, whose keys are
+	// mounted next to run.yaml, e.g. provider configuration files referenced by the run configuration.
+	// The keys must be unique across all the ConfigMaps
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	AdditionalConfigMaps []string `json:"additionalConfigMaps,omitempty"`
+}
+
+// SecretRefSpec identifies a Secret watched for changes
+type SecretRefSpec struct {
+	// SecretName is the name of the Secret, in the same namespace as the CR
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
 }
 
 // TLSConfig defines the TLS configuration for the llama-stack server
@@ -100,6 +502,20 @@ type TLSConfig struct {
 	// CABundle defines the CA bundle configuration for custom certificates
 	// +optional
 	CABundle *CABundleConfig `json:"caBundle,omitempty"`
+	// ServerCert serves the llama-stack server over HTTPS with the certificate of a kubernetes.io/tls Secret
+	// +optional
+	ServerCert *ServerCertConfig `json:"serverCert,omitempty"`
+}
+
+// ServerCertConfig defines the certificate served by the llama-stack server
+type ServerCertConfig struct {
+	// SecretName is the name of the kubernetes.io/tls Secret, in the same namespace as the CR. The operator
+	// verifies the server against the ca.crt key of the Secret, or against tls.crt if the Secret has no ca.crt
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+	// InsecureSkipVerify disables the verification of the server certificate by the operator health checks
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // CABundleConfig defines the CA bundle configuration for custom certificates
@@ -125,17 +541,74 @@ type StorageSpec struct {
 	Size *resource.Quantity `json:"size,omitempty"`
 	// MountPath is the path where the storage will be mounted in the container
 	MountPath string `json:"mountPath,omitempty"`
+	// StorageClassName is the name of the StorageClass used for the persistent volume claim.
+	// Defaults to the default StorageClass of the cluster
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// AccessModes are the access modes of the persistent volume claim. Defaults to ReadWriteOnce
+	// +optional
+	// +kubebuilder:validation:items:Enum=ReadWriteOnce;ReadOnlyMany;ReadWriteMany;ReadWriteOncePod
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// PermissionsInitContainer runs an init container as root changing the owner of the mount path, for volumes
+	// that ignore the fsGroup of the pods. By default the volume is made writable through the fsGroup instead
+	// +optional
+	PermissionsInitContainer bool `json:"permissionsInitContainer,omitempty"`
+	// FSGroup is the group the volume is made writable for through the fsGroup of the pods. Defaults to 1001,
+	// the group of the server images. On OpenShift, set it to a group of the range allocated to the namespace
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	FSGroup *int64 `json:"fsGroup,omitempty"`
 }
 
 // ContainerSpec defines the llama-stack server container configuration.
 type ContainerSpec struct {
 	// +kubebuilder:default:="llama-stack"
-	Name      string                      `json:"name,omitempty"` // Optional, defaults to "llama-stack"
+	Name string `json:"name,omitempty"` // Optional, defaults to "llama-stack"
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
 	Port      int32                       `json:"port,omitempty"` // Defaults to 8321 if unset
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 	Env       []corev1.EnvVar             `json:"env,omitempty"` // Runtime env vars (e.g., INFERENCE_MODEL)
 	Command   []string                    `json:"command,omitempty"`
 	Args      []string                    `json:"args,omitempty"`
+	// EnvFrom sets env vars from all the keys of Secrets or ConfigMaps, e.g. API keys kept out of the CR.
+	// Variables set in Env and by the operator take precedence
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+	// Probes overrides the readiness, liveness and startup probes of the llama-stack server container
+	// +optional
+	Probes *ProbesSpec `json:"probes,omitempty"`
+	// ContainerSecurityContext is the security context of the llama-stack server container
+	// +optional
+	ContainerSecurityContext *corev1.SecurityContext `json:"containerSecurityContext,omitempty"`
+	// ImagePullPolicy is the pull policy of the server image. Defaults to IfNotPresent for an image pinned
+	// by digest, and Always otherwise
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// ExtraPorts are additional ports of the llama-stack server container, e.g. a telemetry or gRPC port.
+	// They are exposed by the Service and allowed by the NetworkPolicy, named after the port name or
+	// port-<number> when unnamed. Port remains the port of the API and of the health checks
+	// +optional
+	ExtraPorts []corev1.ContainerPort `json:"extraPorts,omitempty"`
+}
+
+// ProbesSpec defines the probes of the llama-stack server container.
+// Probes that are not set default to an HTTP probe against the health check endpoint.
+type ProbesSpec struct {
+	// Disable removes the readiness, liveness and startup probes from the container
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+	// Readiness is used verbatim as the readiness probe of the container
+	// +optional
+	Readiness *corev1.Probe `json:"readiness,omitempty"`
+	// Liveness is used verbatim as the liveness probe of the container
+	// +optional
+	Liveness *corev1.Probe `json:"liveness,omitempty"`
+	// Startup is used verbatim as the startup probe of the container. No startup probe is set by default,
+	// use one to hold the liveness probe while a server loading large models starts
+	// +optional
+	Startup *corev1.Probe `json:"startup,omitempty"`
 }
 
 // PodOverrides allows advanced pod-level customization.
@@ -146,6 +619,41 @@ type PodOverrides struct {
 	ServiceAccountName string               `json:"serviceAccountName,omitempty"`
 	Volumes            []corev1.Volume      `json:"volumes,omitempty"`
 	VolumeMounts       []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// ServiceAccountAnnotations are added to the ServiceAccount the operator creates for the server pods, e.g. to
+	// bind it to a cloud identity. They do not apply to a ServiceAccount set in ServiceAccountName
+	// +optional
+	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+	// ImagePullSecrets are the Secrets, in the same namespace as the CR, used to pull the images of the server pods
+	// from private registries. They take precedence over the ImagePullSecrets of the server spec
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// PodSecurityContext is the security context of the server pods
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// InitContainerResources are the resources of the init containers added by the operator, such as the one fixing
+	// the permissions of the storage volume. Small requests and limits are set when unset
+	// +optional
+	InitContainerResources *corev1.ResourceRequirements `json:"initContainerResources,omitempty"`
+	// Sidecars are additional containers of the server pods, e.g. model downloaders or log shippers, running next
+	// to the server container. They can mount the Volumes above. Their names must differ from the server container
+	// +optional
+	Sidecars []corev1.Container `json:"sidecars,omitempty"`
+	// NodeSelector constrains the server pods to nodes with matching labels, e.g. a GPU node type.
+	// It takes precedence over the NodeSelector of the server spec
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the server pods to be scheduled on nodes with matching taints.
+	// They take precedence over the Tolerations of the server spec
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity defines the node and pod affinity rules of the server pods. It takes precedence over the Affinity
+	// of the server spec and, like it, replaces the default anti-affinity of a server running more than one replica
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// TopologySpreadConstraints spread the server pods across topology domains, e.g. zones. They take precedence
+	// over the TopologySpreadConstraints of the server spec and replace the default anti-affinity and zone spread
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // ProviderInfo represents a single provider from the providers endpoint.
@@ -157,17 +665,59 @@ type ProviderInfo struct {
 	Health       ProviderHealthStatus `json:"health"`
 }
 
+// ModelInfo represents a single model registered on the server, from the models endpoint.
+type ModelInfo struct {
+	// ModelID is the identifier of the model in the requests to the server
+	ModelID string `json:"model_id"`
+	// ProviderID is the provider serving the model
+	// +optional
+	ProviderID string `json:"provider_id,omitempty"`
+	// ModelType is the type of the model, e.g. llm or embedding
+	// +optional
+	ModelType string `json:"model_type,omitempty"`
+}
+
 // DistributionConfig represents the configuration information from the providers endpoint.
 type DistributionConfig struct {
 	// ActiveDistribution shows which distribution is currently being used
 	ActiveDistribution string         `json:"activeDistribution,omitempty"`
 	Providers          []ProviderInfo `json:"providers,omitempty"`
+	// ProvidersTruncated is set when the providers list was capped to keep the status small
+	ProvidersTruncated bool `json:"providersTruncated,omitempty"`
+	// TotalProviders is the number of providers reported by the server before truncation
+	TotalProviders int32 `json:"totalProviders,omitempty"`
+	// HealthyProviders is the number of providers reported by the server with an OK health status
+	// +optional
+	HealthyProviders int32 `json:"healthyProviders,omitempty"`
+	// UnhealthyProviders is the number of providers reported by the server with an Error health status.
+	// Providers whose health check is not implemented are neither healthy nor unhealthy
+	// +optional
+	UnhealthyProviders int32 `json:"unhealthyProviders,omitempty"`
+	// ProvidersHealthy summarizes the healthy providers out of all the providers, e.g. 3/4
+	// +optional
+	ProvidersHealthy string `json:"providersHealthy,omitempty"`
+	// ProvidersLastUpdated is when the providers were last fetched from the server
+	// +optional
+	ProvidersLastUpdated *metav1.Time `json:"providersLastUpdated,omitempty"`
+	// ProvidersStale is set when the providers could not be refreshed, for instance while the server is not
+	// ready. The providers are then the last ones fetched, at ProvidersLastUpdated
+	// +optional
+	ProvidersStale bool `json:"providersStale,omitempty"`
+	// Models lists the models registered on the server. They are cleared while the server is not ready
+	// +optional
+	Models []ModelInfo `json:"models,omitempty"`
+	// ModelsTruncated is set when the models list was capped to keep the status small
+	// +optional
+	ModelsTruncated bool `json:"modelsTruncated,omitempty"`
+	// TotalModels is the number of models registered on the server before truncation
+	// +optional
+	TotalModels int32 `json:"totalModels,omitempty"`
 	// AvailableDistributions lists all available distributions and their images
 	AvailableDistributions map[string]string `json:"availableDistributions,omitempty"`
 }
 
 // LlamaStackDistributionPhase represents the current phase of the LlamaStackDistribution
-// +kubebuilder:validation:Enum=Pending;Initializing;Ready;Failed;Terminating
+// +kubebuilder:validation:Enum=Pending;Initializing;Ready;Failed;Terminating;Paused
 type DistributionPhase string
 
 const (
@@ -181,6 +731,8 @@ const (
 	LlamaStackDistributionPhaseFailed DistributionPhase = "Failed"
 	// LlamaStackDistributionPhaseTerminating indicates that the distribution is being terminated
 	LlamaStackDistributionPhaseTerminating DistributionPhase = "Terminating"
+	// LlamaStackDistributionPhasePaused indicates that the reconciliation of the distribution is suspended
+	LlamaStackDistributionPhasePaused DistributionPhase = "Paused"
 )
 
 // VersionInfo contains version-related information
@@ -193,6 +745,61 @@ type VersionInfo struct {
 	LastUpdated metav1.Time `json:"lastUpdated,omitempty"`
 }
 
+// ExternalReference identifies an external object (ConfigMap or Secret) consumed by the distribution.
+type ExternalReference struct {
+	// Kind is the kind of the referenced object, e.g. ConfigMap or Secret
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the referenced object
+	Namespace string `json:"namespace"`
+	// Name is the name of the referenced object
+	Name string `json:"name"`
+	// Purpose describes what the referenced object is used for, e.g. UserConfig or CABundle
+	Purpose string `json:"purpose"`
+	// LastObservedHash is the content hash of the referenced object last observed by the operator.
+	// Empty if the object could not be found.
+	// +optional
+	LastObservedHash string `json:"lastObservedHash,omitempty"`
+}
+
+// ReplicaManagementPolicy selects who owns the replica count of the server Deployment
+// +kubebuilder:validation:Enum=Managed;External
+type ReplicaManagementPolicy string
+
+const (
+	// ReplicaManagementPolicyManaged reverts any change of the replica count to the Replicas of the spec
+	ReplicaManagementPolicyManaged ReplicaManagementPolicy = "Managed"
+	// ReplicaManagementPolicyExternal leaves the replica count to whoever scales the Deployment
+	ReplicaManagementPolicyExternal ReplicaManagementPolicy = "External"
+)
+
+// MaintenanceJobState is the outcome of the last run of a maintenance job
+// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+type MaintenanceJobState string
+
+const (
+	// MaintenanceJobStateRunning indicates that a run of the job is active
+	MaintenanceJobStateRunning MaintenanceJobState = "Running"
+	// MaintenanceJobStateSucceeded indicates that the last run of the job succeeded
+	MaintenanceJobStateSucceeded MaintenanceJobState = "Succeeded"
+	// MaintenanceJobStateFailed indicates that the last run of the job did not succeed
+	MaintenanceJobStateFailed MaintenanceJobState = "Failed"
+)
+
+// MaintenanceJobStatus reports the last run of a maintenance job.
+type MaintenanceJobStatus struct {
+	// Name is the name of the maintenance job
+	Name string `json:"name"`
+	// LastScheduleTime is when the job was last scheduled
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is when the job last completed successfully
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// LastJobStatus is the outcome of the last run. Empty if the job never ran
+	// +optional
+	LastJobStatus MaintenanceJobState `json:"lastJobStatus,omitempty"`
+}
+
 // LlamaStackDistributionStatus defines the observed state of LlamaStackDistribution.
 type LlamaStackDistributionStatus struct {
 	// Phase represents the current phase of the distribution
@@ -205,6 +812,67 @@ type LlamaStackDistributionStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// AvailableReplicas is the number of available replicas
 	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// References lists the external ConfigMaps and Secrets the distribution depends on
+	// +optional
+	References []ExternalReference `json:"references,omitempty"`
+	// MaintenanceJobs reports the last run of each maintenance job
+	// +optional
+	MaintenanceJobs []MaintenanceJobStatus `json:"maintenanceJobs,omitempty"`
+	// Storage reports the effective size of the persistent storage
+	// +optional
+	Storage *StorageStatus `json:"storage,omitempty"`
+	// ExternalURL is the URL of the server exposed through the Ingress or Route, once it is known
+	// +optional
+	ExternalURL string `json:"externalURL,omitempty"`
+	// Rollout records the last known-good pod template used for automatic rollback
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// Autoscaling reports the replicas observed by the HorizontalPodAutoscaler while autoscaling is enabled
+	// +optional
+	Autoscaling *AutoscalingStatus `json:"autoscaling,omitempty"`
+	// HealthCheck tracks the failed health checks of a ready server
+	// +optional
+	HealthCheck *HealthCheckStatus `json:"healthCheck,omitempty"`
+}
+
+// HealthCheckStatus tracks the failed health checks of a ready server
+type HealthCheckStatus struct {
+	// ConsecutiveFailures is the number of health checks failed since the last successful one
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+}
+
+// AutoscalingStatus reports the replicas observed by the HorizontalPodAutoscaler
+type AutoscalingStatus struct {
+	// CurrentReplicas is the number of replicas last seen by the HorizontalPodAutoscaler
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+	// DesiredReplicas is the number of replicas last computed by the HorizontalPodAutoscaler
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+}
+
+// RolloutStatus records the pod templates tracked for automatic rollback
+type RolloutStatus struct {
+	// LastGoodTemplateHash identifies the last pod template that completed a rollout
+	// +optional
+	LastGoodTemplateHash string `json:"lastGoodTemplateHash,omitempty"`
+	// LastGoodTemplate is the last pod template that completed a rollout
+	// +optional
+	LastGoodTemplate *apiextensionsv1.JSON `json:"lastGoodTemplate,omitempty"`
+	// FailedTemplateHash identifies the pod template that was rolled back. The last known-good pod template
+	// is kept until the spec renders a different pod template
+	// +optional
+	FailedTemplateHash string `json:"failedTemplateHash,omitempty"`
+}
+
+// StorageStatus reports the requested and actual size of the persistent storage
+type StorageStatus struct {
+	// RequestedSize is the size requested for the persistent volume claim, including the default if no size is set
+	RequestedSize resource.Quantity `json:"requestedSize"`
+	// Capacity is the actual capacity of the bound persistent volume, which may exceed the requested size
+	// +optional
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -214,11 +882,15 @@ type LlamaStackDistributionStatus struct {
 //+kubebuilder:printcolumn:name="Operator Version",type="string",JSONPath=".status.version.operatorVersion"
 //+kubebuilder:printcolumn:name="Server Version",type="string",JSONPath=".status.version.llamaStackServerVersion"
 //+kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.availableReplicas"
+//+kubebuilder:printcolumn:name="Providers Healthy",type="string",JSONPath=".status.distributionConfig.providersHealthy"
+//+kubebuilder:printcolumn:name="Models",type="integer",JSONPath=".status.distributionConfig.totalModels"
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 //+kubebuilder:selectablefield:JSONPath=".spec.server.userConfig.configMapName"
 //+kubebuilder:selectablefield:JSONPath=".spec.server.userConfig.configMapNamespace"
 //+kubebuilder:selectablefield:JSONPath=".spec.server.tlsConfig.caBundle.configMapName"
 //+kubebuilder:selectablefield:JSONPath=".spec.server.tlsConfig.caBundle.configMapNamespace"
+//+kubebuilder:selectablefield:JSONPath=".spec.server.secretRef.secretName"
+//+kubebuilder:selectablefield:JSONPath=".spec.server.tlsConfig.serverCert.secretName"
 // LlamaStackDistribution is the Schema for the llamastackdistributions API
 
 type LlamaStackDistribution struct {
@@ -244,5 +916,58 @@ func init() { //nolint:gochecknoinits
 
 // HasPorts checks if the container spec defines a port.
 func (r *LlamaStackDistribution) HasPorts() bool {
-	return r.Spec.Server.ContainerSpec.Port != 0 || len(r.Spec.Server.ContainerSpec.Env) > 0
+	return r.Spec.Server.ContainerSpec.Port != 0 || len(r.Spec.Server.ContainerSpec.ExtraPorts) > 0 ||
+		len(r.Spec.Server.ContainerSpec.Env) > 0
+}
+
+// IsDashboardEnabled checks if the Grafana dashboard ConfigMap is requested.
+func (r *LlamaStackDistribution) IsDashboardEnabled() bool {
+	return r.Spec.Server.Monitoring != nil && r.Spec.Server.Monitoring.Dashboard
+}
+
+// IsMetricsEnabled checks if a ServiceMonitor scraping the server metrics is requested.
+func (r *LlamaStackDistribution) IsMetricsEnabled() bool {
+	return r.Spec.Server.Monitoring != nil && r.Spec.Server.Monitoring.Metrics != nil && r.Spec.Server.Monitoring.Metrics.Enabled
+}
+
+// IsAutoscalingEnabled checks if the server is scaled by a HorizontalPodAutoscaler.
+func (r *LlamaStackDistribution) IsAutoscalingEnabled() bool {
+	return r.Spec.Autoscaling != nil
+}
+
+// IsReplicaCountExternal checks if the replica count of the server Deployment is owned by another controller.
+func (r *LlamaStackDistribution) IsReplicaCountExternal() bool {
+	return r.Spec.ReplicaManagementPolicy == ReplicaManagementPolicyExternal
+}
+
+// IsIngressEnabled checks if an Ingress exposing the server is requested.
+func (r *LlamaStackDistribution) IsIngressEnabled() bool {
+	return r.Spec.Server.Ingress != nil && r.Spec.Server.Ingress.Enabled
+}
+
+// RunUserConfig returns the user config holding the run configuration of the server: UserConfig, or the first
+// entry of UserConfigs when UserConfig is not set. It returns nil if no ConfigMap is referenced.
+func (r *LlamaStackDistribution) RunUserConfig() *UserConfigSpec {
+	if userConfig := r.Spec.Server.UserConfig; userConfig != nil && userConfig.ConfigMapName != "" {
+		return userConfig
+	}
+	if len(r.Spec.Server.UserConfigs) > 0 && r.Spec.Server.UserConfigs[0].ConfigMapName != "" {
+		return &r.Spec.Server.UserConfigs[0]
+	}
+	return nil
+}
+
+// MountedUserConfigs returns the entries of UserConfigs mounted at their own path, i.e. all of them but the one
+// holding the run configuration.
+func (r *LlamaStackDistribution) MountedUserConfigs() []UserConfigSpec {
+	userConfigs := r.Spec.Server.UserConfigs
+	if len(userConfigs) > 0 && r.RunUserConfig() == &userConfigs[0] {
+		return userConfigs[1:]
+	}
+	return userConfigs
+}
+
+// IsRouteEnabled checks if an OpenShift Route exposing the server is requested.
+func (r *LlamaStackDistribution) IsRouteEnabled() bool {
+	return r.Spec.Server.Route != nil && r.Spec.Server.Route.Enabled
 }
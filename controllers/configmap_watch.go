@@ -0,0 +1,150 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// configMapWatchNamespacesKey is the operator ConfigMap key restricting the namespaces of the ConfigMap watch.
+const configMapWatchNamespacesKey = "configMapWatchNamespaces"
+
+// NamespaceFilter selects namespaces by name or shell pattern, such as openshift-*.
+// A namespace matches if it matches one of the Include entries, or Include is empty,
+// and none of the Exclude entries. The zero value matches all namespaces.
+type NamespaceFilter struct {
+	Include []string `yaml:"include,omitempty"`
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// IsZero reports whether the filter matches all namespaces.
+func (f NamespaceFilter) IsZero() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Matches reports whether the namespace is selected by the filter.
+func (f NamespaceFilter) Matches(namespace string) bool {
+	if len(f.Include) > 0 && !matchesAnyNamespacePattern(f.Include, namespace) {
+		return false
+	}
+	return !matchesAnyNamespacePattern(f.Exclude, namespace)
+}
+
+// CacheByObject returns the cache options restricting the informer to the filtered namespaces, as far as the
+// cache can express them. Included names without patterns restrict the watched namespaces, and excluded names
+// without patterns become a field selector. Patterns are only applied by the event predicates, see Matches.
+// It returns false if the cache cannot be restricted.
+func (f NamespaceFilter) CacheByObject() (cache.ByObject, bool) {
+	if len(f.Include) > 0 && !slices.ContainsFunc(f.Include, isNamespacePattern) {
+		namespaces := make(map[string]cache.Config, len(f.Include))
+		for _, namespace := range f.Include {
+			if f.Matches(namespace) {
+				namespaces[namespace] = cache.Config{}
+			}
+		}
+		return cache.ByObject{Namespaces: namespaces}, true
+	}
+
+	var selectors []fields.Selector
+	for _, namespace := range f.Exclude {
+		if !isNamespacePattern(namespace) {
+			selectors = append(selectors, fields.OneTermNotEqualSelector("metadata.namespace", namespace))
+		}
+	}
+	if len(selectors) == 0 {
+		return cache.ByObject{}, false
+	}
+	return cache.ByObject{Field: fields.AndSelectors(selectors...)}, true
+}
+
+// matchesAnyNamespacePattern reports whether the namespace matches one of the names or patterns.
+func matchesAnyNamespacePattern(patterns []string, namespace string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		// Patterns are validated when the filter is parsed
+		matched, _ := path.Match(pattern, namespace)
+		return matched
+	})
+}
+
+// isNamespacePattern reports whether the entry is a shell pattern rather than a namespace name.
+func isNamespacePattern(entry string) bool {
+	return strings.ContainsAny(entry, `*?[\`)
+}
+
+// parseConfigMapWatchNamespaces extracts and validates the ConfigMap watch namespace filter from ConfigMap data.
+func parseConfigMapWatchNamespaces(configMapData map[string]string) (NamespaceFilter, error) {
+	filter := NamespaceFilter{}
+
+	filterYAML, exists := configMapData[configMapWatchNamespacesKey]
+	if !exists {
+		return filter, nil
+	}
+
+	if err := yaml.Unmarshal([]byte(filterYAML), &filter); err != nil {
+		return NamespaceFilter{}, fmt.Errorf("failed to parse ConfigMap watch namespaces: %w", err)
+	}
+
+	for _, pattern := range slices.Concat(filter.Include, filter.Exclude) {
+		if pattern == "" {
+			return NamespaceFilter{}, errors.New("failed to validate ConfigMap watch namespaces: empty entry")
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return NamespaceFilter{}, fmt.Errorf("failed to validate ConfigMap watch namespace pattern %q: %w", pattern, err)
+		}
+	}
+
+	return filter, nil
+}
+
+// configMapNamespacePredicate drops ConfigMap events from namespaces excluded from the watch
+// before the more expensive reference lookups run.
+func (r *LlamaStackDistributionReconciler) configMapNamespacePredicate(configMap client.Object) bool {
+	return r.ConfigMapWatchNamespaces.Matches(configMap.GetNamespace())
+}
+
+// unwatchedConfigMapNamespaces returns the namespaces of the ConfigMaps referenced by the instance
+// whose changes are not watched by the operator.
+func (r *LlamaStackDistributionReconciler) unwatchedConfigMapNamespaces(instance *llamav1alpha1.LlamaStackDistribution) []string {
+	var namespaces []string
+	if r.hasUserConfigMap(instance) {
+		namespaces = append(namespaces, r.getUserConfigMapNamespace(instance))
+		for _, userConfig := range instance.MountedUserConfigs() {
+			namespaces = append(namespaces, getUserConfigNamespace(instance, userConfig))
+		}
+	}
+	if r.hasCABundleConfigMap(instance) {
+		namespaces = append(namespaces, r.getCABundleConfigMapNamespace(instance))
+	}
+
+	var unwatched []string
+	for _, namespace := range namespaces {
+		if !r.ConfigMapWatchNamespaces.Matches(namespace) && !slices.Contains(unwatched, namespace) {
+			unwatched = append(unwatched, namespace)
+		}
+	}
+	return unwatched
+}
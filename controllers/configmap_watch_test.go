@@ -0,0 +1,354 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/refindex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestParseConfigMapWatchNamespaces(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expected    NamespaceFilter
+		expectError bool
+	}{
+		{name: "missing key watches all namespaces", data: map[string]string{}},
+		{
+			name:     "include and exclude",
+			data:     map[string]string{configMapWatchNamespacesKey: "include: [team-*]\nexclude: [kube-system, openshift-*]\n"},
+			expected: NamespaceFilter{Include: []string{"team-*"}, Exclude: []string{"kube-system", "openshift-*"}},
+		},
+		{name: "invalid YAML", data: map[string]string{configMapWatchNamespacesKey: "include: ["}, expectError: true},
+		{name: "invalid pattern", data: map[string]string{configMapWatchNamespacesKey: "exclude: ['[a-']"}, expectError: true},
+		{name: "empty entry", data: map[string]string{configMapWatchNamespacesKey: "include: ['']"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := parseConfigMapWatchNamespaces(tc.data)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, filter)
+		})
+	}
+}
+
+func TestNamespaceFilterMatches(t *testing.T) {
+	filter := NamespaceFilter{Include: []string{"team-*", "shared"}, Exclude: []string{"team-legacy"}}
+
+	assert.True(t, filter.Matches("team-a"))
+	assert.True(t, filter.Matches("shared"))
+	assert.False(t, filter.Matches("team-legacy"), "excluded namespaces win over included patterns")
+	assert.False(t, filter.Matches("default"), "namespaces not included are filtered out")
+
+	excludeOnly := NamespaceFilter{Exclude: []string{"kube-*", "openshift-*"}}
+	assert.True(t, excludeOnly.Matches("default"))
+	assert.False(t, excludeOnly.Matches("openshift-monitoring"))
+
+	assert.True(t, NamespaceFilter{}.Matches("kube-system"), "the zero value matches all namespaces")
+}
+
+func TestNamespaceFilterCacheByObject(t *testing.T) {
+	t.Run("included names restrict the namespaces", func(t *testing.T) {
+		byObject, restricted := NamespaceFilter{Include: []string{"team-a", "team-b"}, Exclude: []string{"team-b"}}.CacheByObject()
+
+		require.True(t, restricted)
+		assert.Equal(t, map[string]cache.Config{"team-a": {}}, byObject.Namespaces)
+	})
+
+	t.Run("excluded names become a field selector", func(t *testing.T) {
+		byObject, restricted := NamespaceFilter{Exclude: []string{"kube-system", "openshift-*"}}.CacheByObject()
+
+		require.True(t, restricted)
+		assert.Nil(t, byObject.Namespaces)
+		assert.Equal(t, "metadata.namespace!=kube-system", byObject.Field.String())
+	})
+
+	t.Run("patterns only apply to the predicates", func(t *testing.T) {
+		_, restricted := NamespaceFilter{Include: []string{"team-*"}, Exclude: []string{"openshift-*"}}.CacheByObject()
+		assert.False(t, restricted)
+
+		_, restricted = NamespaceFilter{}.CacheByObject()
+		assert.False(t, restricted)
+	})
+}
+
+func TestUnwatchedConfigMapNamespaces(t *testing.T) {
+	r := &LlamaStackDistributionReconciler{
+		ConfigMapWatchNamespaces: NamespaceFilter{Exclude: []string{"kube-system", "openshift-*"}},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "run-config"},
+				TLSConfig: &llamav1alpha1.TLSConfig{
+					CABundle: &llamav1alpha1.CABundleConfig{ConfigMapName: "trusted-ca", ConfigMapNamespace: "openshift-config"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"openshift-config"}, r.unwatchedConfigMapNamespaces(instance))
+
+	instance.Spec.Server.TLSConfig = nil
+	assert.Empty(t, r.unwatchedConfigMapNamespaces(instance))
+}
+
+// fakeBuilderIndexer registers field indexes with a fake client builder.
+type fakeBuilderIndexer struct {
+	builder *fake.ClientBuilder
+}
+
+func (b fakeBuilderIndexer) IndexField(_ context.Context, obj client.Object, field string, extract client.IndexerFunc) error {
+	b.builder.WithIndex(obj, field, extract)
+	return nil
+}
+
+func TestConfigMapLookupUsesFieldIndexes(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	userConfigInstance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "team"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "shared"}},
+		},
+	}
+	caBundleInstance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle", Namespace: "other-team"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{TLSConfig: &llamav1alpha1.TLSConfig{
+				CABundle: &llamav1alpha1.CABundleConfig{ConfigMapName: "shared", ConfigMapNamespace: "team"},
+			}},
+		},
+	}
+
+	// Lists without a field selector are the manual search fallback
+	fullLists := 0
+	builder := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(userConfigInstance, caBundleInstance).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				listOptions := &client.ListOptions{}
+				listOptions.ApplyOptions(opts)
+				if listOptions.FieldSelector == nil {
+					fullLists++
+				}
+				return c.List(ctx, list, opts...)
+			},
+		})
+	require.NoError(t, refindex.Register(t.Context(), fakeBuilderIndexer{builder: builder}))
+	r := NewReconciler(builder.Build(), testScheme)
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "team"}}
+
+	// --- act ---
+	referenced := r.isConfigMapReferenced(configMap)
+	requests := r.findLlamaStackDistributionsForConfigMap(t.Context(), configMap)
+
+	// --- assert: the keys of the event handlers match those of the index functions ---
+	assert.True(t, referenced)
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: client.ObjectKeyFromObject(userConfigInstance)},
+		{NamespacedName: client.ObjectKeyFromObject(caBundleInstance)},
+	}, requests)
+	assert.Zero(t, fullLists, "referenced ConfigMaps should be found without the manual search")
+
+	// --- act: a ConfigMap with the same name in another namespace ---
+	unrelated := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "other-team"}}
+
+	// --- assert ---
+	assert.False(t, r.isConfigMapReferenced(unrelated))
+	assert.Empty(t, r.findLlamaStackDistributionsForConfigMap(t.Context(), unrelated))
+}
+
+func TestUserConfigMapDeleted(t *testing.T) {
+	const validRunYAML = "version: '2'\nproviders:\n  inference: []\n"
+	newConfigMap := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "team"},
+			Data:       map[string]string{"run.yaml": validRunYAML},
+		}
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "user-config"}},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(newConfigMap()).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, clientgoscheme.Scheme)
+	r.Recorder = recorder
+	require.NoError(t, r.reconcileUserConfigMap(t.Context(), instance))
+	r.updateReferencesStatus(t.Context(), instance)
+	for len(recorder.Events) > 0 {
+		<-recorder.Events
+	}
+
+	// --- act: the ConfigMap is deleted ---
+	require.NoError(t, cli.Delete(t.Context(), newConfigMap()))
+	err := r.reconcileUserConfigMap(t.Context(), instance)
+	r.updateReferencesStatus(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorIs(t, err, errUserConfigMapDeleted)
+	condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReasonUserConfigMapDeleted, condition.Reason)
+	assert.Contains(t, condition.Message, "team/user-config")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonUserConfigMapDeleted)
+
+	result, err := requeueForReconcileError(err)
+	require.NoError(t, err, "a deleted ConfigMap should not be retried with backoff")
+	assert.Equal(t, ctrl.Result{RequeueAfter: userConfigMapDeletedRequeueInterval}, result)
+
+	// --- act: the periodic recheck still finds no ConfigMap ---
+	err = r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorIs(t, err, errUserConfigMapDeleted, "the deletion should be remembered once the reference hash is gone")
+	assert.Empty(t, recorder.Events, "the event should only be emitted when the ConfigMap goes missing")
+
+	// --- act: the ConfigMap is recreated ---
+	require.NoError(t, cli.Create(t.Context(), newConfigMap()))
+	err = r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeUserConfigReady))
+}
+
+func TestUserConfigMapNeverCreated(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "user-config"}},
+		},
+	}
+	r := NewReconciler(fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(), clientgoscheme.Scheme)
+
+	// --- act ---
+	err := r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.Error(t, err)
+	require.NotErrorIs(t, err, errUserConfigMapDeleted, "a ConfigMap that was never found is not reported as deleted")
+	condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, ReasonUserConfigInvalid, condition.Reason)
+}
+
+func TestSecretWatching(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				SecretRef: &llamav1alpha1.SecretRefSpec{SecretName: "credentials"},
+				TLSConfig: &llamav1alpha1.TLSConfig{ServerCert: &llamav1alpha1.ServerCertConfig{SecretName: "serving-cert"}},
+			},
+		},
+	}
+	// Uses the same Secret for both purposes
+	sharedInstance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-instance", Namespace: "team"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				SecretRef: &llamav1alpha1.SecretRefSpec{SecretName: "shared"},
+				TLSConfig: &llamav1alpha1.TLSConfig{ServerCert: &llamav1alpha1.ServerCertConfig{SecretName: "shared"}},
+			},
+		},
+	}
+	newSecret := func(namespace, name, apiKey string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       map[string][]byte{"API_KEY": []byte(apiKey)},
+		}
+	}
+	// The Secrets are watched through their metadata only
+	newSecretMetadata := func(namespace, name, resourceVersion string) *metav1.PartialObjectMetadata {
+		metadata := &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: resourceVersion},
+		}
+		metadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+		return metadata
+	}
+	expected := []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(instance)}}
+
+	for name, withIndex := range map[string]bool{"field indexer": true, "manual search fallback": false} {
+		t.Run(name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(instance, sharedInstance)
+			if withIndex {
+				require.NoError(t, refindex.Register(t.Context(), fakeBuilderIndexer{builder: builder}))
+			}
+			r := NewReconciler(builder.Build(), testScheme)
+			secret := newSecret("team", "credentials", "old")
+
+			// --- assert: the referenced Secret is mapped to the instance ---
+			assert.Equal(t, expected, r.findLlamaStackDistributionsForSecret(t.Context(), secret))
+			assert.True(t, r.secretCreatePredicate(event.CreateEvent{Object: secret}))
+			assert.True(t, r.secretDeletePredicate(event.DeleteEvent{Object: secret}))
+
+			// --- assert: updates are detected through the metadata, resyncs are ignored ---
+			oldMetadata := newSecretMetadata("team", "credentials", "1")
+			assert.True(t, r.secretUpdatePredicate(event.UpdateEvent{ObjectOld: oldMetadata, ObjectNew: newSecretMetadata("team", "credentials", "2")}))
+			assert.False(t, r.secretUpdatePredicate(event.UpdateEvent{ObjectOld: oldMetadata, ObjectNew: newSecretMetadata("team", "credentials", "1")}))
+			assert.False(t, r.secretUpdatePredicate(event.UpdateEvent{ObjectOld: newSecretMetadata("team", "other", "1"), ObjectNew: newSecretMetadata("team", "other", "2")}))
+
+			// --- assert: the serving certificate Secret is mapped to the instance ---
+			assert.Equal(t, expected, r.findLlamaStackDistributionsForSecret(t.Context(), newSecret("team", "serving-cert", "old")))
+
+			// --- assert: an instance using a Secret for both purposes is reconciled once ---
+			assert.Equal(t, []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(sharedInstance)}},
+				r.findLlamaStackDistributionsForSecret(t.Context(), newSecret("team", "shared", "old")))
+
+			// --- assert: Secrets with the same name in other namespaces or other names are ignored ---
+			for _, unrelated := range []*corev1.Secret{newSecret("other-team", "credentials", "old"), newSecret("team", "other", "old")} {
+				assert.Empty(t, r.findLlamaStackDistributionsForSecret(t.Context(), unrelated))
+				assert.False(t, r.secretCreatePredicate(event.CreateEvent{Object: unrelated}))
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// isDistributionsConfigMap reports whether the ConfigMap is the distributions ConfigMap of the operator namespace.
+func (r *LlamaStackDistributionReconciler) isDistributionsConfigMap(configMap client.Object) bool {
+	return r.ClusterInfo != nil &&
+		configMap.GetNamespace() == r.ClusterInfo.OperatorNamespace &&
+		configMap.GetName() == cluster.DistributionsConfigMapName
+}
+
+// findLlamaStackDistributionsForDistributionsConfigMap reloads the distribution images after a change of the
+// distributions ConfigMap, and reconciles all the instances so that their images and status follow the change.
+// An invalid ConfigMap keeps the previous images.
+func (r *LlamaStackDistributionReconciler) findLlamaStackDistributionsForDistributionsConfigMap(ctx context.Context,
+	configMap client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx).WithValues("configMapName", configMap.GetName(), "configMapNamespace", configMap.GetNamespace())
+
+	overrides, err := cluster.LoadDistributionOverrides(ctx, r.Client, configMap.GetNamespace())
+	if err != nil {
+		logger.Error(err, "failed to reload the distributions ConfigMap, keeping the previous distribution images")
+		return nil
+	}
+	r.ClusterInfo.SetDistributionOverrides(overrides)
+	logger.Info("Reloaded the distributions ConfigMap", "distributions", len(overrides))
+
+	llamaStacks := llamav1alpha1.LlamaStackDistributionList{}
+	if err := r.List(ctx, &llamaStacks); err != nil {
+		logger.Error(err, "failed to list LlamaStackDistributions after a distributions ConfigMap change")
+		return nil
+	}
+	return r.convertToReconcileRequests(llamaStacks)
+}
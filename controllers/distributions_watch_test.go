@@ -0,0 +1,98 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestDistributionsConfigMapWatch(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	instance := createLSD("custom", "")
+	instance.Name = "custom-instance"
+	other := createLSD("ollama", "")
+	other.Name, other.Namespace = "ollama-instance", "other-team"
+	distributions := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: cluster.DistributionsConfigMapName, Namespace: "default"},
+		Data: map[string]string{
+			cluster.DistributionsConfigMapKey: "custom: registry.example.com/custom:1.0\nollama: mirror.example.com/ollama:latest\n",
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(instance, other, distributions).Build()
+	r := NewReconciler(cli, testScheme, WithClusterInfo(setupTestClusterInfo(nil)))
+
+	// --- assert: only the distributions ConfigMap of the operator namespace is watched ---
+	assert.True(t, r.isDistributionsConfigMap(distributions))
+	assert.False(t, r.isDistributionsConfigMap(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: cluster.DistributionsConfigMapName, Namespace: "team"},
+	}))
+	require.Error(t, r.validateDistribution(instance), "the distribution is only known once the ConfigMap is loaded")
+
+	// --- act ---
+	requests := r.findLlamaStackDistributionsForDistributionsConfigMap(t.Context(), distributions)
+
+	// --- assert: all the instances follow the merged distributions ---
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: client.ObjectKeyFromObject(instance)},
+		{NamespacedName: client.ObjectKeyFromObject(other)},
+	}, requests)
+	require.NoError(t, r.validateDistribution(instance))
+	image, err := r.resolveImage(instance.Spec.Server.Distribution)
+	require.NoError(t, err)
+	assert.Equal(t, "registry.example.com/custom:1.0", image)
+	image, err = r.resolveImage(other.Spec.Server.Distribution)
+	require.NoError(t, err)
+	assert.Equal(t, "mirror.example.com/ollama:latest", image, "the ConfigMap should override the embedded image")
+	r.updateDistributionConfig(instance)
+	assert.Equal(t, map[string]string{
+		"custom": "registry.example.com/custom:1.0",
+		"ollama": "mirror.example.com/ollama:latest",
+	}, instance.Status.DistributionConfig.AvailableDistributions)
+
+	// --- act: an invalid ConfigMap keeps the previous distributions ---
+	distributions.Data[cluster.DistributionsConfigMapKey] = "custom: ["
+	require.NoError(t, cli.Update(t.Context(), distributions))
+
+	// --- assert ---
+	assert.Empty(t, r.findLlamaStackDistributionsForDistributionsConfigMap(t.Context(), distributions))
+	require.NoError(t, r.validateDistribution(instance))
+
+	// --- act: deleting the ConfigMap restores the embedded distributions ---
+	require.NoError(t, cli.Delete(t.Context(), distributions))
+	r.findLlamaStackDistributionsForDistributionsConfigMap(t.Context(), distributions)
+
+	// --- assert ---
+	require.Error(t, r.validateDistribution(instance))
+	image, err = r.resolveImage(other.Spec.Server.Distribution)
+	require.NoError(t, err)
+	assert.Equal(t, "ollama-image:latest", image)
+}
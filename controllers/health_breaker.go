@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Health check circuit breaker configuration. The breaker threshold is distinct from the health check failure
+// threshold of the operator ConfigMap, which only decides when a ready server moves to the Failed phase.
+const (
+	// endpointBreakerFailureThreshold is the number of consecutive failed health probes that opens the breaker.
+	endpointBreakerFailureThreshold = 5
+	// endpointBreakerOpenInterval is how long probing is suspended once the breaker is open.
+	endpointBreakerOpenInterval = 5 * time.Minute
+)
+
+// healthCheckBreaker suspends health probing of server endpoints that failed repeatedly.
+// State is kept in memory per LlamaStackDistribution and is lost on operator restart.
+// A nil breaker never suspends probing.
+type healthCheckBreaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	openInterval     time.Duration
+	now              func() time.Time
+	states           map[types.NamespacedName]*healthCheckBreakerState
+}
+
+// healthCheckBreakerState tracks the probe outcomes of a single endpoint.
+type healthCheckBreakerState struct {
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+// newHealthCheckBreaker creates a breaker that opens after failureThreshold consecutive failures.
+func newHealthCheckBreaker(failureThreshold int, openInterval time.Duration) *healthCheckBreaker {
+	return &healthCheckBreaker{
+		failureThreshold: failureThreshold,
+		openInterval:     openInterval,
+		now:              time.Now,
+		states:           make(map[types.NamespacedName]*healthCheckBreakerState),
+	}
+}
+
+// Allow reports whether the endpoint should be probed now.
+// Once the open interval elapses a single probe is allowed through to test connectivity.
+func (b *healthCheckBreaker) Allow(key types.NamespacedName) bool {
+	_, open := b.RetryAfter(key)
+	return !open
+}
+
+// RetryAfter returns how long probing stays suspended and whether the breaker is open.
+func (b *healthCheckBreaker) RetryAfter(key types.NamespacedName) (time.Duration, bool) {
+	if b == nil {
+		return 0, false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, exists := b.states[key]
+	if !exists || state.openUntil.IsZero() {
+		return 0, false
+	}
+	remaining := state.openUntil.Sub(b.now())
+	if remaining <= 0 {
+		return 0, false
+	}
+	return remaining, true
+}
+
+// RecordFailure records a failed probe and reports whether the breaker is now open.
+func (b *healthCheckBreaker) RecordFailure(key types.NamespacedName) bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, exists := b.states[key]
+	if !exists {
+		state = &healthCheckBreakerState{}
+		b.states[key] = state
+	}
+	state.consecutiveFailures++
+	if state.consecutiveFailures >= b.failureThreshold {
+		state.openUntil = b.now().Add(b.openInterval)
+		return true
+	}
+	return false
+}
+
+// RecordSuccess records a successful probe and closes the breaker.
+func (b *healthCheckBreaker) RecordSuccess(key types.NamespacedName) {
+	b.Forget(key)
+}
+
+// Forget drops all state tracked for the endpoint.
+func (b *healthCheckBreaker) Forget(key types.NamespacedName) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.states, key)
+}
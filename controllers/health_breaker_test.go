@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestHealthCheckBreaker(t *testing.T) {
+	const threshold = 3
+	const openInterval = time.Minute
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := newHealthCheckBreaker(threshold, openInterval)
+	breaker.now = func() time.Time { return now }
+	key := types.NamespacedName{Namespace: "test-ns", Name: "test-instance"}
+	otherKey := types.NamespacedName{Namespace: "test-ns", Name: "other-instance"}
+
+	// Failures below the threshold keep the breaker closed
+	for range threshold - 1 {
+		assert.False(t, breaker.RecordFailure(key))
+		assert.True(t, breaker.Allow(key))
+	}
+
+	// Reaching the threshold opens the breaker
+	require.True(t, breaker.RecordFailure(key), "breaker should open at the failure threshold")
+	assert.False(t, breaker.Allow(key), "probing should be suspended while the breaker is open")
+	retryAfter, open := breaker.RetryAfter(key)
+	assert.True(t, open)
+	assert.Equal(t, openInterval, retryAfter)
+	assert.True(t, breaker.Allow(otherKey), "breaker state should be tracked per instance")
+
+	// Once the interval elapses a probe is allowed through; another failure reopens the breaker
+	now = now.Add(openInterval)
+	require.True(t, breaker.Allow(key), "probing should resume after the open interval")
+	require.True(t, breaker.RecordFailure(key), "a failed trial probe should reopen the breaker")
+	assert.False(t, breaker.Allow(key))
+
+	// A successful probe closes the breaker and resets the failure count
+	now = now.Add(openInterval)
+	breaker.RecordSuccess(key)
+	assert.True(t, breaker.Allow(key))
+	assert.False(t, breaker.RecordFailure(key), "failure count should restart after a success")
+}
+
+func TestNilHealthCheckBreaker(t *testing.T) {
+	var breaker *healthCheckBreaker
+	key := types.NamespacedName{Namespace: "test-ns", Name: "test-instance"}
+
+	assert.False(t, breaker.RecordFailure(key))
+	assert.True(t, breaker.Allow(key), "a nil breaker should never suspend probing")
+	breaker.RecordSuccess(key)
+}
@@ -7,6 +7,19 @@ package controllers
 
 // Deployment permissions - controller creates and manages deployments
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=list
+
+// HorizontalPodAutoscaler permissions - controller scales the server Deployment when autoscaling is enabled
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+
+// PodDisruptionBudget permissions - controller limits the voluntary disruptions of the server pods
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+
+// CronJob permissions - controller creates and manages the CronJobs of maintenance jobs
+//+kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+
+// Pod permissions - controller inspects server pods to report Kueue admission state
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 
 // Service permissions - controller creates and manages services
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
@@ -20,10 +33,36 @@ package controllers
 //+kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=use
 //+kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,resourceNames=anyuid,verbs=use
 
-//+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;patch;delete
+
+// ResourceQuota permissions - controller checks the Deployment against the namespace quotas when the pre-flight is enabled
+//+kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch
+
+// StorageClass permissions - controller checks whether the StorageClass of a PVC allows volume expansion
+//+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 
 // ConfigMap permissions - controller reads user configmaps and manages operator config configmaps
-//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// Secret permissions - controller hashes the Secrets referenced by spec.server.secretRef to restart the pods on changes.
+// Only the metadata of the Secrets is listed and watched, their data is read for the referenced Secrets only
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// SelfSubjectAccessReview permissions - controller rechecks its access to referenced ConfigMaps it was not allowed to read
+//+kubebuilder:rbac:groups=authorization.k8s.io,resources=selfsubjectaccessreviews,verbs=create
 
 // NetworkPolicy permissions - controller creates and manages network policies
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+
+// Ingress permissions - controller exposes the server through an optional Ingress
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+
+// Route permissions - controller exposes the server through a Route on OpenShift, custom-host allows setting the host
+//+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=route.openshift.io,resources=routes/custom-host,verbs=create
+
+// ServiceMonitor permissions - controller creates a ServiceMonitor scraping the server metrics with the Prometheus Operator
+//+kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+
+// Event permissions - controller reports adopted resources with events
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -18,13 +18,23 @@ package controllers
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,19 +44,30 @@ import (
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/deploy"
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/featureflags"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/metrics"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/refindex"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/statusexport"
 	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -57,22 +78,105 @@ import (
 
 const (
 	operatorConfigData = "llama-stack-operator-config"
-	manifestsBasePath  = "manifests/base"
+	// distributionEntrypointsKey is the operator ConfigMap key holding per-distribution default entrypoints.
+	distributionEntrypointsKey = "distributionEntrypoints"
+	// ownerReferencesKey is the operator ConfigMap key holding the owner reference policy for generated resources.
+	ownerReferencesKey = "ownerReferences"
+	// minStorageSizeKey is the operator ConfigMap key holding the smallest accepted PVC size.
+	minStorageSizeKey = "minStorageSize"
+	// networkPolicyEnforcementKey is the operator ConfigMap key overriding the detected NetworkPolicy enforcement.
+	networkPolicyEnforcementKey = "networkPolicyEnforcement"
+	// initializingRequeueSecondsKey is the operator ConfigMap key holding how often Initializing instances are requeued.
+	initializingRequeueSecondsKey = "initializingRequeueSeconds"
+	// utilityImageKey is the operator ConfigMap key holding the image of the init containers run by the operator.
+	utilityImageKey = "utilityImage"
+	// healthCheckFailureThresholdKey is the operator ConfigMap key holding the number of consecutive failed health
+	// checks that moves a ready instance to the Failed phase.
+	healthCheckFailureThresholdKey = "healthCheckFailureThreshold"
+	manifestsBasePath              = "manifests/base"
 
 	// CA Bundle related constants.
-	DefaultCABundleKey    = "ca-bundle.crt"
-	CABundleMountPath     = "/etc/ssl/certs/ca-bundle.crt"
-	CABundleTempPath      = "/tmp/ca-bundle/ca-bundle.crt"
-	CABundleVolumeName    = "ca-bundle"
-	CABundleSourceDir     = "/tmp/ca-source"
-	CABundleInitName      = "ca-bundle-init"
-	CABundleSourceVolName = "ca-bundle-source"
-	CABundleTempDir       = "/tmp/ca-bundle"
+	DefaultCABundleKey = "ca-bundle.crt"
+	CABundleMountPath  = "/etc/ssl/certs/ca-bundle.crt"
+	CABundleVolumeName = "ca-bundle"
+
+	// Serving certificate related constants.
+	ServerCertMountPath  = "/etc/llama-stack-tls"
+	ServerCertVolumeName = "server-cert"
 
 	// ODH/RHOAI well-known ConfigMap for trusted CA bundles.
 	odhTrustedCABundleConfigMap = "odh-trusted-ca-bundle"
+
+	// serverCertCAKey is the key of the serving certificate Secret holding the CA the server is verified against.
+	serverCertCAKey = "ca.crt"
+
+	// userConfigRunYAMLKey is the key of the user ConfigMap holding the llama-stack run configuration.
+	userConfigRunYAMLKey = "run.yaml"
+	// userConfigMountPath is the default directory of the user ConfigMap holding the run configuration.
+	userConfigMountPath = "/etc/llama-stack/"
+
+	// DefaultUserConfigRevalidationInterval is how often a referenced user ConfigMap is re-validated
+	// when no watch event fired.
+	DefaultUserConfigRevalidationInterval = 5 * time.Minute
+
+	// DefaultInitializingRequeueInterval is how often an instance whose pods are not ready yet is reconciled again.
+	DefaultInitializingRequeueInterval = 10 * time.Second
+
+	// DefaultHealthCheckFailureThreshold is the default number of consecutive failed health checks that moves a
+	// ready instance to the Failed phase.
+	DefaultHealthCheckFailureThreshold = 3
+
+	// DefaultMaxStatusProviders is the default cap on the number of providers stored in the status.
+	DefaultMaxStatusProviders = 32
+
+	// maxStatusModels caps the number of models stored in the status, which a server proxying a model catalog may
+	// register by the hundreds.
+	maxStatusModels = 64
+
+	// deploymentForbiddenRequeueInterval is how often a Deployment rejected by admission or quota is retried.
+	deploymentForbiddenRequeueInterval = time.Minute
+
+	// resourceNotOwnedRequeueInterval is how often a resource blocked by an object of the same name is retried.
+	resourceNotOwnedRequeueInterval = time.Minute
+
+	// insufficientPermissionsRequeueInterval is how often the access to a referenced object the operator may not read
+	// is rechecked.
+	insufficientPermissionsRequeueInterval = 5 * time.Minute
+
+	// userConfigMapDeletedRequeueInterval is how often an instance whose user ConfigMap was deleted checks whether
+	// it was recreated, in case the ConfigMap watch misses it.
+	userConfigMapDeletedRequeueInterval = time.Minute
+
+	// staleProvidersRequeueInterval is how often the providers of a ready server are refetched after a failure.
+	staleProvidersRequeueInterval = 10 * time.Second
+
+	// healthCheckFailedRequeueInterval is how often the health of a server failing its health checks is checked again.
+	healthCheckFailedRequeueInterval = 10 * time.Second
+
+	// rolloutQueuedRequeueInterval is how often a queued rollout checks whether the namespace rollout lock is free.
+	rolloutQueuedRequeueInterval = 15 * time.Second
 )
 
+// errUserConfigMapDeleted is returned while the user ConfigMap found before is missing. The pods keep running
+// the last configuration until it is recreated.
+var errUserConfigMapDeleted = errors.New("user ConfigMap was deleted")
+
+// errStorageBackendChangeBlocked is returned while a switch between emptyDir and a PVC waits for acknowledgment.
+var errStorageBackendChangeBlocked = errors.New("storage backend change is not acknowledged")
+
+// errServerUnavailable marks a transient failure of a request to the llama-stack server, worth retrying.
+var errServerUnavailable = errors.New("llama-stack server unavailable")
+
+// serverRequestBackoff retries the transient failures of the requests to the llama-stack server, making at most
+// 3 attempts so that a freshly started server is not hammered and the reconciliation is not held up for long.
+var serverRequestBackoff = wait.Backoff{Steps: 3, Duration: 100 * time.Millisecond, Factor: 2, Jitter: 0.1}
+
+// DefaultUtilityImage runs the init containers of the operator unless overridden in the operator ConfigMap.
+const DefaultUtilityImage = "registry.access.redhat.com/ubi9/ubi-minimal:9.5"
+
+// DefaultMinStorageSize is the smallest PVC size accepted unless overridden in the operator ConfigMap.
+var DefaultMinStorageSize = resource.MustParse("1Gi")
+
 // LlamaStackDistributionReconciler reconciles a LlamaStack object.
 //
 // ConfigMap Watching Feature:
@@ -84,23 +188,106 @@ type LlamaStackDistributionReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 	// Feature flags
-	EnableNetworkPolicy bool
+	EnableNetworkPolicy           bool
+	EnableQuotaPreflight          bool
+	EnableRolloutSerialization    bool
+	EnableSecurityContextDefaults bool
+	EnableDefaultTopologySpread   bool
+	ValidateConfigMapContent      bool
 	// Cluster info
 	ClusterInfo *cluster.ClusterInfo
 	httpClient  *http.Client
+	// UserConfigRevalidationInterval controls how often the user ConfigMap is re-validated
+	// without a watch event firing. Zero disables periodic re-validation.
+	UserConfigRevalidationInterval time.Duration
+	// InitializingRequeueInterval controls how often an Initializing instance is reconciled again while its
+	// pods start. Zero uses DefaultInitializingRequeueInterval.
+	InitializingRequeueInterval time.Duration
+	// HealthCheckFailureThreshold is the number of consecutive failed health checks that moves a ready instance to
+	// the Failed phase. Zero uses DefaultHealthCheckFailureThreshold.
+	HealthCheckFailureThreshold int
+	// MaxStatusProviders caps the number of providers stored in the status to keep the object
+	// well below the etcd size limit. Zero disables the cap.
+	MaxStatusProviders int
+	// DistributionEntrypoints maps distribution names to the command and args used when a user config is mounted.
+	DistributionEntrypoints map[string]DistributionEntrypoint
+	// OwnerReferencePolicy controls the owner reference flags set on generated resources.
+	OwnerReferencePolicy deploy.OwnerReferencePolicy
+	// StatusPublisher publishes status snapshots to an external sink on significant transitions. Nil disables it.
+	StatusPublisher statusexport.Publisher
+	// healthBreaker suspends health probing of endpoints that keep failing. Nil disables it.
+	healthBreaker *healthCheckBreaker
+	// rolloutLocks tracks the instance of each namespace allowed to roll out a new pod template.
+	rolloutLocks *rolloutLocks
+	// serverClients caches the clients querying the servers that serve a certificate.
+	serverClients *serverClients
+	// Recorder emits Kubernetes Events on the instance. Nil disables events.
+	Recorder record.EventRecorder
+	// MinStorageSize is the smallest PVC size accepted for an instance. Zero disables the check.
+	MinStorageSize resource.Quantity
+	// UtilityImage runs the init containers of the operator, such as the one fixing the PVC permissions.
+	// Empty uses DefaultUtilityImage.
+	UtilityImage string
+	// ConfigMapWatchNamespaces restricts the namespaces whose ConfigMap events are processed. The zero value
+	// watches all namespaces.
+	ConfigMapWatchNamespaces NamespaceFilter
+	// clock stamps the status and drives the health check breaker. Nil uses the real clock.
+	clock clock.PassiveClock
+}
+
+// DistributionEntrypoint is the default command and args used to start a distribution with a mounted user config.
+type DistributionEntrypoint struct {
+	Command []string `yaml:"command,omitempty"`
+	Args    []string `yaml:"args,omitempty"`
+}
+
+// now returns the current time of the reconciler clock.
+func (r *LlamaStackDistributionReconciler) now() time.Time {
+	if r.clock == nil {
+		return time.Now()
+	}
+	return r.clock.Now()
+}
+
+// utilityImage returns the image of the init containers run by the operator. It is safe to call on a nil reconciler.
+func (r *LlamaStackDistributionReconciler) utilityImage() string {
+	if r == nil || r.UtilityImage == "" {
+		return DefaultUtilityImage
+	}
+	return r.UtilityImage
+}
+
+// initContainerImage returns the image of the init containers run by the operator for the instance, the one of
+// its spec taking precedence over the utility image of the operator ConfigMap.
+func (r *LlamaStackDistributionReconciler) initContainerImage(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.InitContainerImage != "" {
+		return instance.Spec.InitContainerImage
+	}
+	return r.utilityImage()
 }
 
 // hasUserConfigMap checks if the instance has a valid UserConfig with ConfigMapName.
 // Returns true if configured, false otherwise.
 func (r *LlamaStackDistributionReconciler) hasUserConfigMap(instance *llamav1alpha1.LlamaStackDistribution) bool {
-	return instance.Spec.Server.UserConfig != nil && instance.Spec.Server.UserConfig.ConfigMapName != ""
+	return instance.RunUserConfig() != nil
+}
+
+// hasUserSecret checks if the instance references a Secret whose changes restart its pods.
+func (r *LlamaStackDistributionReconciler) hasUserSecret(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance.Spec.Server.SecretRef != nil && instance.Spec.Server.SecretRef.SecretName != ""
+}
+
+// hasServerCert checks if the server of the instance serves the certificate of a Secret over HTTPS.
+func hasServerCert(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	tlsConfig := instance.Spec.Server.TLSConfig
+	return tlsConfig != nil && tlsConfig.ServerCert != nil && tlsConfig.ServerCert.SecretName != ""
 }
 
 // getUserConfigMapNamespace returns the resolved ConfigMap namespace.
 // If ConfigMapNamespace is specified, it returns that; otherwise, it returns the instance's namespace.
 func (r *LlamaStackDistributionReconciler) getUserConfigMapNamespace(instance *llamav1alpha1.LlamaStackDistribution) string {
-	if instance.Spec.Server.UserConfig.ConfigMapNamespace != "" {
-		return instance.Spec.Server.UserConfig.ConfigMapNamespace
+	if namespace := instance.RunUserConfig().ConfigMapNamespace; namespace != "" {
+		return namespace
 	}
 	return instance.Namespace
 }
@@ -123,13 +310,13 @@ func (r *LlamaStackDistributionReconciler) getCABundleConfigMapNamespace(instanc
 // hasValidUserConfig is a standalone helper function to check if a LlamaStackDistribution has valid UserConfig.
 // This is used by functions that don't have access to the reconciler receiver.
 func hasValidUserConfig(llsd *llamav1alpha1.LlamaStackDistribution) bool {
-	return llsd.Spec.Server.UserConfig != nil && llsd.Spec.Server.UserConfig.ConfigMapName != ""
+	return llsd.RunUserConfig() != nil
 }
 
 // getUserConfigMapNamespaceStandalone returns the resolved ConfigMap namespace without needing a receiver.
 func getUserConfigMapNamespaceStandalone(llsd *llamav1alpha1.LlamaStackDistribution) string {
-	if llsd.Spec.Server.UserConfig.ConfigMapNamespace != "" {
-		return llsd.Spec.Server.UserConfig.ConfigMapNamespace
+	if namespace := llsd.RunUserConfig().ConfigMapNamespace; namespace != "" {
+		return namespace
 	}
 	return llsd.Namespace
 }
@@ -156,7 +343,12 @@ func getCABundleConfigMapNamespaceStandalone(llsd *llamav1alpha1.LlamaStackDistr
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.12.2/pkg/reconcile
-func (r *LlamaStackDistributionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *LlamaStackDistributionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	start := time.Now()
+	defer func() {
+		metrics.RecordReconcile(reconcileResult(result, err), time.Since(start))
+	}()
+
 	// Create a logger with request-specific values and store it in the context.
 	// This ensures consistent logging across the reconciliation process and its sub-functions.
 	// The logger is retrieved from the context in each sub-function that needs it, maintaining
@@ -173,14 +365,35 @@ func (r *LlamaStackDistributionReconciler) Reconcile(ctx context.Context, req ct
 
 	if instance == nil {
 		logger.Info("LlamaStackDistribution resource not found, skipping reconciliation")
+		r.healthBreaker.Forget(req.NamespacedName)
+		r.rolloutLocks.Release(req.NamespacedName)
+		r.serverClients.Forget(req.NamespacedName)
+		forgetInstanceMetrics(req.NamespacedName)
 		return ctrl.Result{}, nil
 	}
 
+	// A paused instance keeps its resources as they are, but its deletion is not held back
+	if isReconcilePaused(instance) && instance.GetDeletionTimestamp().IsZero() {
+		return ctrl.Result{}, r.pauseReconciliation(ctx, instance)
+	}
+	if instance.Status.Phase == llamav1alpha1.LlamaStackDistributionPhasePaused {
+		logger.Info("Resuming reconciliation")
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonReconcileResumed, "Reconciliation resumed")
+	}
+
+	// Keep the persisted status to detect transitions worth publishing.
+	previousStatus := instance.Status.DeepCopy()
+
 	// Reconcile all resources, storing the error for later.
 	reconcileErr := r.reconcileResources(ctx, instance)
 
+	// An instance being deleted is gone once its finalizer is removed, so its status is not updated.
+	if !instance.GetDeletionTimestamp().IsZero() && reconcileErr == nil {
+		return ctrl.Result{}, nil
+	}
+
 	// Update the status, passing in any reconciliation error.
-	if statusUpdateErr := r.updateStatus(ctx, instance, reconcileErr); statusUpdateErr != nil {
+	if statusUpdateErr := r.updateStatus(ctx, instance, previousStatus.Conditions, reconcileErr); statusUpdateErr != nil {
 		// Log the status update error, but prioritize the reconciliation error for return.
 		logger.Error(statusUpdateErr, "failed to update status")
 		if reconcileErr != nil {
@@ -188,21 +401,147 @@ func (r *LlamaStackDistributionReconciler) Reconcile(ctx context.Context, req ct
 		}
 		return ctrl.Result{}, statusUpdateErr
 	}
+	r.publishStatusSnapshot(ctx, previousStatus, instance)
 
-	// If reconciliation failed, return the error to trigger a requeue.
+	// If reconciliation failed, requeue according to the class of the failure.
 	if reconcileErr != nil {
-		return ctrl.Result{}, reconcileErr
+		return requeueForReconcileError(reconcileErr)
 	}
 
 	// Check if requeue is needed based on phase
 	if instance.Status.Phase == llamav1alpha1.LlamaStackDistributionPhaseInitializing {
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		return ctrl.Result{RequeueAfter: r.initializingRequeueInterval()}, nil
 	}
 
 	logger.Info("Successfully reconciled LlamaStackDistribution")
+
+	// Come back once suspended health probing is allowed again
+	if retryAfter, open := r.healthBreaker.RetryAfter(req.NamespacedName); open {
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	// The end of the rollout holding the namespace lock triggers no event for this instance, so check again periodically
+	if IsConditionTrue(&instance.Status, ConditionTypeRolloutQueued) {
+		return ctrl.Result{RequeueAfter: rolloutQueuedRequeueInterval}, nil
+	}
+
+	// Check the health of a failing server again rather than waiting for the next event
+	if instance.Status.HealthCheck != nil && instance.Status.HealthCheck.ConsecutiveFailures > 0 {
+		return ctrl.Result{RequeueAfter: healthCheckFailedRequeueInterval}, nil
+	}
+
+	// Refresh stale providers promptly rather than waiting for the next event
+	if instance.Status.DistributionConfig.ProvidersStale {
+		return ctrl.Result{RequeueAfter: staleProvidersRequeueInterval}, nil
+	}
+
+	// Periodically re-validate the user ConfigMap so that edits breaking it are caught
+	// even if no watch event fires.
+	if r.hasUserConfigMap(instance) && r.UserConfigRevalidationInterval > 0 {
+		return ctrl.Result{RequeueAfter: r.UserConfigRevalidationInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
+// pauseReconciliation moves the instance to the Paused phase without touching its resources. The status is only
+// updated on the transition, so that the status update does not trigger another reconciliation.
+func (r *LlamaStackDistributionReconciler) pauseReconciliation(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if instance.Status.Phase == llamav1alpha1.LlamaStackDistributionPhasePaused {
+		return nil
+	}
+	log.FromContext(ctx).Info("Reconciliation paused", "annotation", llamav1alpha1.ReconcilePausedAnnotation)
+	instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhasePaused
+	instance.Status.Version.LastUpdated = metav1.NewTime(r.now().UTC())
+	if err := r.Status().Update(ctx, instance); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+	recordPhaseMetric(client.ObjectKeyFromObject(instance), instance.Status.Phase)
+	r.recordEvent(instance, corev1.EventTypeNormal, EventReasonReconcilePaused,
+		fmt.Sprintf("Reconciliation paused by the %s annotation", llamav1alpha1.ReconcilePausedAnnotation))
+	return nil
+}
+
+// initializingRequeueInterval returns how often an Initializing instance is reconciled again.
+func (r *LlamaStackDistributionReconciler) initializingRequeueInterval() time.Duration {
+	if r.InitializingRequeueInterval <= 0 {
+		return DefaultInitializingRequeueInterval
+	}
+	return r.InitializingRequeueInterval
+}
+
+// healthFailureThreshold returns the number of consecutive failed health checks that moves a ready instance to the
+// Failed phase.
+func (r *LlamaStackDistributionReconciler) healthFailureThreshold() int {
+	if r.HealthCheckFailureThreshold <= 0 {
+		return DefaultHealthCheckFailureThreshold
+	}
+	return r.HealthCheckFailureThreshold
+}
+
+// reconcileResult returns the metrics label of the outcome of a reconciliation.
+func reconcileResult(result ctrl.Result, err error) string {
+	switch {
+	case err != nil:
+		return metrics.ResultError
+	case result.Requeue || result.RequeueAfter > 0:
+		return metrics.ResultRequeue
+	default:
+		return metrics.ResultSuccess
+	}
+}
+
+// requeueForReconcileError decides how a failed reconciliation is retried.
+func requeueForReconcileError(err error) (ctrl.Result, error) {
+	switch {
+	case errors.Is(err, deploy.ErrImmutableField), errors.Is(err, deploy.ErrDeploymentInvalid), errors.Is(err, deploy.ErrRenderFailed):
+		// Retrying cannot fix a spec the API server or kustomize rejects, wait for the spec to change instead.
+		return ctrl.Result{}, reconcile.TerminalError(err)
+	case errors.Is(err, deploy.ErrDeploymentForbidden):
+		// Quotas and admission policies change out of band, so retry at a steady pace rather than backing off.
+		return ctrl.Result{RequeueAfter: deploymentForbiddenRequeueInterval}, nil
+	case errors.Is(err, deploy.ErrNotOwned):
+		// Removing the conflicting object triggers no event for this instance, so check again periodically.
+		return ctrl.Result{RequeueAfter: resourceNotOwnedRequeueInterval}, nil
+	case errors.Is(err, errInsufficientPermissions):
+		// Granting the permissions triggers no event for this instance, so recheck periodically rather than hot looping.
+		return ctrl.Result{RequeueAfter: insufficientPermissionsRequeueInterval}, nil
+	case errors.Is(err, errUserConfigMapDeleted):
+		// Recreating the ConfigMap triggers a reconciliation, recheck periodically in case the event is missed.
+		return ctrl.Result{RequeueAfter: userConfigMapDeletedRequeueInterval}, nil
+	case errors.Is(err, errStorageBackendChangeBlocked):
+		// The switch proceeds once the instance is annotated or the spec is reverted, both trigger a reconciliation.
+		return ctrl.Result{}, reconcile.TerminalError(err)
+	default:
+		// Conflicts, scope detection and API errors are usually transient, retry with backoff.
+		return ctrl.Result{}, err
+	}
+}
+
+// reconcileFailureReason maps a classified reconciliation failure to a condition reason and message.
+// The more specific classes are checked first, e.g. an immutable field change of the Deployment is also invalid.
+func reconcileFailureReason(err error) (string, string, bool) {
+	switch {
+	case errors.Is(err, deploy.ErrImmutableField):
+		return ReasonImmutableFieldChanged, MessageImmutableFieldChanged, true
+	case errors.Is(err, deploy.ErrDeploymentInvalid):
+		return ReasonDeploymentInvalid, MessageDeploymentInvalid, true
+	case errors.Is(err, deploy.ErrDeploymentForbidden):
+		return ReasonDeploymentForbidden, MessageDeploymentForbidden, true
+	case errors.Is(err, deploy.ErrDeploymentConflict):
+		return ReasonDeploymentConflict, MessageDeploymentConflict, true
+	case errors.Is(err, deploy.ErrRenderFailed):
+		return ReasonManifestRenderFailed, MessageManifestRenderFailed, true
+	case errors.Is(err, deploy.ErrNotOwned):
+		return ReasonResourceNotOwned, MessageResourceNotOwned, true
+	case errors.Is(err, errInsufficientPermissions):
+		return ReasonInsufficientPermissions, MessageInsufficientPermissions, true
+	case errors.Is(err, deploy.ErrScopeDetection):
+		return ReasonResourceScopeUnknown, MessageResourceScopeUnknown, true
+	default:
+		return "", "", false
+	}
+}
+
 // fetchInstance retrieves the LlamaStackDistribution instance.
 func (r *LlamaStackDistributionReconciler) fetchInstance(ctx context.Context, namespacedName types.NamespacedName) (*llamav1alpha1.LlamaStackDistribution, error) {
 	logger := log.FromContext(ctx)
@@ -232,11 +571,24 @@ func (r *LlamaStackDistributionReconciler) determineKindsToExclude(instance *lla
 		kinds = append(kinds, "NetworkPolicy")
 	}
 
+	// Exclude Ingress if it is not configured, like the NetworkPolicy it is built by its own reconciler when it is
+	if !instance.IsIngressEnabled() {
+		kinds = append(kinds, "Ingress")
+	}
+
 	// Exclude Service if no ports are defined
 	if !instance.HasPorts() {
 		kinds = append(kinds, "Service")
 	}
 
+	// Exclude ConfigMap if the dashboard is disabled; the dashboard is the only ConfigMap in the manifests
+	if !instance.IsDashboardEnabled() {
+		kinds = append(kinds, "ConfigMap")
+	}
+
+	// The ServiceAccount is managed by reconcileServiceAccount
+	kinds = append(kinds, "ServiceAccount")
+
 	return kinds
 }
 
@@ -254,15 +606,38 @@ func (r *LlamaStackDistributionReconciler) reconcileManifestResources(ctx contex
 		return fmt.Errorf("failed to filter manifests: %w", err)
 	}
 
-	if err := deploy.ApplyResources(ctx, r.Client, r.Scheme, instance, filteredResMap); err != nil {
+	if err := deploy.ApplyResources(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, filteredResMap); err != nil {
 		return fmt.Errorf("failed to apply manifests: %w", err)
 	}
 
+	if !instance.IsDashboardEnabled() {
+		if err := deploy.HandleDisabledDashboard(ctx, r.Client, instance, log.FromContext(ctx)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // reconcileResources reconciles all resources for the LlamaStackDistribution instance.
 func (r *LlamaStackDistributionReconciler) reconcileResources(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	// Release the PVC of an instance being deleted instead of reconciling its resources
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return r.reconcileDeletion(ctx, instance)
+	}
+
+	// Protect the PVC from garbage collection until the deletion of the instance is handled
+	if err := r.reconcileFinalizer(ctx, instance); err != nil {
+		return err
+	}
+
+	// Take over existing resources before they are reconciled
+	if deploy.IsAdoptionRequested(instance) {
+		if err := r.adoptExistingResources(ctx, instance); err != nil {
+			return err
+		}
+	}
+
 	// Reconcile ConfigMaps
 	if err := r.reconcileConfigMaps(ctx, instance); err != nil {
 		return err
@@ -273,6 +648,11 @@ func (r *LlamaStackDistributionReconciler) reconcileResources(ctx context.Contex
 		return err
 	}
 
+	// Reconcile the ServiceAccount before the resources binding or using it
+	if err := r.reconcileServiceAccount(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile ServiceAccount: %w", err)
+	}
+
 	// Reconcile manifest-based resources
 	if err := r.reconcileManifestResources(ctx, instance); err != nil {
 		return err
@@ -283,197 +663,536 @@ func (r *LlamaStackDistributionReconciler) reconcileResources(ctx context.Contex
 		return fmt.Errorf("failed to reconcile NetworkPolicy: %w", err)
 	}
 
+	// Reconcile the Ingress
+	if err := r.reconcileIngress(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile Ingress: %w", err)
+	}
+
+	// Reconcile the ServiceMonitor
+	if err := r.reconcileServiceMonitor(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile ServiceMonitor: %w", err)
+	}
+
+	// Reconcile the HorizontalPodAutoscaler
+	if err := r.reconcileAutoscaling(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile HorizontalPodAutoscaler: %w", err)
+	}
+
+	// Reconcile the PodDisruptionBudget
+	if err := r.reconcileDisruptionBudget(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile PodDisruptionBudget: %w", err)
+	}
+
 	// Reconcile the Deployment
 	if err := r.reconcileDeployment(ctx, instance); err != nil {
 		return fmt.Errorf("failed to reconcile Deployment: %w", err)
 	}
 
+	// Reconcile the maintenance CronJobs
+	if err := r.reconcileMaintenanceJobs(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile maintenance jobs: %w", err)
+	}
+
 	return nil
 }
 
-func (r *LlamaStackDistributionReconciler) reconcileConfigMaps(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
-	// Reconcile the ConfigMap if specified by the user
-	if r.hasUserConfigMap(instance) {
-		if err := r.reconcileUserConfigMap(ctx, instance); err != nil {
-			return fmt.Errorf("failed to reconcile user ConfigMap: %w", err)
-		}
+// reconcileServiceAccount manages the ServiceAccount of the server pods, annotated with the ServiceAccount
+// annotations of the pod overrides.
+func (r *LlamaStackDistributionReconciler) reconcileServiceAccount(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	sa := buildServiceAccount(instance)
+	return deploy.ApplyServiceAccount(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, sa, log.FromContext(ctx))
+}
+
+// reconcileIngress exposes the server through an Ingress, or through a Route on clusters serving the
+// OpenShift Route API or when the Route is requested, and reports whether it was admitted.
+func (r *LlamaStackDistributionReconciler) reconcileIngress(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+	routeAvailable := r.ClusterInfo != nil && r.ClusterInfo.RouteAvailable
+
+	if err := validateRoute(instance, routeAvailable); err != nil {
+		SetRouteReadyCondition(&instance.Status, false, false, err.Error())
+		return err
 	}
 
-	// Reconcile the CA bundle ConfigMap if specified
-	if r.hasCABundleConfigMap(instance) {
-		if err := r.reconcileCABundleConfigMap(ctx, instance); err != nil {
-			return fmt.Errorf("failed to reconcile CA bundle ConfigMap: %w", err)
+	if !instance.IsIngressEnabled() && !instance.IsRouteEnabled() {
+		RemoveCondition(&instance.Status, ConditionTypeIngressReady)
+		RemoveCondition(&instance.Status, ConditionTypeRouteReady)
+		instance.Status.ExternalURL = ""
+		if routeAvailable {
+			if err := deploy.HandleDisabledRoute(ctx, r.Client, instance, logger); err != nil {
+				return err
+			}
 		}
+		return deploy.HandleDisabledIngress(ctx, r.Client, instance, logger)
+	}
+
+	if routeAvailable {
+		return r.reconcileRoute(ctx, instance)
 	}
 
+	ingress := buildIngress(instance)
+	if err := deploy.ApplyIngress(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, ingress, logger); err != nil {
+		SetIngressReadyCondition(&instance.Status, false, false, fmt.Sprintf("Failed to apply Ingress: %v", err))
+		return err
+	}
+	SetIngressReadyCondition(&instance.Status, true, isIngressAdmitted(ingress), "")
+	instance.Status.ExternalURL = getIngressURL(instance, ingress)
 	return nil
 }
 
-func (r *LlamaStackDistributionReconciler) reconcileStorage(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
-	// Reconcile the PVC if storage is configured
-	if instance.Spec.Server.Storage != nil {
-		resMap, err := deploy.RenderManifest(filesys.MakeFsOnDisk(), manifestsBasePath, instance)
-		if err != nil {
-			return fmt.Errorf("failed to render PVC manifests: %w", err)
-		}
-		if err := deploy.ApplyResources(ctx, r.Client, r.Scheme, instance, resMap); err != nil {
-			return fmt.Errorf("failed to apply PVC manifests: %w", err)
-		}
+// reconcileRoute manages the OpenShift Route exposing the server and reports whether it was admitted.
+// An Ingress created before the Route API became available is removed.
+func (r *LlamaStackDistributionReconciler) reconcileRoute(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	RemoveCondition(&instance.Status, ConditionTypeIngressReady)
+	if err := deploy.HandleDisabledIngress(ctx, r.Client, instance, logger); err != nil {
+		return err
 	}
 
+	route := buildRoute(instance)
+	if err := deploy.ApplyRoute(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, route, logger); err != nil {
+		SetRouteReadyCondition(&instance.Status, false, false, fmt.Sprintf("Failed to apply Route: %v", err))
+		return err
+	}
+	host := getRouteHost(route)
+	SetRouteReadyCondition(&instance.Status, true, host != "", "")
+	instance.Status.ExternalURL = getRouteURL(instance, host)
 	return nil
 }
 
-// SetupWithManager sets up the controller with the Manager.
-func (r *LlamaStackDistributionReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	// Create a field indexer for ConfigMap references to improve performance
-	if err := r.createConfigMapFieldIndexer(ctx, mgr); err != nil {
-		return err
+// reconcileServiceMonitor manages the ServiceMonitor scraping the server metrics. Nothing is created on clusters
+// without the Prometheus Operator ServiceMonitor CRD.
+func (r *LlamaStackDistributionReconciler) reconcileServiceMonitor(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	if r.ClusterInfo == nil || !r.ClusterInfo.ServiceMonitorAvailable {
+		if instance.IsMetricsEnabled() {
+			logger.Info("Skipping ServiceMonitor, the Prometheus Operator ServiceMonitor CRD is not installed")
+		}
+		return nil
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&llamav1alpha1.LlamaStackDistribution{}, builder.WithPredicates(predicate.Funcs{
-			UpdateFunc: r.llamaStackUpdatePredicate(mgr),
-		})).
-		Owns(&appsv1.Deployment{}).
-		Owns(&corev1.Service{}).
-		Owns(&networkingv1.NetworkPolicy{}).
-		Owns(&corev1.PersistentVolumeClaim{}).
-		Watches(
-			&corev1.ConfigMap{},
-			handler.EnqueueRequestsFromMapFunc(r.findLlamaStackDistributionsForConfigMap),
-			builder.WithPredicates(predicate.Funcs{
-				UpdateFunc: r.configMapUpdatePredicate,
-				CreateFunc: r.configMapCreatePredicate,
-				DeleteFunc: r.configMapDeletePredicate,
-			}),
-		).
-		Complete(r)
+	if !instance.IsMetricsEnabled() {
+		return deploy.HandleDisabledServiceMonitor(ctx, r.Client, instance, logger)
+	}
+
+	serviceMonitor := buildServiceMonitor(instance)
+	return deploy.ApplyServiceMonitor(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, serviceMonitor, logger)
 }
 
-// createConfigMapFieldIndexer creates a field indexer for ConfigMap references.
-// On older Kubernetes versions that don't support custom field labels for custom resources,
-// this will fail gracefully and the operator will fall back to manual searching.
-func (r *LlamaStackDistributionReconciler) createConfigMapFieldIndexer(ctx context.Context, mgr ctrl.Manager) error {
-	// Create index for user config ConfigMaps
-	if err := mgr.GetFieldIndexer().IndexField(
-		ctx,
-		&llamav1alpha1.LlamaStackDistribution{},
-		"spec.server.userConfig.configMapName",
-		r.configMapIndexFunc,
-	); err != nil {
-		// Log warning but don't fail startup - older Kubernetes versions may not support this
-		mgr.GetLogger().V(1).Info("Field indexer for ConfigMap references not supported, will use manual search fallback",
-			"error", err.Error())
-		return nil
+// reconcileAutoscaling manages the HorizontalPodAutoscaler scaling the server Deployment.
+func (r *LlamaStackDistributionReconciler) reconcileAutoscaling(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	if !instance.IsAutoscalingEnabled() {
+		instance.Status.Autoscaling = nil
+		return deploy.HandleDisabledHorizontalPodAutoscaler(ctx, r.Client, instance, logger)
 	}
 
-	// Create index for CA bundle ConfigMaps
-	if err := mgr.GetFieldIndexer().IndexField(
-		ctx,
-		&llamav1alpha1.LlamaStackDistribution{},
-		"spec.server.tlsConfig.caBundle.configMapName",
-		r.caBundleConfigMapIndexFunc,
-	); err != nil {
-		// Log warning but don't fail startup - older Kubernetes versions may not support this
-		mgr.GetLogger().Info("Field indexer for CA bundle ConfigMap references not supported, will use manual search fallback",
-			"error", err.Error())
-		return nil
+	hpa := buildHorizontalPodAutoscaler(instance)
+	if err := deploy.ApplyHorizontalPodAutoscaler(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, hpa, logger); err != nil {
+		return err
 	}
 
-	mgr.GetLogger().V(1).Info("Successfully created field indexer for ConfigMap references - will use efficient lookups")
+	// The applied HorizontalPodAutoscaler carries the status last written by the autoscaler controller
+	instance.Status.Autoscaling = &llamav1alpha1.AutoscalingStatus{
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+	}
 	return nil
 }
 
-// configMapIndexFunc is the indexer function for ConfigMap references.
-func (r *LlamaStackDistributionReconciler) configMapIndexFunc(rawObj client.Object) []string {
-	llsd, ok := rawObj.(*llamav1alpha1.LlamaStackDistribution)
-	if !ok {
-		return nil
-	}
-	if !hasValidUserConfig(llsd) {
-		return nil
+// reconcileDisruptionBudget manages the PodDisruptionBudget limiting the voluntary disruptions of the server pods.
+func (r *LlamaStackDistributionReconciler) reconcileDisruptionBudget(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	budget := getDisruptionBudget(instance)
+	if budget == nil {
+		return deploy.HandleDisabledPodDisruptionBudget(ctx, r.Client, instance, logger)
 	}
 
-	// Create index key as "namespace/name" format
-	configMapNamespace := getUserConfigMapNamespaceStandalone(llsd)
-	indexKey := fmt.Sprintf("%s/%s", configMapNamespace, llsd.Spec.Server.UserConfig.ConfigMapName)
-	return []string{indexKey}
+	pdb := buildPodDisruptionBudget(instance, budget)
+	return deploy.ApplyPodDisruptionBudget(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, pdb, logger)
 }
 
-// caBundleConfigMapIndexFunc is the indexer function for CA bundle ConfigMap references.
-func (r *LlamaStackDistributionReconciler) caBundleConfigMapIndexFunc(rawObj client.Object) []string {
-	llsd, ok := rawObj.(*llamav1alpha1.LlamaStackDistribution)
-	if !ok {
-		return nil
+// reconcileMaintenanceJobs manages the CronJobs of the maintenance jobs and reports their last run in the status.
+func (r *LlamaStackDistributionReconciler) reconcileMaintenanceJobs(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	jobNames := make([]string, 0, len(instance.Spec.Server.MaintenanceJobs))
+	for _, job := range instance.Spec.Server.MaintenanceJobs {
+		jobNames = append(jobNames, job.Name)
+	}
+	if err := deploy.DeleteStaleCronJobs(ctx, r.Client, instance, jobNames, logger); err != nil {
+		return err
 	}
-	if !hasValidCABundleConfig(llsd) {
+	if len(instance.Spec.Server.MaintenanceJobs) == 0 {
+		instance.Status.MaintenanceJobs = nil
 		return nil
 	}
 
-	// Create index key as "namespace/name" format
-	configMapNamespace := getCABundleConfigMapNamespaceStandalone(llsd)
-	indexKey := fmt.Sprintf("%s/%s", configMapNamespace, llsd.Spec.Server.TLSConfig.CABundle.ConfigMapName)
-	return []string{indexKey}
-}
-
-// llamaStackUpdatePredicate returns a predicate function for LlamaStackDistribution updates.
-func (r *LlamaStackDistributionReconciler) llamaStackUpdatePredicate(mgr ctrl.Manager) func(event.UpdateEvent) bool {
-	return func(e event.UpdateEvent) bool {
-		// Safely type assert old object
-		oldObj, ok := e.ObjectOld.(*llamav1alpha1.LlamaStackDistribution)
-		if !ok {
-			return false
-		}
-		oldObjCopy := oldObj.DeepCopy()
+	serverImage, err := r.resolveImage(instance.Spec.Server.Distribution)
+	if err != nil {
+		return err
+	}
 
-		// Safely type assert new object
-		newObj, ok := e.ObjectNew.(*llamav1alpha1.LlamaStackDistribution)
-		if !ok {
-			return false
+	statuses := make([]llamav1alpha1.MaintenanceJobStatus, 0, len(instance.Spec.Server.MaintenanceJobs))
+	for _, job := range instance.Spec.Server.MaintenanceJobs {
+		cronJob := buildMaintenanceCronJob(instance, job, serverImage, r.initContainerImage(instance))
+		if err := deploy.ApplyCronJob(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, cronJob, logger); err != nil {
+			return fmt.Errorf("failed to apply maintenance job %s: %w", job.Name, err)
 		}
-		newObjCopy := newObj.DeepCopy()
+		statuses = append(statuses, getMaintenanceJobStatus(job.Name, cronJob))
+	}
+	instance.Status.MaintenanceJobs = statuses
+	return nil
+}
 
-		// Compare only spec, ignoring metadata and status
-		if diff := cmp.Diff(oldObjCopy.Spec, newObjCopy.Spec); diff != "" {
-			logger := mgr.GetLogger().WithValues("namespace", newObjCopy.Namespace, "name", newObjCopy.Name)
-			logger.Info("LlamaStackDistribution CR spec changed")
-			// Note that both the logger and fmt.Printf could appear entangled in the output
-			// but there is no simple way to avoid this (forcing the logger to flush its output).
-			// When the logger is used to print the diff the output is hard to read,
-			// fmt.Printf is better for readability.
-			fmt.Printf("%s\n", diff)
+// adoptExistingResources takes over the resources of a deployment created without the operator and reports
+// each adopted resource with an Event.
+func (r *LlamaStackDistributionReconciler) adoptExistingResources(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+	adopted, err := deploy.AdoptExistingResources(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance)
+	for _, resource := range adopted {
+		if resource.Recreated {
+			logger.Info("Deleted existing resource with incompatible selector to recreate it", "kind", resource.Kind, "name", resource.Name)
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonResourceRecreated,
+				fmt.Sprintf("Deleted existing %s %s with an incompatible selector, it is recreated by the operator", resource.Kind, resource.Name))
+			continue
 		}
-
-		return true
+		logger.Info("Adopted existing resource", "kind", resource.Kind, "name", resource.Name)
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonResourceAdopted,
+			fmt.Sprintf("Adopted existing %s %s", resource.Kind, resource.Name))
+	}
+	if err != nil {
+		r.recordEvent(instance, corev1.EventTypeWarning, EventReasonAdoptionFailed, err.Error())
+		return fmt.Errorf("failed to adopt existing resources: %w", err)
 	}
+	return nil
 }
 
-// configMapUpdatePredicate handles ConfigMap update events.
-func (r *LlamaStackDistributionReconciler) configMapUpdatePredicate(e event.UpdateEvent) bool {
-	oldConfigMap, oldOk := e.ObjectOld.(*corev1.ConfigMap)
-	newConfigMap, newOk := e.ObjectNew.(*corev1.ConfigMap)
-
-	if !oldOk || !newOk {
-		return false
+// reconcileFinalizer adds the cluster resource cleanup finalizer, and the PVC protection finalizer to an instance
+// with storage, removing the latter once the storage is removed from the spec.
+func (r *LlamaStackDistributionReconciler) reconcileFinalizer(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	changed := controllerutil.AddFinalizer(instance, llamav1alpha1.ClusterResourceCleanupFinalizer)
+	if instance.Spec.Server.Storage != nil {
+		changed = controllerutil.AddFinalizer(instance, llamav1alpha1.PVCProtectionFinalizer) || changed
+	} else {
+		changed = controllerutil.RemoveFinalizer(instance, llamav1alpha1.PVCProtectionFinalizer) || changed
+	}
+	if !changed {
+		return nil
 	}
 
-	// Only proceed if this ConfigMap is referenced by any LlamaStackDistribution
-	if !r.isConfigMapReferenced(newConfigMap) {
-		return false
+	if err := r.Update(ctx, instance); err != nil {
+		return fmt.Errorf("failed to update finalizers: %w", err)
 	}
+	log.FromContext(ctx).V(1).Info("Updated finalizers", "finalizers", instance.GetFinalizers())
+	return nil
+}
 
-	// Only trigger if Data or BinaryData has changed
-	dataChanged := !cmp.Equal(oldConfigMap.Data, newConfigMap.Data)
-	binaryDataChanged := !cmp.Equal(oldConfigMap.BinaryData, newConfigMap.BinaryData)
+// reconcileDeletion releases the resources of an instance being deleted and then removes its finalizers so that
+// the deletion proceeds. The PVC is deleted or retained depending on the RetainPVCAnnotation, and the
+// cluster-scoped resources created for the instance are deleted.
+func (r *LlamaStackDistributionReconciler) reconcileDeletion(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	var changed bool
 
-	// Log ConfigMap changes for debugging (only for referenced ConfigMaps)
-	if dataChanged || binaryDataChanged {
-		r.logConfigMapDiff(oldConfigMap, newConfigMap, dataChanged, binaryDataChanged)
+	if controllerutil.ContainsFinalizer(instance, llamav1alpha1.ClusterResourceCleanupFinalizer) {
+		deleted, err := deploy.DeleteClusterScopedResources(ctx, r.Client, instance, log.FromContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to delete cluster-scoped resources: %w", err)
+		}
+		for _, name := range deleted {
+			r.recordEvent(instance, corev1.EventTypeNormal, EventReasonClusterRoleBindingDeleted,
+				fmt.Sprintf("Deleted ClusterRoleBinding %s", name))
+		}
+		changed = controllerutil.RemoveFinalizer(instance, llamav1alpha1.ClusterResourceCleanupFinalizer)
 	}
 
-	return dataChanged || binaryDataChanged
-}
+	if controllerutil.ContainsFinalizer(instance, llamav1alpha1.PVCProtectionFinalizer) {
+		retain := deploy.IsPVCRetentionRequested(instance)
+		released, err := deploy.ReleasePVC(ctx, r.Client, instance, retain, log.FromContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to release PVC: %w", err)
+		}
+		if released {
+			if retain {
+				r.recordEvent(instance, corev1.EventTypeNormal, EventReasonPVCRetained,
+					fmt.Sprintf("Retained PVC %s, it must be deleted manually", deploy.GetPVCName(instance)))
+			} else {
+				r.recordEvent(instance, corev1.EventTypeNormal, EventReasonPVCDeleted,
+					fmt.Sprintf("Deleted PVC %s", deploy.GetPVCName(instance)))
+			}
+		}
+		changed = controllerutil.RemoveFinalizer(instance, llamav1alpha1.PVCProtectionFinalizer) || changed
+	}
+
+	if !changed {
+		return nil
+	}
+	if err := r.Update(ctx, instance); err != nil {
+		return fmt.Errorf("failed to remove finalizers: %w", err)
+	}
+	return nil
+}
+
+// recordEvent emits an Event on the instance if a recorder is configured.
+func (r *LlamaStackDistributionReconciler) recordEvent(instance *llamav1alpha1.LlamaStackDistribution, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(instance, eventType, reason, message)
+	}
+}
+
+func (r *LlamaStackDistributionReconciler) reconcileConfigMaps(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	// Reconcile the ConfigMap if specified by the user
+	if r.hasUserConfigMap(instance) {
+		if err := r.reconcileUserConfigMap(ctx, instance); err != nil {
+			return fmt.Errorf("failed to reconcile user ConfigMap: %w", err)
+		}
+	} else {
+		RemoveCondition(&instance.Status, ConditionTypeUserConfigReady)
+		RemoveCondition(&instance.Status, ConditionTypeInsufficientPermissions)
+	}
+
+	// Check the Secret whose changes restart the pods if specified by the user
+	if err := r.reconcileUserSecret(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile user Secret: %w", err)
+	}
+
+	// Check the Secret holding the serving certificate if specified by the user
+	if err := r.reconcileServerCertSecret(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile server certificate Secret: %w", err)
+	}
+
+	// Warn about image pull Secrets that do not exist
+	if err := r.checkImagePullSecrets(ctx, instance); err != nil {
+		return err
+	}
+
+	// Reconcile the CA bundle, explicitly configured or auto-detected
+	if err := r.reconcileCABundle(ctx, instance); err != nil {
+		return fmt.Errorf("failed to reconcile CA bundle ConfigMap: %w", err)
+	}
+
+	// Warn if changes to the referenced ConfigMaps are not detected
+	if r.ConfigMapWatchNamespaces.IsZero() {
+		RemoveCondition(&instance.Status, ConditionTypeConfigMapWatched)
+	} else {
+		SetConfigMapWatchedCondition(&instance.Status, r.unwatchedConfigMapNamespaces(instance))
+	}
+
+	return nil
+}
+
+func (r *LlamaStackDistributionReconciler) reconcileStorage(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	// Switching between emptyDir and a PVC loses the data, so it has to be acknowledged
+	if err := r.checkStorageBackendChange(ctx, instance); err != nil {
+		return err
+	}
+
+	// Reconcile the PVC if storage is configured
+	if instance.Spec.Server.Storage != nil {
+		if err := validateStorageSize(instance, r.MinStorageSize); err != nil {
+			SetStorageReadyCondition(&instance.Status, false, err.Error())
+			return err
+		}
+		if warning := storageSizeWarning(instance); warning != "" {
+			log.FromContext(ctx).Info(warning, "size", instance.Spec.Server.Storage.Size.String())
+		}
+		if warning := storageAccessModeWarning(instance); warning != "" {
+			log.FromContext(ctx).Info(warning, "accessModes", instance.Spec.Server.Storage.AccessModes, "replicas", instance.Spec.Replicas)
+		}
+		resMap, err := deploy.RenderManifest(filesys.MakeFsOnDisk(), manifestsBasePath, instance)
+		if err != nil {
+			return fmt.Errorf("failed to render PVC manifests: %w", err)
+		}
+		filteredResMap, err := deploy.FilterExcludeKinds(resMap, r.determineKindsToExclude(instance))
+		if err != nil {
+			return fmt.Errorf("failed to filter PVC manifests: %w", err)
+		}
+		if err := deploy.ApplyResources(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, filteredResMap); err != nil {
+			return fmt.Errorf("failed to apply PVC manifests: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkStorageBackendChange detects a switch of the storage between emptyDir and a PVC, which does not migrate
+// the data, and blocks it unless the instance acknowledges the change.
+func (r *LlamaStackDistributionReconciler) checkStorageBackendChange(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	existing := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			RemoveCondition(&instance.Status, ConditionTypeStorageBackendChanged)
+			return nil
+		}
+		return fmt.Errorf("failed to fetch deployment for storage backend check: %w", err)
+	}
+
+	current := deploymentStorageBackend(existing)
+	desired := desiredStorageBackend(instance)
+	if current == "" || current == desired {
+		RemoveCondition(&instance.Status, ConditionTypeStorageBackendChanged)
+		return nil
+	}
+
+	acknowledged := isStorageChangeAcknowledged(instance, current, desired)
+	SetStorageBackendChangedCondition(&instance.Status, current, desired, acknowledged)
+	if !acknowledged {
+		return fmt.Errorf("failed to change storage backend from %s to %s, the data is not migrated, set the %s annotation to %q to proceed: %w",
+			current, desired, llamav1alpha1.AcknowledgeStorageChangeAnnotation, storageChangeAcknowledgment(current, desired),
+			errStorageBackendChangeBlocked)
+	}
+
+	log.FromContext(ctx).Info("Changing storage backend, the data is not migrated", "from", current, "to", desired)
+	r.recordEvent(instance, corev1.EventTypeWarning, EventReasonStorageBackendChanged,
+		fmt.Sprintf("Storage backend changed from %s to %s, the data is not migrated", current, desired))
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *LlamaStackDistributionReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	// Create field indexers for the referenced objects to improve performance
+	if err := r.createReferenceFieldIndexers(ctx, mgr); err != nil {
+		return err
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&llamav1alpha1.LlamaStackDistribution{}, builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: r.llamaStackUpdatePredicate(mgr),
+		})).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.Service{}).
+		Owns(&corev1.ServiceAccount{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&networkingv1.Ingress{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&batchv1.CronJob{})
+
+	// Routes can only be watched on clusters serving the OpenShift Route API
+	if r.ClusterInfo != nil && r.ClusterInfo.RouteAvailable {
+		controllerBuilder = controllerBuilder.Owns(deploy.NewRoute())
+	}
+
+	// ServiceMonitors can only be watched on clusters with the Prometheus Operator CRDs
+	if r.ClusterInfo != nil && r.ClusterInfo.ServiceMonitorAvailable {
+		controllerBuilder = controllerBuilder.Owns(deploy.NewServiceMonitor())
+	}
+
+	return controllerBuilder.
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.findLlamaStackDistributionsForConfigMap),
+			builder.WithPredicates(predicate.NewPredicateFuncs(r.configMapNamespacePredicate), predicate.Funcs{
+				UpdateFunc: r.configMapUpdatePredicate,
+				CreateFunc: r.configMapCreatePredicate,
+				DeleteFunc: r.configMapDeletePredicate,
+			}),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.findLlamaStackDistributionsForDistributionsConfigMap),
+			builder.WithPredicates(predicate.NewPredicateFuncs(r.isDistributionsConfigMap)),
+		).
+		// Only the metadata of the Secrets is cached, the referenced Secrets are read from the API server
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findLlamaStackDistributionsForSecret),
+			builder.OnlyMetadata,
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: r.secretUpdatePredicate,
+				CreateFunc: r.secretCreatePredicate,
+				DeleteFunc: r.secretDeletePredicate,
+			}),
+		).
+		Complete(r)
+}
+
+// createReferenceFieldIndexers creates the field indexers for the objects referenced by the LlamaStackDistributions.
+// On older Kubernetes versions that don't support custom field labels for custom resources,
+// this will fail gracefully and the operator will fall back to manual searching.
+func (r *LlamaStackDistributionReconciler) createReferenceFieldIndexers(ctx context.Context, mgr ctrl.Manager) error {
+	if err := refindex.Register(ctx, mgr.GetFieldIndexer()); err != nil {
+		// Log warning but don't fail startup - older Kubernetes versions may not support this
+		mgr.GetLogger().Info("Field indexers for references not supported, will use manual search fallback",
+			"error", err.Error())
+		return nil
+	}
+
+	mgr.GetLogger().V(1).Info("Successfully created field indexers for references - will use efficient lookups")
+	return nil
+}
+
+// llamaStackUpdatePredicate returns a predicate function for LlamaStackDistribution updates.
+func (r *LlamaStackDistributionReconciler) llamaStackUpdatePredicate(mgr ctrl.Manager) func(event.UpdateEvent) bool {
+	return func(e event.UpdateEvent) bool {
+		// Safely type assert old object
+		oldObj, ok := e.ObjectOld.(*llamav1alpha1.LlamaStackDistribution)
+		if !ok {
+			return false
+		}
+		oldObjCopy := oldObj.DeepCopy()
+
+		// Safely type assert new object
+		newObj, ok := e.ObjectNew.(*llamav1alpha1.LlamaStackDistribution)
+		if !ok {
+			return false
+		}
+		newObjCopy := newObj.DeepCopy()
+
+		// The deletion of an instance with finalizers is handled by the reconciliation
+		if oldObjCopy.GetDeletionTimestamp().IsZero() && !newObjCopy.GetDeletionTimestamp().IsZero() {
+			mgr.GetLogger().Info("LlamaStackDistribution CR marked for deletion",
+				"namespace", newObjCopy.Namespace, "name", newObjCopy.Name, "finalizers", newObjCopy.GetFinalizers())
+		}
+
+		// Every update is let through, so that setting or removing the pause annotation pauses or resumes the
+		// reconciliation. Compare only spec, ignoring metadata and status
+		if diff := cmp.Diff(oldObjCopy.Spec, newObjCopy.Spec); diff != "" {
+			logger := mgr.GetLogger().WithValues("namespace", newObjCopy.Namespace, "name", newObjCopy.Name)
+			logger.Info("LlamaStackDistribution CR spec changed")
+			// Note that both the logger and fmt.Printf could appear entangled in the output
+			// but there is no simple way to avoid this (forcing the logger to flush its output).
+			// When the logger is used to print the diff the output is hard to read,
+			// fmt.Printf is better for readability.
+			fmt.Printf("%s\n", diff)
+		}
+
+		return true
+	}
+}
+
+// configMapUpdatePredicate handles ConfigMap update events.
+func (r *LlamaStackDistributionReconciler) configMapUpdatePredicate(e event.UpdateEvent) bool {
+	metrics.RecordConfigMapWatchEvent()
+
+	oldConfigMap, oldOk := e.ObjectOld.(*corev1.ConfigMap)
+	newConfigMap, newOk := e.ObjectNew.(*corev1.ConfigMap)
+
+	if !oldOk || !newOk {
+		return false
+	}
+
+	// Only proceed if this ConfigMap is referenced by any LlamaStackDistribution
+	if !r.isConfigMapReferenced(newConfigMap) {
+		return false
+	}
+
+	// Only trigger if Data or BinaryData has changed
+	dataChanged := !cmp.Equal(oldConfigMap.Data, newConfigMap.Data)
+	binaryDataChanged := !cmp.Equal(oldConfigMap.BinaryData, newConfigMap.BinaryData)
+
+	// Log ConfigMap changes for debugging (only for referenced ConfigMaps)
+	if dataChanged || binaryDataChanged {
+		r.logConfigMapDiff(oldConfigMap, newConfigMap, dataChanged, binaryDataChanged)
+	}
+
+	return dataChanged || binaryDataChanged
+}
 
 // logConfigMapDiff logs the differences between old and new ConfigMaps.
 func (r *LlamaStackDistributionReconciler) logConfigMapDiff(oldConfigMap, newConfigMap *corev1.ConfigMap, dataChanged, binaryDataChanged bool) {
@@ -500,6 +1219,8 @@ func (r *LlamaStackDistributionReconciler) logConfigMapDiff(oldConfigMap, newCon
 
 // configMapCreatePredicate handles ConfigMap create events.
 func (r *LlamaStackDistributionReconciler) configMapCreatePredicate(e event.CreateEvent) bool {
+	metrics.RecordConfigMapWatchEvent()
+
 	configMap, ok := e.Object.(*corev1.ConfigMap)
 	if !ok {
 		return false
@@ -518,16 +1239,17 @@ func (r *LlamaStackDistributionReconciler) configMapCreatePredicate(e event.Crea
 
 // configMapDeletePredicate handles ConfigMap delete events.
 func (r *LlamaStackDistributionReconciler) configMapDeletePredicate(e event.DeleteEvent) bool {
+	metrics.RecordConfigMapWatchEvent()
+
 	configMap, ok := e.Object.(*corev1.ConfigMap)
 	if !ok {
 		return false
 	}
 
 	isReferenced := r.isConfigMapReferenced(configMap)
-	// Log delete events for referenced ConfigMaps - this is critical for deployment health
+	// The instances report the deletion in their UserConfigReady condition and keep their pods running
 	if isReferenced {
-		log.FromContext(context.Background()).Error(nil,
-			"CRITICAL: ConfigMap delete event detected for referenced ConfigMap - this will break dependent deployments",
+		log.FromContext(context.Background()).Info("Referenced ConfigMap deleted, reconciling the instances using it",
 			"configMapName", configMap.Name,
 			"configMapNamespace", configMap.Namespace)
 	}
@@ -541,42 +1263,25 @@ func (r *LlamaStackDistributionReconciler) isConfigMapReferenced(configMap clien
 		"configMapName", configMap.GetName(),
 		"configMapNamespace", configMap.GetNamespace())
 
-	// Use field indexer for efficient lookup - create the same index key format
-	indexKey := fmt.Sprintf("%s/%s", configMap.GetNamespace(), configMap.GetName())
-
-	// Check for user config ConfigMap references
-	userConfigLlamaStacks := llamav1alpha1.LlamaStackDistributionList{}
-	err := r.List(context.Background(), &userConfigLlamaStacks, client.MatchingFields{"spec.server.userConfig.configMapName": indexKey})
-	if err != nil {
-		// Field indexer failed (likely due to older Kubernetes version not supporting custom field labels)
-		// Fall back to a manual check instead of assuming all ConfigMaps are referenced
-		logger.V(1).Info("Field indexer not supported, falling back to manual ConfigMap reference check", "error", err.Error())
-		return r.manuallyCheckConfigMapReference(configMap)
-	}
-
-	found := len(userConfigLlamaStacks.Items) > 0
+	// Use field indexer for efficient lookup - the key is computed as the index functions compute it
+	indexKey := refindex.KeyForObject(configMap)
 
-	// Check for CA bundle ConfigMap references if not found in user config
-	if !found {
-		caBundleLlamaStacks := llamav1alpha1.LlamaStackDistributionList{}
-		err := r.List(context.Background(), &caBundleLlamaStacks, client.MatchingFields{"spec.server.tlsConfig.caBundle.configMapName": indexKey})
-		if err != nil {
-			// Field indexer failed for CA bundle, fall back to manual check
-			logger.Info("CA bundle field indexer not supported, falling back to manual ConfigMap reference check", "error", err.Error())
+	for _, index := range refindex.ConfigMapIndexes {
+		llamaStacks := llamav1alpha1.LlamaStackDistributionList{}
+		if err := r.List(context.Background(), &llamaStacks, client.MatchingFields{index.Name: indexKey}); err != nil {
+			// Field indexer failed (likely due to older Kubernetes version not supporting custom field labels)
+			// Fall back to a manual check instead of assuming all ConfigMaps are referenced
+			logger.V(1).Info("Field indexer not supported, falling back to manual ConfigMap reference check",
+				"index", index.Name, "error", err.Error())
 			return r.manuallyCheckConfigMapReference(configMap)
 		}
-		found = len(caBundleLlamaStacks.Items) > 0
-	}
-
-	if !found {
-		// Fallback: manually check all LlamaStackDistributions
-		manuallyFound := r.manuallyCheckConfigMapReference(configMap)
-		if manuallyFound {
+		if len(llamaStacks.Items) > 0 {
 			return true
 		}
 	}
 
-	return found
+	// Fallback: manually check all LlamaStackDistributions
+	return r.manuallyCheckConfigMapReference(configMap)
 }
 
 // manuallyCheckConfigMapReference manually checks if any LlamaStackDistribution references the given ConfigMap.
@@ -592,28 +1297,10 @@ func (r *LlamaStackDistributionReconciler) manuallyCheckConfigMapReference(confi
 		return true // Return true to trigger reconciliation when we can't determine reference status
 	}
 
-	targetNamespace := configMap.GetNamespace()
-	targetName := configMap.GetName()
-
 	for _, ls := range allLlamaStacks.Items {
-		// Check user config ConfigMap references
-		if hasValidUserConfig(&ls) {
-			configMapNamespace := getUserConfigMapNamespaceStandalone(&ls)
-
-			if configMapNamespace == targetNamespace && ls.Spec.Server.UserConfig.ConfigMapName == targetName {
-				// found a LlamaStackDistribution that references the ConfigMap
-				return true
-			}
-		}
-
-		// Check CA bundle ConfigMap references
-		if hasValidCABundleConfig(&ls) {
-			configMapNamespace := getCABundleConfigMapNamespaceStandalone(&ls)
-
-			if configMapNamespace == targetNamespace && ls.Spec.Server.TLSConfig.CABundle.ConfigMapName == targetName {
-				// found a LlamaStackDistribution that references the CA bundle ConfigMap
-				return true
-			}
+		if r.doesLlamaStackReferenceConfigMap(ls, configMap.GetNamespace(), configMap.GetName()) {
+			// found a LlamaStackDistribution that references the ConfigMap
+			return true
 		}
 	}
 
@@ -642,30 +1329,19 @@ func (r *LlamaStackDistributionReconciler) tryFieldIndexerLookup(ctx context.Con
 		"configMapName", configMap.GetName(),
 		"configMapNamespace", configMap.GetNamespace())
 
-	indexKey := fmt.Sprintf("%s/%s", configMap.GetNamespace(), configMap.GetName())
+	indexKey := refindex.KeyForObject(configMap)
 
-	// Check for user config ConfigMap references
-	userConfigLlamaStacks := llamav1alpha1.LlamaStackDistributionList{}
-	err := r.List(ctx, &userConfigLlamaStacks, client.MatchingFields{"spec.server.userConfig.configMapName": indexKey})
-	if err != nil {
-		logger.V(1).Info("Field indexer not supported, will fall back to a manual search for ConfigMap event processing",
-			"indexKey", indexKey, "error", err.Error())
-		return userConfigLlamaStacks, false
-	}
-
-	// Check for CA bundle ConfigMap references
-	caBundleLlamaStacks := llamav1alpha1.LlamaStackDistributionList{}
-	err = r.List(ctx, &caBundleLlamaStacks, client.MatchingFields{"spec.server.tlsConfig.caBundle.configMapName": indexKey})
-	if err != nil {
-		logger.Info("CA bundle field indexer not supported, will fall back to a manual search for ConfigMap event processing",
-			"indexKey", indexKey, "error", err.Error())
-		return userConfigLlamaStacks, len(userConfigLlamaStacks.Items) > 0
-	}
-
-	// Combine results from both searches
+	// Combine the references of all kinds, an instance referencing the ConfigMap twice is reconciled once anyway
 	combinedLlamaStacks := llamav1alpha1.LlamaStackDistributionList{}
-	combinedLlamaStacks.Items = append(combinedLlamaStacks.Items, userConfigLlamaStacks.Items...)
-	combinedLlamaStacks.Items = append(combinedLlamaStacks.Items, caBundleLlamaStacks.Items...)
+	for _, index := range refindex.ConfigMapIndexes {
+		llamaStacks := llamav1alpha1.LlamaStackDistributionList{}
+		if err := r.List(ctx, &llamaStacks, client.MatchingFields{index.Name: indexKey}); err != nil {
+			logger.V(1).Info("Field indexer not supported, will fall back to a manual search for ConfigMap event processing",
+				"index", index.Name, "indexKey", indexKey, "error", err.Error())
+			return combinedLlamaStacks, len(combinedLlamaStacks.Items) > 0
+		}
+		combinedLlamaStacks.Items = append(combinedLlamaStacks.Items, llamaStacks.Items...)
+	}
 
 	return combinedLlamaStacks, len(combinedLlamaStacks.Items) > 0
 }
@@ -708,23 +1384,106 @@ func (r *LlamaStackDistributionReconciler) filterLlamaStacksForConfigMap(llamaSt
 
 // doesLlamaStackReferenceConfigMap checks if a LlamaStackDistribution references the specified ConfigMap.
 func (r *LlamaStackDistributionReconciler) doesLlamaStackReferenceConfigMap(ls llamav1alpha1.LlamaStackDistribution, targetNamespace, targetName string) bool {
-	// Check user config ConfigMap references
-	if hasValidUserConfig(&ls) {
-		configMapNamespace := getUserConfigMapNamespaceStandalone(&ls)
-		if configMapNamespace == targetNamespace && ls.Spec.Server.UserConfig.ConfigMapName == targetName {
-			return true
-		}
+	return slices.Contains(refindex.ConfigMapKeys(&ls), refindex.Key(targetNamespace, targetName))
+}
+
+// secretUpdatePredicate handles Secret update events. The Secrets are watched through their metadata, so any
+// change of the resource version of a referenced Secret triggers a reconciliation, which restarts the pods only if
+// the hash of the data changed.
+func (r *LlamaStackDistributionReconciler) secretUpdatePredicate(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
 	}
 
-	// Check CA bundle ConfigMap references
-	if hasValidCABundleConfig(&ls) {
-		configMapNamespace := getCABundleConfigMapNamespaceStandalone(&ls)
-		if configMapNamespace == targetNamespace && ls.Spec.Server.TLSConfig.CABundle.ConfigMapName == targetName {
-			return true
+	// Only proceed if this Secret is referenced by any LlamaStackDistribution
+	if !r.isSecretReferenced(e.ObjectNew) {
+		return false
+	}
+
+	// Periodic resyncs deliver the same resource version
+	changed := e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+	if changed {
+		log.FromContext(context.Background()).Info("Referenced Secret change detected",
+			"secretName", e.ObjectNew.GetName(),
+			"secretNamespace", e.ObjectNew.GetNamespace())
+	}
+
+	return changed
+}
+
+// secretCreatePredicate handles Secret create events.
+func (r *LlamaStackDistributionReconciler) secretCreatePredicate(e event.CreateEvent) bool {
+	if e.Object == nil {
+		return false
+	}
+
+	return r.isSecretReferenced(e.Object)
+}
+
+// secretDeletePredicate handles Secret delete events.
+func (r *LlamaStackDistributionReconciler) secretDeletePredicate(e event.DeleteEvent) bool {
+	if e.Object == nil {
+		return false
+	}
+
+	isReferenced := r.isSecretReferenced(e.Object)
+	if isReferenced {
+		log.FromContext(context.Background()).Error(nil,
+			"Secret delete event detected for referenced Secret - dependent deployments will fail to reconcile",
+			"secretName", e.Object.GetName(),
+			"secretNamespace", e.Object.GetNamespace())
+	}
+
+	return isReferenced
+}
+
+// isSecretReferenced checks if a Secret is referenced by the secretRef or the serving certificate of any
+// LlamaStackDistribution.
+func (r *LlamaStackDistributionReconciler) isSecretReferenced(secret client.Object) bool {
+	return len(r.findLlamaStackDistributionsForSecret(context.Background(), secret)) > 0
+}
+
+// findLlamaStackDistributionsForSecret maps Secret changes to LlamaStackDistribution reconcile requests.
+func (r *LlamaStackDistributionReconciler) findLlamaStackDistributionsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx).WithValues(
+		"secretName", secret.GetName(),
+		"secretNamespace", secret.GetNamespace())
+
+	indexKey := refindex.KeyForObject(secret)
+	llamaStacks := llamav1alpha1.LlamaStackDistributionList{}
+	for _, index := range refindex.WatchedSecretIndexes {
+		referencing := llamav1alpha1.LlamaStackDistributionList{}
+		if err := r.List(ctx, &referencing, client.MatchingFields{index.Name: indexKey}); err != nil {
+			logger.V(1).Info("Field indexer not supported, will fall back to a manual search for Secret event processing",
+				"index", index.Name, "indexKey", indexKey, "error", err.Error())
+			return r.findLlamaStackDistributionsForSecretManually(ctx, secret)
+		}
+		// An instance using the Secret for several purposes is only reconciled once
+		for _, item := range referencing.Items {
+			if !slices.ContainsFunc(llamaStacks.Items, func(ls llamav1alpha1.LlamaStackDistribution) bool { return ls.Name == item.Name }) {
+				llamaStacks.Items = append(llamaStacks.Items, item)
+			}
 		}
 	}
 
-	return false
+	return r.convertToReconcileRequests(llamaStacks)
+}
+
+// findLlamaStackDistributionsForSecretManually lists the LlamaStackDistributions of the namespace of the Secret and
+// filters those referencing it, for when the field indexes are not available.
+func (r *LlamaStackDistributionReconciler) findLlamaStackDistributionsForSecretManually(ctx context.Context, secret client.Object) []reconcile.Request {
+	// The Secret is always in the namespace of the instances referencing it
+	llamaStacks := llamav1alpha1.LlamaStackDistributionList{}
+	if err := r.List(ctx, &llamaStacks, client.InNamespace(secret.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "CRITICAL: Failed to list LlamaStackDistributions for manual Secret reference search",
+			"secretName", secret.GetName(), "secretNamespace", secret.GetNamespace())
+		return nil
+	}
+	indexKey := refindex.KeyForObject(secret)
+	llamaStacks.Items = slices.DeleteFunc(llamaStacks.Items, func(ls llamav1alpha1.LlamaStackDistribution) bool {
+		return !slices.Contains(refindex.WatchedSecretKeys(&ls), indexKey)
+	})
+	return r.convertToReconcileRequests(llamaStacks)
 }
 
 // convertToReconcileRequests converts LlamaStackDistribution items to reconcile requests.
@@ -741,57 +1500,73 @@ func (r *LlamaStackDistributionReconciler) convertToReconcileRequests(attachedLl
 	return requests
 }
 
-// reconcileDeployment manages the Deployment for the LlamaStack server.
-func (r *LlamaStackDistributionReconciler) reconcileDeployment(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+// validateServerSpec validates the server spec before the Deployment is built and logs the settings that are valid
+// but likely to fail at runtime.
+func (r *LlamaStackDistributionReconciler) validateServerSpec(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
 	logger := log.FromContext(ctx)
 
 	// Validate distribution configuration
 	if err := r.validateDistribution(instance); err != nil {
+		r.recordEvent(instance, corev1.EventTypeWarning, EventReasonDistributionNotSupported, err.Error())
 		return err
 	}
 
-	// Get the image either from the map or direct reference
-	resolvedImage, err := r.resolveImage(instance.Spec.Server.Distribution)
-	if err != nil {
+	// Validate the Kueue queue configuration
+	if err := r.validateQueue(instance); err != nil {
 		return err
 	}
 
-	// Build container spec
-	container := buildContainerSpec(ctx, r, instance, resolvedImage)
+	// Validate the container resource requests against the limits
+	if err := validateResources(instance); err != nil {
+		return err
+	}
 
-	// Configure storage
-	podSpec := configurePodStorage(ctx, r, instance, container)
+	// Validate that the sidecars do not replace the server container
+	if err := validateSidecars(instance); err != nil {
+		return err
+	}
 
-	// Set the service acc
-	// Prepare annotations for the pod template
-	podAnnotations := make(map[string]string)
+	// Validate that the ConfigMaps of the user configs can be mounted
+	if err := validateUserConfigs(instance); err != nil {
+		return err
+	}
 
-	// Add ConfigMap hash to trigger restarts when the ConfigMap changes
-	if r.hasUserConfigMap(instance) {
-		configMapHash, err := r.getConfigMapHash(ctx, instance)
-		if err != nil {
-			return fmt.Errorf("failed to get ConfigMap hash for pod restart annotation: %w", err)
-		}
-		if configMapHash != "" {
-			podAnnotations["configmap.hash/user-config"] = configMapHash
-			logger.V(1).Info("Added ConfigMap hash annotation to trigger pod restart",
-				"configMapName", instance.Spec.Server.UserConfig.ConfigMapName,
-				"hash", configMapHash)
-		}
+	// Validate the port range and that the extra ports do not collide with the ports managed by the operator
+	if err := validatePorts(instance); err != nil {
+		return err
+	}
+	if warning := runtimeClassWarning(instance); warning != "" {
+		logger.Info(warning, "runtimeClassName", *instance.Spec.Server.RuntimeClassName)
+	}
+	if warning := privilegedPortWarning(instance); warning != "" {
+		logger.Info(warning)
 	}
+	return nil
+}
 
-	// Add CA bundle ConfigMap hash to trigger restarts when the CA bundle changes
-	if r.hasCABundleConfigMap(instance) {
-		caBundleHash, err := r.getCABundleConfigMapHash(ctx, instance)
-		if err != nil {
-			return fmt.Errorf("failed to get CA bundle ConfigMap hash for pod restart annotation: %w", err)
-		}
-		if caBundleHash != "" {
-			podAnnotations["configmap.hash/ca-bundle"] = caBundleHash
-			logger.V(1).Info("Added CA bundle ConfigMap hash annotation to trigger pod restart",
-				"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
-				"hash", caBundleHash)
-		}
+// reconcileDeployment manages the Deployment for the LlamaStack server.
+func (r *LlamaStackDistributionReconciler) reconcileDeployment(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	if err := r.validateServerSpec(ctx, instance); err != nil {
+		return err
+	}
+
+	// Get the image either from the map or direct reference
+	resolvedImage, err := r.resolveImage(instance.Spec.Server.Distribution)
+	if err != nil {
+		return err
+	}
+
+	// Build container spec
+	container := buildContainerSpec(ctx, r, instance, resolvedImage)
+
+	// Configure storage
+	podSpec := configurePodStorage(ctx, r, instance, container)
+
+	podAnnotations, err := r.getPodRestartAnnotations(ctx, instance)
+	if err != nil {
+		return err
 	}
 
 	// Create deployment object
@@ -799,123 +1574,378 @@ func (r *LlamaStackDistributionReconciler) reconcileDeployment(ctx context.Conte
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      instance.Name,
 			Namespace: instance.Namespace,
+			Labels:    deploy.GetDeploymentLabels(instance, nil),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: &instance.Spec.Replicas,
 			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					llamav1alpha1.DefaultLabelKey: llamav1alpha1.DefaultLabelValue,
-					"app.kubernetes.io/instance":  instance.Name,
-				},
+				MatchLabels: deploy.GetSelectorLabels(instance),
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{
-						llamav1alpha1.DefaultLabelKey: llamav1alpha1.DefaultLabelValue,
-						"app.kubernetes.io/instance":  instance.Name,
-					},
-					Annotations: podAnnotations,
+					Labels:      deploy.GetCommonLabels(instance, deploy.GetSelectorLabels(instance)),
+					Annotations: deploy.GetPodAnnotations(instance, podAnnotations),
 				},
 				Spec: podSpec,
 			},
 		},
 	}
 
-	return deploy.ApplyDeployment(ctx, r.Client, r.Scheme, instance, deployment, logger)
+	// Hand the pods over to Kueue for admission if a queue is configured
+	configurePodQueue(instance, &deployment.Spec.Template)
+
+	// Leave the replicas to the HorizontalPodAutoscaler if autoscaling is enabled
+	if instance.IsAutoscalingEnabled() {
+		deployment.Spec.Replicas = nil
+	}
+
+	// Record the known-good pod template and roll back a stalled rollout if requested
+	if err := r.applyRolloutPolicy(ctx, instance, deployment); err != nil {
+		return err
+	}
+
+	// Hold back a new pod template while another instance of the namespace rolls out
+	if err := r.serializeRollout(ctx, instance, deployment); err != nil {
+		return err
+	}
+
+	// Warn early if the namespace ResourceQuotas cannot fit the pods, the Deployment is applied regardless
+	if r.EnableQuotaPreflight {
+		r.checkResourceQuotas(ctx, instance, deployment)
+	} else {
+		RemoveCondition(&instance.Status, ConditionTypeQuotaWouldBeExceeded)
+	}
+
+	result, err := deploy.ApplyDeployment(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, deployment, logger)
+	if err != nil {
+		return err
+	}
+	switch result {
+	case controllerutil.OperationResultCreated:
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonDeploymentCreated, "Created Deployment "+deployment.Name)
+	case controllerutil.OperationResultUpdated:
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonDeploymentUpdated, "Updated Deployment "+deployment.Name)
+	}
+	return nil
+}
+
+// getPodRestartAnnotations returns the pod template annotations holding the hashes of the ConfigMaps and Secrets
+// mounted by the server, so that the pods restart when one of them changes.
+func (r *LlamaStackDistributionReconciler) getPodRestartAnnotations(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (map[string]string, error) {
+	logger := log.FromContext(ctx)
+	podAnnotations := make(map[string]string)
+
+	// Add ConfigMap hash to trigger restarts when the ConfigMap changes
+	if r.hasUserConfigMap(instance) {
+		configMapHash, err := r.getConfigMapHash(ctx, instance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap hash for pod restart annotation: %w", err)
+		}
+		if configMapHash != "" {
+			podAnnotations["configmap.hash/user-config"] = configMapHash
+			logger.V(1).Info("Added ConfigMap hash annotation to trigger pod restart",
+				"configMapName", instance.RunUserConfig().ConfigMapName,
+				"hash", configMapHash)
+		}
+
+		additionalHash, err := r.getAdditionalConfigMapsHash(ctx, instance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get additional ConfigMaps hash for pod restart annotation: %w", err)
+		}
+		if additionalHash != "" {
+			podAnnotations["configmap.hash/additional-config"] = additionalHash
+			logger.V(1).Info("Added additional ConfigMaps hash annotation to trigger pod restart", "hash", additionalHash)
+		}
+
+		userConfigsHash, err := r.getUserConfigsHash(ctx, instance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user ConfigMaps hash for pod restart annotation: %w", err)
+		}
+		if userConfigsHash != "" {
+			podAnnotations["configmap.hash/user-configs"] = userConfigsHash
+			logger.V(1).Info("Added user ConfigMaps hash annotation to trigger pod restart", "hash", userConfigsHash)
+		}
+	}
+
+	// Add Secret hash to trigger restarts when the referenced Secret changes
+	secretHash, err := r.getUserSecretHash(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Secret hash for pod restart annotation: %w", err)
+	}
+	if secretHash != "" {
+		podAnnotations["secret.hash/user-secret"] = secretHash
+		logger.V(1).Info("Added Secret hash annotation to trigger pod restart",
+			"secretName", instance.Spec.Server.SecretRef.SecretName,
+			"hash", secretHash)
+	}
+
+	// Add serving certificate Secret hash to trigger restarts when the certificate is rotated
+	serverCertHash, err := r.getServerCertSecretHash(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server certificate Secret hash for pod restart annotation: %w", err)
+	}
+	if serverCertHash != "" {
+		podAnnotations["secret.hash/server-cert"] = serverCertHash
+		logger.V(1).Info("Added server certificate Secret hash annotation to trigger pod restart", "hash", serverCertHash)
+	}
+
+	// Add CA bundle ConfigMap hash to trigger restarts when the CA bundle changes
+	caBundleHash, err := r.getCABundleConfigMapHash(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CA bundle ConfigMap hash for pod restart annotation: %w", err)
+	}
+	if caBundleHash != "" {
+		podAnnotations["configmap.hash/ca-bundle"] = caBundleHash
+		logger.V(1).Info("Added CA bundle ConfigMap hash annotation to trigger pod restart", "hash", caBundleHash)
+	}
+
+	return podAnnotations, nil
 }
 
 // getServerURL returns the URL for the LlamaStack server.
 func (r *LlamaStackDistributionReconciler) getServerURL(instance *llamav1alpha1.LlamaStackDistribution, path string) *url.URL {
+	return r.getServerURLWithPort(instance, deploy.GetServicePort(instance), path)
+}
+
+// getHealthCheckURL returns the URL of the health endpoint, honoring the health check overrides of the instance.
+func (r *LlamaStackDistributionReconciler) getHealthCheckURL(instance *llamav1alpha1.LlamaStackDistribution) *url.URL {
+	return r.getServerURLWithPort(instance, deploy.GetHealthCheckPort(instance), deploy.GetHealthCheckPath(instance))
+}
+
+// getServerURLWithPort returns the URL for the LlamaStack server on the given service port.
+func (r *LlamaStackDistributionReconciler) getServerURLWithPort(instance *llamav1alpha1.LlamaStackDistribution, port int32, path string) *url.URL {
 	serviceName := deploy.GetServiceName(instance)
-	port := deploy.GetServicePort(instance)
 
 	return &url.URL{
-		Scheme: "http",
+		Scheme: getServerScheme(instance),
 		Host:   fmt.Sprintf("%s.%s.svc.cluster.local:%d", serviceName, instance.Namespace, port),
 		Path:   path,
 	}
 }
 
-// getProviderInfo makes an HTTP request to the providers endpoint.
-func (r *LlamaStackDistributionReconciler) getProviderInfo(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) ([]llamav1alpha1.ProviderInfo, error) {
-	u := r.getServerURL(instance, "/v1/providers")
+// serverHTTPClient returns the client querying the llama-stack server of the instance. A server serving a certificate
+// is verified against the CA of its Secret, unless the verification is explicitly disabled. Its client is cached
+// until the CA changes, so that the connections to the server are reused.
+func (r *LlamaStackDistributionReconciler) serverHTTPClient(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (*http.Client, error) {
+	key := client.ObjectKeyFromObject(instance)
+	if !hasServerCert(instance) {
+		r.serverClients.Forget(key)
+		return r.httpClient, nil
+	}
+	baseTransport, ok := r.httpClient.Transport.(*http.Transport)
+	if !ok {
+		if r.httpClient.Transport != nil {
+			// A custom round tripper is responsible for its own TLS configuration
+			return r.httpClient, nil
+		}
+		baseTransport = http.DefaultTransport.(*http.Transport)
+	}
+
+	serverCert := instance.Spec.Server.TLSConfig.ServerCert
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	fingerprint := "insecure"
+	if serverCert.InsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // explicitly requested by the spec of the instance
+	} else {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: serverCert.SecretName, Namespace: instance.Namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to fetch server certificate Secret %s/%s: %w", instance.Namespace, serverCert.SecretName, err)
+		}
+		caData := secret.Data[serverCertCAKey]
+		if len(caData) == 0 {
+			// A self-signed certificate is its own CA
+			caData = secret.Data[corev1.TLSCertKey]
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("failed to load the CA of server certificate Secret %s/%s", instance.Namespace, serverCert.SecretName)
+		}
+		tlsConfig.RootCAs = rootCAs
+		sum := sha256.Sum256(caData)
+		fingerprint = hex.EncodeToString(sum[:])
+	}
+
+	return r.serverClients.Get(key, fingerprint, func() (*http.Client, error) {
+		transport := baseTransport.Clone()
+		transport.TLSClientConfig = tlsConfig
+		return &http.Client{Transport: transport, Timeout: r.httpClient.Timeout}, nil
+	})
+}
+
+// withServerRequestTimeout bounds a request to the llama-stack server by the health check timeout of the instance.
+func withServerRequestTimeout(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, time.Duration(deploy.GetHealthCheckTimeoutSeconds(instance))*time.Second)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+// checkHealth makes an HTTP request to the health endpoint.
+func (r *LlamaStackDistributionReconciler) checkHealth(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	ctx, cancel := withServerRequestTimeout(ctx, instance)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.getHealthCheckURL(instance).String(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create providers request: %w", err)
+		return fmt.Errorf("failed to create health request: %w", err)
 	}
 
-	resp, err := r.httpClient.Do(req)
+	httpClient, err := r.serverHTTPClient(ctx, instance)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make providers request: %w", err)
+		return fmt.Errorf("failed to make health request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to query providers endpoint: returned status code %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read providers response: %w", err)
+		return fmt.Errorf("failed to query health endpoint: returned status code %d", resp.StatusCode)
 	}
+	return nil
+}
 
+// getProviderInfo makes an HTTP request to the providers endpoint.
+func (r *LlamaStackDistributionReconciler) getProviderInfo(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) ([]llamav1alpha1.ProviderInfo, error) {
 	var response struct {
 		Data []llamav1alpha1.ProviderInfo `json:"data"`
 	}
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal providers response: %w", err)
+	if err := r.getServerJSON(ctx, instance, "/v1/providers", "providers", &response); err != nil {
+		return nil, err
 	}
-
 	return response.Data, nil
 }
 
 // getVersionInfo makes an HTTP request to the version endpoint.
 func (r *LlamaStackDistributionReconciler) getVersionInfo(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
-	u := r.getServerURL(instance, "/v1/version")
+	var response struct {
+		Version string `json:"version"`
+	}
+	if err := r.getServerJSON(ctx, instance, "/v1/version", "version", &response); err != nil {
+		return "", err
+	}
+	return response.Version, nil
+}
+
+// getModelsInfo makes an HTTP request to the models endpoint.
+// Older servers report the fields of each model at the top level, while servers with the OpenAI-compatible
+// models API report the identifier as id and the other fields in custom_metadata.
+func (r *LlamaStackDistributionReconciler) getModelsInfo(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) ([]llamav1alpha1.ModelInfo, error) {
+	type modelFields struct {
+		ProviderID string `json:"provider_id"`
+		ModelType  string `json:"model_type"`
+	}
+	var response struct {
+		Data []struct {
+			modelFields
+			Identifier     string      `json:"identifier"`
+			ID             string      `json:"id"`
+			CustomMetadata modelFields `json:"custom_metadata"`
+		} `json:"data"`
+	}
+	if err := r.getServerJSON(ctx, instance, "/v1/models", "models", &response); err != nil {
+		return nil, err
+	}
+
+	models := make([]llamav1alpha1.ModelInfo, 0, len(response.Data))
+	for _, model := range response.Data {
+		info := llamav1alpha1.ModelInfo{ModelID: model.Identifier, ProviderID: model.ProviderID, ModelType: model.ModelType}
+		if info.ModelID == "" {
+			info.ModelID = model.ID
+		}
+		if info.ProviderID == "" {
+			info.ProviderID = model.CustomMetadata.ProviderID
+		}
+		if info.ModelType == "" {
+			info.ModelType = model.CustomMetadata.ModelType
+		}
+		models = append(models, info)
+	}
+	return models, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+// getServerJSON queries an endpoint of the llama-stack server and decodes its JSON response into out.
+// Transient failures, such as connection errors or 5xx responses of a server still starting, are retried with
+// serverRequestBackoff. Each attempt is bounded by the health check timeout of the instance.
+func (r *LlamaStackDistributionReconciler) getServerJSON(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	path, name string, out any) error {
+	return retry.OnError(serverRequestBackoff, func(err error) bool {
+		return errors.Is(err, errServerUnavailable)
+	}, func() error {
+		return r.getServerJSONOnce(ctx, instance, path, name, out)
+	})
+}
+
+// getServerJSONOnce performs a single attempt of getServerJSON.
+func (r *LlamaStackDistributionReconciler) getServerJSONOnce(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	path, name string, out any) error {
+	ctx, cancel := withServerRequestTimeout(ctx, instance)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.getServerURL(instance, path).String(), nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create version request: %w", err)
+		return fmt.Errorf("failed to create %s request: %w", name, err)
 	}
 
-	resp, err := r.httpClient.Do(req)
+	httpClient, err := r.serverHTTPClient(ctx, instance)
 	if err != nil {
-		return "", fmt.Errorf("failed to make version request: %w", err)
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// A server that did not answer within the timeout is not retried, since every attempt would wait as long
+		if isTimeout(err) {
+			return fmt.Errorf("failed to make %s request: %w", name, err)
+		}
+		return fmt.Errorf("failed to make %s request: %w: %w", name, errServerUnavailable, err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("failed to query %s endpoint: returned status code %d: %w", name, resp.StatusCode, errServerUnavailable)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("failed to query version endpoint: returned status code %d", resp.StatusCode)
+		return fmt.Errorf("failed to query %s endpoint: returned status code %d", name, resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read version response: %w", err)
+		return fmt.Errorf("failed to read %s response: %w", name, err)
 	}
-
-	var response struct {
-		Version string `json:"version"`
-	}
-	if err := json.Unmarshal(body, &response); err != nil {
-		return "", fmt.Errorf("failed to unmarshal version response: %w", err)
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to unmarshal %s response: %w", name, err)
 	}
+	return nil
+}
 
-	return response.Version, nil
+// isTimeout reports whether a request failed because its deadline was exceeded or it was canceled.
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) ||
+		(errors.As(err, &netErr) && netErr.Timeout())
 }
 
 // updateStatus refreshes the LlamaStack status.
-func (r *LlamaStackDistributionReconciler) updateStatus(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution, reconcileErr error) error {
-	logger := log.FromContext(ctx)
+func (r *LlamaStackDistributionReconciler) updateStatus(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	previousConditions []metav1.Condition, reconcileErr error) error {
 	// Initialize OperatorVersion if not set
 	if instance.Status.Version.OperatorVersion == "" {
 		instance.Status.Version.OperatorVersion = os.Getenv("OPERATOR_VERSION")
 	}
 
+	previousPhase := instance.Status.Phase
+
+	// References are tracked regardless of the reconcile outcome so that dependencies stay visible on failures.
+	r.updateReferencesStatus(ctx, instance)
+
 	// A reconciliation error is the highest priority. It overrides all other status checks.
 	if reconcileErr != nil {
 		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseFailed
-		SetDeploymentReadyCondition(&instance.Status, false, fmt.Sprintf("Resource reconciliation failed: %v", reconcileErr))
+		if reason, message, classified := reconcileFailureReason(reconcileErr); classified {
+			SetDeploymentFailedCondition(&instance.Status, reason, message)
+			metrics.RecordReconcileError(reason)
+		} else {
+			SetDeploymentReadyCondition(&instance.Status, false, fmt.Sprintf("Resource reconciliation failed: %v", reconcileErr))
+			metrics.RecordReconcileError(ReasonDeploymentFailed)
+		}
 	} else {
 		// If reconciliation was successful, proceed with detailed status checks.
 		deploymentReady, err := r.updateDeploymentStatus(ctx, instance)
@@ -929,34 +1959,25 @@ func (r *LlamaStackDistributionReconciler) updateStatus(ctx context.Context, ins
 
 		if deploymentReady {
 			instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseReady
-
-			providers, err := r.getProviderInfo(ctx, instance)
-			if err != nil {
-				logger.Error(err, "failed to get provider info, clearing provider list")
-				instance.Status.DistributionConfig.Providers = nil
-			} else {
-				instance.Status.DistributionConfig.Providers = providers
-			}
-
-			version, err := r.getVersionInfo(ctx, instance)
-			if err != nil {
-				logger.Error(err, "failed to get version info from API endpoint")
-				// Don't clear the version if we cant fetch it - keep the existing one
-			} else {
-				instance.Status.Version.LlamaStackServerVersion = version
-				logger.V(1).Info("Updated LlamaStack version from API endpoint", "version", version)
-			}
-
-			SetHealthCheckCondition(&instance.Status, true, MessageHealthCheckPassed)
+			r.performHealthChecks(ctx, instance)
 		} else {
 			// If not ready, health can't be checked. Set condition appropriately.
 			SetHealthCheckCondition(&instance.Status, false, "Deployment not ready")
-			instance.Status.DistributionConfig.Providers = nil // Clear providers
+			instance.Status.HealthCheck = nil
+			// Keep the last known providers until they can be refreshed
+			MarkStatusProvidersStale(&instance.Status.DistributionConfig)
+			// Unlike the providers, the models of a server that is not ready may not be served anymore
+			ClearStatusModels(&instance.Status.DistributionConfig)
 		}
 	}
 
+	r.recordPhaseTransition(instance, previousPhase, reconcileErr)
+	recordPhaseMetric(client.ObjectKeyFromObject(instance), instance.Status.Phase)
+
 	// Always update the status at the end of the function.
-	instance.Status.Version.LastUpdated = metav1.NewTime(metav1.Now().UTC())
+	now := r.now().UTC()
+	StampConditionTransitions(&instance.Status, previousConditions, now)
+	instance.Status.Version.LastUpdated = metav1.NewTime(now)
 	if err := r.Status().Update(ctx, instance); err != nil {
 		return fmt.Errorf("failed to update status: %w", err)
 	}
@@ -964,6 +1985,162 @@ func (r *LlamaStackDistributionReconciler) updateStatus(ctx context.Context, ins
 	return nil
 }
 
+// recordPhaseTransition emits an event when the instance enters the Ready or Failed phase.
+func (r *LlamaStackDistributionReconciler) recordPhaseTransition(instance *llamav1alpha1.LlamaStackDistribution,
+	previousPhase llamav1alpha1.DistributionPhase, reconcileErr error) {
+	if instance.Status.Phase == previousPhase {
+		return
+	}
+	switch instance.Status.Phase {
+	case llamav1alpha1.LlamaStackDistributionPhaseReady:
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonReady, MessageDeploymentReady)
+	case llamav1alpha1.LlamaStackDistributionPhaseFailed:
+		message := "Resource reconciliation failed"
+		if reconcileErr != nil {
+			message = fmt.Sprintf("%s: %v", message, reconcileErr)
+		} else if condition := GetCondition(&instance.Status, ConditionTypeHealthCheck); condition != nil {
+			message = condition.Message
+		}
+		r.recordEvent(instance, corev1.EventTypeWarning, EventReasonFailed, message)
+	}
+}
+
+// publishStatusSnapshot publishes the status to the configured sink if it changed significantly.
+// Failures are logged and never fail the reconciliation.
+func (r *LlamaStackDistributionReconciler) publishStatusSnapshot(ctx context.Context, previousStatus *llamav1alpha1.LlamaStackDistributionStatus,
+	instance *llamav1alpha1.LlamaStackDistribution) {
+	if r.StatusPublisher == nil || !statusexport.IsSignificantTransition(previousStatus, &instance.Status) {
+		return
+	}
+
+	urls := map[string]string{
+		"service": r.getServerURL(instance, "").String(),
+	}
+	if err := r.StatusPublisher.Publish(ctx, statusexport.NewSnapshot(instance, urls)); err != nil {
+		log.FromContext(ctx).Error(err, "failed to publish status snapshot, continuing")
+	}
+}
+
+// refreshServerInfo records the providers, version and models reported by the server in the status. Information
+// that cannot be fetched is kept, and the providers are flagged as stale.
+func (r *LlamaStackDistributionReconciler) refreshServerInfo(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) {
+	logger := log.FromContext(ctx)
+	key := client.ObjectKeyFromObject(instance)
+
+	providers, err := r.getProviderInfo(ctx, instance)
+	if err != nil {
+		logger.Error(err, "failed to get provider info, keeping the last known providers")
+		MarkStatusProvidersStale(&instance.Status.DistributionConfig)
+	} else {
+		previous := instance.Status.DistributionConfig.Providers
+		SetStatusProviders(&instance.Status.DistributionConfig, providers, r.MaxStatusProviders, r.now().UTC())
+		recordProviderHealthMetrics(key, previous, instance.Status.DistributionConfig.Providers)
+	}
+
+	version, err := r.getVersionInfo(ctx, instance)
+	if err != nil {
+		logger.Error(err, "failed to get version info from API endpoint")
+		// Don't clear the version if we cant fetch it - keep the existing one
+	} else {
+		instance.Status.Version.LlamaStackServerVersion = version
+		logger.V(1).Info("Updated LlamaStack version from API endpoint", "version", version)
+	}
+
+	models, err := r.getModelsInfo(ctx, instance)
+	if err != nil {
+		logger.Error(err, "failed to get model info, keeping the last known models")
+	} else {
+		SetStatusModels(&instance.Status.DistributionConfig, models, maxStatusModels)
+	}
+}
+
+// performHealthChecks probes the server endpoints and records providers, version and models in the status.
+// A failed health check only marks the server unhealthy and moves it to the Failed phase once it failed the
+// configured number of consecutive times, so that a restarting pod does not make the phase flap. The first
+// successful health check restores it. Probing is suspended by the health check breaker while the endpoint keeps
+// failing.
+// Providers, version and models are refreshed on every successful probe, so a server recovering from a failure
+// reports them in the same status update as its healthy condition. They are not queried while the probe fails:
+// the last known providers are then kept and flagged as stale.
+func (r *LlamaStackDistributionReconciler) performHealthChecks(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) {
+	logger := log.FromContext(ctx)
+	key := client.ObjectKeyFromObject(instance)
+	wasUnhealthy := IsConditionFalse(&instance.Status, ConditionTypeHealthCheck)
+
+	if !r.healthBreaker.Allow(key) {
+		logger.V(1).Info("Skipping health checks, endpoint is unreachable")
+		SetHealthCheckCondition(&instance.Status, false, "Health checks suspended, endpoint is unreachable")
+		if healthCheckFailures(instance) >= r.healthFailureThreshold() {
+			instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseFailed
+		}
+		metrics.RecordHealthCheck(metrics.ResultSkipped)
+		recordServerHealthMetric(key, false)
+		return
+	}
+
+	healthURL := r.getHealthCheckURL(instance).String()
+	healthErr := r.checkHealth(ctx, instance)
+	if healthErr != nil {
+		logger.Error(healthErr, "health check failed", "endpoint", healthURL)
+		if r.healthBreaker.RecordFailure(key) {
+			logger.Info("Suspending health checks after repeated failures", "interval", r.healthBreaker.openInterval)
+			SetUnreachableCondition(&instance.Status, fmt.Sprintf("Health checks failed %d consecutive times, retrying in %s",
+				r.healthBreaker.failureThreshold, r.healthBreaker.openInterval))
+			// A single transient failure keeps the last known providers, but not an endpoint that keeps failing
+			recordProviderHealthMetrics(key, instance.Status.DistributionConfig.Providers, nil)
+			ClearStatusProviders(&instance.Status.DistributionConfig)
+		}
+	} else {
+		r.healthBreaker.RecordSuccess(key)
+		RemoveCondition(&instance.Status, ConditionTypeUnreachable)
+	}
+
+	// The other endpoints are only queried once the server is healthy, so that a hung server does not hold the
+	// reconciliation for the timeout of every request
+	if healthErr == nil {
+		r.refreshServerInfo(ctx, instance)
+	} else {
+		MarkStatusProvidersStale(&instance.Status.DistributionConfig)
+	}
+
+	if healthErr != nil {
+		metrics.RecordHealthCheck(metrics.ResultError)
+		recordServerHealthMetric(key, false)
+		if instance.Status.HealthCheck == nil {
+			instance.Status.HealthCheck = &llamav1alpha1.HealthCheckStatus{}
+		}
+		instance.Status.HealthCheck.ConsecutiveFailures++
+		if failures := healthCheckFailures(instance); failures < r.healthFailureThreshold() {
+			logger.Info("Health check failed, keeping the server status until it fails repeatedly",
+				"failures", failures, "threshold", r.healthFailureThreshold())
+			return
+		}
+		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseFailed
+		message := fmt.Sprintf("%s at %s: %v", MessageHealthCheckFailed, healthURL, healthErr)
+		SetHealthCheckCondition(&instance.Status, false, message)
+		// Only the transition is recorded, so that a server staying unhealthy does not flood the namespace
+		if !wasUnhealthy {
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonHealthCheckFailed, message)
+		}
+		return
+	}
+	metrics.RecordHealthCheck(metrics.ResultSuccess)
+	recordServerHealthMetric(key, true)
+	instance.Status.HealthCheck = nil
+	SetHealthCheckCondition(&instance.Status, true, fmt.Sprintf("%s at %s", MessageHealthCheckPassed, healthURL))
+	if wasUnhealthy {
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonHealthCheckRecovered, "Health check passed at "+healthURL)
+	}
+}
+
+// healthCheckFailures returns the number of consecutive failed health checks of the instance.
+func healthCheckFailures(instance *llamav1alpha1.LlamaStackDistribution) int {
+	if instance.Status.HealthCheck == nil {
+		return 0
+	}
+	return int(instance.Status.HealthCheck.ConsecutiveFailures)
+}
+
 func (r *LlamaStackDistributionReconciler) updateDeploymentStatus(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (bool, error) {
 	deployment := &appsv1.Deployment{}
 	deploymentErr := r.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, deployment)
@@ -972,6 +2149,12 @@ func (r *LlamaStackDistributionReconciler) updateDeploymentStatus(ctx context.Co
 	}
 
 	deploymentReady := false
+	// With autoscaling the replicas may settle anywhere within the limits of the HorizontalPodAutoscaler
+	minReplicas, maxReplicas := replicaRange(instance)
+	// An external controller owns the replicas, the Deployment is scaled to whatever it decided
+	if deploymentErr == nil && instance.IsReplicaCountExternal() && deployment.Spec.Replicas != nil {
+		minReplicas, maxReplicas = *deployment.Spec.Replicas, *deployment.Spec.Replicas
+	}
 
 	switch {
 	case deploymentErr != nil: // This case covers when the deployment is not found
@@ -980,13 +2163,13 @@ func (r *LlamaStackDistributionReconciler) updateDeploymentStatus(ctx context.Co
 	case deployment.Status.ReadyReplicas == 0:
 		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseInitializing
 		SetDeploymentReadyCondition(&instance.Status, false, MessageDeploymentPending)
-	case deployment.Status.ReadyReplicas < instance.Spec.Replicas:
+	case deployment.Status.ReadyReplicas < minReplicas:
 		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseInitializing
-		deploymentMessage := fmt.Sprintf("Deployment is scaling: %d/%d replicas ready", deployment.Status.ReadyReplicas, instance.Spec.Replicas)
+		deploymentMessage := fmt.Sprintf("Deployment is scaling: %d/%d replicas ready", deployment.Status.ReadyReplicas, minReplicas)
 		SetDeploymentReadyCondition(&instance.Status, false, deploymentMessage)
-	case deployment.Status.ReadyReplicas > instance.Spec.Replicas:
+	case deployment.Status.ReadyReplicas > maxReplicas:
 		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseInitializing
-		deploymentMessage := fmt.Sprintf("Deployment is scaling down: %d/%d replicas ready", deployment.Status.ReadyReplicas, instance.Spec.Replicas)
+		deploymentMessage := fmt.Sprintf("Deployment is scaling down: %d/%d replicas ready", deployment.Status.ReadyReplicas, maxReplicas)
 		SetDeploymentReadyCondition(&instance.Status, false, deploymentMessage)
 	default:
 		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseReady
@@ -994,19 +2177,76 @@ func (r *LlamaStackDistributionReconciler) updateDeploymentStatus(ctx context.Co
 		SetDeploymentReadyCondition(&instance.Status, true, MessageDeploymentReady)
 	}
 	instance.Status.AvailableReplicas = deployment.Status.ReadyReplicas
+
+	if err := r.updateQueueStatus(ctx, instance); err != nil {
+		return false, err
+	}
 	return deploymentReady, nil
 }
 
+// updateQueueStatus reports whether the server pods are waiting for Kueue admission.
+func (r *LlamaStackDistributionReconciler) updateQueueStatus(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if instance.Spec.Server.Queue == nil {
+		RemoveCondition(&instance.Status, ConditionTypeQueuedForResources)
+		return nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(instance.Namespace), client.MatchingLabels{
+		llamav1alpha1.DefaultLabelKey: llamav1alpha1.DefaultLabelValue,
+		"app.kubernetes.io/instance":  instance.Name,
+	}); err != nil {
+		return fmt.Errorf("failed to list pods for queue status: %w", err)
+	}
+
+	queuedPods := 0
+	for i := range podList.Items {
+		if isPodQueued(&podList.Items[i]) {
+			queuedPods++
+		}
+	}
+
+	if queuedPods == 0 {
+		SetQueuedForResourcesCondition(&instance.Status, false, "")
+		return nil
+	}
+
+	// Gated pods are waiting for quota rather than starting, so report them as pending
+	if instance.Status.Phase == llamav1alpha1.LlamaStackDistributionPhaseInitializing {
+		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhasePending
+	}
+	SetQueuedForResourcesCondition(&instance.Status, true,
+		fmt.Sprintf("%d pod(s) waiting for admission to queue %s", queuedPods, instance.Spec.Server.Queue.Name))
+	return nil
+}
+
 func (r *LlamaStackDistributionReconciler) updateStorageStatus(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) {
 	if instance.Spec.Server.Storage == nil {
+		instance.Status.Storage = nil
 		return
 	}
+	instance.Status.Storage = &llamav1alpha1.StorageStatus{RequestedSize: deploy.GetStorageSize(instance)}
+
 	pvc := &corev1.PersistentVolumeClaim{}
 	err := r.Get(ctx, types.NamespacedName{Name: instance.Name + "-pvc", Namespace: instance.Namespace}, pvc)
 	if err != nil {
 		SetStorageReadyCondition(&instance.Status, false, fmt.Sprintf("Failed to get PVC: %v", err))
 		return
 	}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		instance.Status.Storage.Capacity = &capacity
+	}
+
+	// A requested size the PVC cannot be resized to is never applied, so the storage does not match the spec
+	resizeMessage, err := deploy.PVCResizeBlockedMessage(ctx, r.Client, pvc, deploy.GetStorageSize(instance))
+	if err != nil {
+		SetStorageReadyCondition(&instance.Status, false, fmt.Sprintf("Failed to check PVC resize: %v", err))
+		return
+	}
+	if resizeMessage != "" {
+		SetStorageReadyCondition(&instance.Status, false, resizeMessage)
+		return
+	}
 
 	ready := pvc.Status.Phase == corev1.ClaimBound
 	var message string
@@ -1034,7 +2274,7 @@ func (r *LlamaStackDistributionReconciler) updateServiceStatus(ctx context.Conte
 }
 
 func (r *LlamaStackDistributionReconciler) updateDistributionConfig(instance *llamav1alpha1.LlamaStackDistribution) {
-	instance.Status.DistributionConfig.AvailableDistributions = r.ClusterInfo.DistributionImages
+	instance.Status.DistributionConfig.AvailableDistributions = r.ClusterInfo.Distributions()
 	var activeDistribution string
 	if instance.Spec.Server.Distribution.Name != "" {
 		activeDistribution = instance.Spec.Server.Distribution.Name
@@ -1044,6 +2284,108 @@ func (r *LlamaStackDistributionReconciler) updateDistributionConfig(instance *ll
 	instance.Status.DistributionConfig.ActiveDistribution = activeDistribution
 }
 
+// updateReferencesStatus records the external ConfigMaps and Secrets consumed by the instance,
+// together with the content hash last observed for each of them.
+func (r *LlamaStackDistributionReconciler) updateReferencesStatus(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) {
+	logger := log.FromContext(ctx)
+	var references []llamav1alpha1.ExternalReference
+
+	if r.hasUserConfigMap(instance) {
+		hash, err := r.getConfigMapHash(ctx, instance)
+		if err != nil {
+			logger.V(1).Info("Unable to observe user ConfigMap for status references", "error", err.Error())
+		}
+		references = append(references, llamav1alpha1.ExternalReference{
+			Kind:             "ConfigMap",
+			Namespace:        r.getUserConfigMapNamespace(instance),
+			Name:             instance.RunUserConfig().ConfigMapName,
+			Purpose:          llamav1alpha1.ReferencePurposeUserConfig,
+			LastObservedHash: hash,
+		})
+
+		for _, name := range getAdditionalConfigMapNames(instance) {
+			hash, err := r.getAdditionalConfigMapHash(ctx, instance, name)
+			if err != nil {
+				logger.V(1).Info("Unable to observe additional ConfigMap for status references", "name", name, "error", err.Error())
+			}
+			references = append(references, llamav1alpha1.ExternalReference{
+				Kind:             "ConfigMap",
+				Namespace:        r.getUserConfigMapNamespace(instance),
+				Name:             name,
+				Purpose:          llamav1alpha1.ReferencePurposeAdditionalUserConfig,
+				LastObservedHash: hash,
+			})
+		}
+
+		for _, userConfig := range instance.MountedUserConfigs() {
+			hash, err := r.getUserConfigHash(ctx, instance, userConfig)
+			if err != nil {
+				logger.V(1).Info("Unable to observe user ConfigMap for status references", "name", userConfig.ConfigMapName, "error", err.Error())
+			}
+			references = append(references, llamav1alpha1.ExternalReference{
+				Kind:             "ConfigMap",
+				Namespace:        getUserConfigNamespace(instance, userConfig),
+				Name:             userConfig.ConfigMapName,
+				Purpose:          llamav1alpha1.ReferencePurposeMountedUserConfig,
+				LastObservedHash: hash,
+			})
+		}
+	}
+
+	if r.hasUserSecret(instance) {
+		hash, err := r.getUserSecretHash(ctx, instance)
+		if err != nil {
+			logger.V(1).Info("Unable to observe user Secret for status references", "error", err.Error())
+		}
+		references = append(references, llamav1alpha1.ExternalReference{
+			Kind:             "Secret",
+			Namespace:        instance.Namespace,
+			Name:             instance.Spec.Server.SecretRef.SecretName,
+			Purpose:          llamav1alpha1.ReferencePurposeUserSecret,
+			LastObservedHash: hash,
+		})
+	}
+
+	if hasServerCert(instance) {
+		hash, err := r.getServerCertSecretHash(ctx, instance)
+		if err != nil {
+			logger.V(1).Info("Unable to observe server certificate Secret for status references", "error", err.Error())
+		}
+		references = append(references, llamav1alpha1.ExternalReference{
+			Kind:             "Secret",
+			Namespace:        instance.Namespace,
+			Name:             instance.Spec.Server.TLSConfig.ServerCert.SecretName,
+			Purpose:          llamav1alpha1.ReferencePurposeServerCert,
+			LastObservedHash: hash,
+		})
+	}
+
+	if r.hasCABundleConfigMap(instance) {
+		hash, err := r.getCABundleConfigMapHash(ctx, instance)
+		if err != nil {
+			logger.V(1).Info("Unable to observe CA bundle ConfigMap for status references", "error", err.Error())
+		}
+		references = append(references, llamav1alpha1.ExternalReference{
+			Kind:             "ConfigMap",
+			Namespace:        r.getCABundleConfigMapNamespace(instance),
+			Name:             instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+			Purpose:          llamav1alpha1.ReferencePurposeCABundle,
+			LastObservedHash: hash,
+		})
+	} else if configMap, keys, err := r.detectODHTrustedCABundle(ctx, instance); err == nil && configMap != nil && len(keys) > 0 {
+		// The auto-detected ODH trusted CA bundle is consumed just like an explicit one
+		references = append(references, llamav1alpha1.ExternalReference{
+			Kind:             "ConfigMap",
+			Namespace:        configMap.Namespace,
+			Name:             configMap.Name,
+			Purpose:          llamav1alpha1.ReferencePurposeCABundle,
+			LastObservedHash: configMapDataHash(configMap),
+		})
+	}
+
+	instance.Status.References = references
+}
+
 // reconcileNetworkPolicy manages the NetworkPolicy for the LlamaStack server.
 func (r *LlamaStackDistributionReconciler) reconcileNetworkPolicy(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
 	logger := log.FromContext(ctx)
@@ -1056,11 +2398,15 @@ func (r *LlamaStackDistributionReconciler) reconcileNetworkPolicy(ctx context.Co
 
 	// If feature is disabled, delete the NetworkPolicy if it exists
 	if !r.EnableNetworkPolicy {
-		return deploy.HandleDisabledNetworkPolicy(ctx, r.Client, networkPolicy, logger)
+		RemoveCondition(&instance.Status, ConditionTypeNetworkPolicyReady)
+		deleted, err := deploy.HandleDisabledNetworkPolicy(ctx, r.Client, networkPolicy, logger)
+		if deleted {
+			r.recordEvent(instance, corev1.EventTypeNormal, EventReasonNetworkPolicyDeleted,
+				"Deleted NetworkPolicy "+networkPolicy.Name+", the NetworkPolicy feature is disabled")
+		}
+		return err
 	}
 
-	port := deploy.GetServicePort(instance)
-
 	// get operator namespace
 	operatorNamespace, err := deploy.GetOperatorNamespace()
 	if err != nil {
@@ -1077,90 +2423,463 @@ func (r *LlamaStackDistributionReconciler) reconcileNetworkPolicy(ctx context.Co
 		PolicyTypes: []networkingv1.PolicyType{
 			networkingv1.PolicyTypeIngress,
 		},
-		Ingress: []networkingv1.NetworkPolicyIngressRule{
-			{
-				From: []networkingv1.NetworkPolicyPeer{
-					{ // to match all pods in all namespaces
-						PodSelector: &metav1.LabelSelector{
-							MatchLabels: map[string]string{
-								"app.kubernetes.io/part-of": llamav1alpha1.DefaultContainerName,
-							},
-						},
-						NamespaceSelector: &metav1.LabelSelector{}, // Empty namespaceSelector to match all namespaces
-					},
-				},
-				Ports: []networkingv1.NetworkPolicyPort{
-					{
-						Protocol: (*corev1.Protocol)(ptr.To("TCP")),
-						Port: &intstr.IntOrString{
-							IntVal: port,
-						},
-					},
-				},
-			},
-			{
-				From: []networkingv1.NetworkPolicyPeer{
-					{ // to match all pods in matched namespace
-						PodSelector: &metav1.LabelSelector{},
-						NamespaceSelector: &metav1.LabelSelector{
-							MatchLabels: map[string]string{
-								"kubernetes.io/metadata.name": operatorNamespace,
-							},
-						},
-					},
-				},
-				Ports: []networkingv1.NetworkPolicyPort{
-					{
-						Protocol: (*corev1.Protocol)(ptr.To("TCP")),
-						Port: &intstr.IntOrString{
-							IntVal: port,
-						},
-					},
-				},
-			},
-		},
+		Ingress: buildIngressRules(instance, operatorNamespace),
+	}
+
+	// Restrict the outbound traffic when egress rules are requested, removing them otherwise
+	if egress := buildEgressRules(instance); egress != nil {
+		networkPolicy.Spec.PolicyTypes = append(networkPolicy.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+		networkPolicy.Spec.Egress = egress
+	}
+
+	result, err := deploy.ApplyNetworkPolicy(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, networkPolicy, logger)
+	if err != nil {
+		SetNetworkPolicyReadyCondition(&instance.Status, false, false, fmt.Sprintf("Failed to apply NetworkPolicy: %v", err))
+		return err
+	}
+	switch result {
+	case controllerutil.OperationResultCreated:
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonNetworkPolicyCreated, "Created NetworkPolicy "+networkPolicy.Name)
+	case controllerutil.OperationResultUpdated:
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonNetworkPolicyUpdated, "Updated NetworkPolicy "+networkPolicy.Name)
+	}
+	SetNetworkPolicyReadyCondition(&instance.Status, true, r.ClusterInfo != nil && r.ClusterInfo.NetworkPolicyEnforced, "")
+	return nil
+}
+
+// reconcileUserConfigMap validates that the referenced ConfigMap exists and holds a valid run configuration.
+// Content that was already validated is not parsed again unless the ConfigMap hash changed.
+func (r *LlamaStackDistributionReconciler) reconcileUserConfigMap(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	logger := log.FromContext(ctx)
+
+	if !r.hasUserConfigMap(instance) {
+		logger.V(1).Info("No user ConfigMap specified, skipping")
+		return nil
+	}
+
+	// Determine the ConfigMap namespace - default to the same namespace as the LlamaStackDistribution.
+	configMapNamespace := r.getUserConfigMapNamespace(instance)
+	configMapName := instance.RunUserConfig().ConfigMapName
+
+	logger.V(1).Info("Validating referenced ConfigMap exists",
+		"configMapName", configMapName,
+		"configMapNamespace", configMapNamespace)
+
+	// While the operator is known to lack access, recheck it with a SelfSubjectAccessReview instead of a request
+	// bound to fail. The ConfigMap is read anyway if the review itself fails.
+	if IsConditionTrue(&instance.Status, ConditionTypeInsufficientPermissions) {
+		allowed, err := r.canGetConfigMap(ctx, configMapNamespace, configMapName)
+		if err != nil {
+			logger.Error(err, "Failed to recheck access to the user ConfigMap")
+		} else if !allowed {
+			return r.configMapAccessForbidden(instance, configMapNamespace, configMapName)
+		}
+	}
+
+	// Check if the ConfigMap exists
+	configMap := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      configMapName,
+		Namespace: configMapNamespace,
+	}, configMap)
+	if err != nil {
+		if k8serrors.IsForbidden(err) {
+			logger.Error(err, "Operator is not allowed to read the referenced ConfigMap",
+				"configMapName", configMapName,
+				"configMapNamespace", configMapNamespace)
+			return r.configMapAccessForbidden(instance, configMapNamespace, configMapName)
+		}
+		if k8serrors.IsNotFound(err) && wasUserConfigMapFound(instance, configMapNamespace) {
+			return r.userConfigMapDeleted(ctx, instance, configMapNamespace)
+		}
+		if k8serrors.IsNotFound(err) {
+			logger.Error(err, "Referenced ConfigMap not found",
+				"configMapName", configMapName,
+				"configMapNamespace", configMapNamespace)
+			message := fmt.Sprintf("ConfigMap %s/%s not found", configMapNamespace, configMapName)
+			SetUserConfigReadyCondition(&instance.Status, false, message)
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonConfigMapNotFound, message)
+			return fmt.Errorf("failed to find referenced ConfigMap %s/%s", configMapNamespace, configMapName)
+		}
+		return fmt.Errorf("failed to fetch ConfigMap %s/%s: %w", configMapNamespace, configMapName, err)
+	}
+
+	RemoveCondition(&instance.Status, ConditionTypeInsufficientPermissions)
+
+	// The data is validated even if unchanged, since the spec may select another key
+	// An invalid configuration fails the reconciliation before the Deployment, so the pods keep the last valid one
+	inheritUserConfigPort(instance, configMap.Data, getUserConfigKey(instance))
+	if err := r.validateUserConfigMap(configMap.Data, getUserConfigKey(instance), getContainerPort(instance)); err != nil {
+		condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady)
+		if condition == nil || condition.Status != metav1.ConditionFalse || condition.Message != err.Error() {
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonUserConfigInvalid,
+				fmt.Sprintf("ConfigMap %s/%s is invalid: %v", configMapNamespace, configMapName, err))
+		}
+		SetUserConfigReadyCondition(&instance.Status, false, err.Error())
+		return fmt.Errorf("failed to validate ConfigMap %s/%s: %w", configMapNamespace, configMapName, err)
+	}
+
+	// The pods cannot start if an additional ConfigMap is missing or collides with another one
+	if err := r.reconcileAdditionalConfigMaps(ctx, instance, configMap); err != nil {
+		SetUserConfigReadyCondition(&instance.Status, false, err.Error())
+		return err
+	}
+	if err := r.reconcileMountedUserConfigs(ctx, instance); err != nil {
+		SetUserConfigReadyCondition(&instance.Status, false, err.Error())
+		return err
+	}
+
+	hash := configMapDataHash(configMap)
+	if isUserConfigValidated(instance, hash) {
+		logger.V(1).Info("User ConfigMap unchanged since last validation", "hash", hash)
+		return nil
+	}
+	SetUserConfigReadyCondition(&instance.Status, true, MessageUserConfigValid)
+	r.recordEvent(instance, corev1.EventTypeNormal, EventReasonUserConfigValidated,
+		fmt.Sprintf("Validated ConfigMap %s/%s", configMapNamespace, configMapName))
+
+	logger.V(1).Info("User ConfigMap found and validated",
+		"configMap", configMap.Name,
+		"namespace", configMap.Namespace,
+		"dataKeys", len(configMap.Data))
+	return nil
+}
+
+// reconcileAdditionalConfigMaps checks that the additional ConfigMaps of the user config exist, and that the keys
+// they mount next to the run configuration are unique, since the projected volume of the pods cannot be set up otherwise.
+func (r *LlamaStackDistributionReconciler) reconcileAdditionalConfigMaps(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	userConfigMap *corev1.ConfigMap) error {
+	namespace := r.getUserConfigMapNamespace(instance)
+
+	// Keys mapped to the ConfigMap providing them
+	providers := make(map[string]string)
+	for _, key := range mountedUserConfigKeys(instance, userConfigMap) {
+		providers[key] = userConfigMap.Name
+	}
+
+	for _, name := range getAdditionalConfigMapNames(instance) {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, configMap); err != nil {
+			if k8serrors.IsNotFound(err) {
+				r.recordEvent(instance, corev1.EventTypeWarning, EventReasonConfigMapNotFound,
+					fmt.Sprintf("Additional ConfigMap %s/%s not found", namespace, name))
+				return fmt.Errorf("failed to find additional ConfigMap %s/%s", namespace, name)
+			}
+			return fmt.Errorf("failed to fetch additional ConfigMap %s/%s: %w", namespace, name, err)
+		}
+
+		for _, key := range configMapKeys(configMap) {
+			if provider, exists := providers[key]; exists {
+				return fmt.Errorf("failed to mount additional ConfigMap %s/%s: key '%s' is also provided by ConfigMap %s",
+					namespace, name, key, provider)
+			}
+			providers[key] = name
+		}
+	}
+	return nil
+}
+
+// reconcileMountedUserConfigs checks that the ConfigMaps of UserConfigs mounted at their own path exist and,
+// unless disabled by the ValidateConfigMapContent feature flag, that their YAML entries parse.
+func (r *LlamaStackDistributionReconciler) reconcileMountedUserConfigs(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	for _, userConfig := range instance.MountedUserConfigs() {
+		namespace := getUserConfigNamespace(instance, userConfig)
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: userConfig.ConfigMapName, Namespace: namespace}, configMap); err != nil {
+			if k8serrors.IsNotFound(err) {
+				r.recordEvent(instance, corev1.EventTypeWarning, EventReasonConfigMapNotFound,
+					fmt.Sprintf("ConfigMap %s/%s not found", namespace, userConfig.ConfigMapName))
+				return fmt.Errorf("failed to find user ConfigMap %s/%s", namespace, userConfig.ConfigMapName)
+			}
+			return fmt.Errorf("failed to fetch user ConfigMap %s/%s: %w", namespace, userConfig.ConfigMapName, err)
+		}
+		if !r.ValidateConfigMapContent {
+			continue
+		}
+		if err := validateUserConfigYAML(configMap.Data, ""); err != nil {
+			return fmt.Errorf("failed to validate ConfigMap %s/%s: %w", namespace, userConfig.ConfigMapName, err)
+		}
+	}
+	return nil
+}
+
+// mountedUserConfigKeys returns the files the user ConfigMap provides in the run configuration directory.
+func mountedUserConfigKeys(instance *llamav1alpha1.LlamaStackDistribution, userConfigMap *corev1.ConfigMap) []string {
+	// A custom key is the only one mounted, as run.yaml
+	if getUserConfigKey(instance) != userConfigRunYAMLKey {
+		return []string{userConfigRunYAMLKey}
+	}
+	return configMapKeys(userConfigMap)
+}
+
+// configMapKeys returns the sorted keys of the data and binary data of a ConfigMap.
+func configMapKeys(configMap *corev1.ConfigMap) []string {
+	return slices.Sorted(func(yield func(string) bool) {
+		for key := range maps.Keys(configMap.Data) {
+			if !yield(key) {
+				return
+			}
+		}
+		for key := range maps.Keys(configMap.BinaryData) {
+			if !yield(key) {
+				return
+			}
+		}
+	})
+}
+
+// reconcileUserSecret checks that the Secret referenced by the secretRef of the instance exists.
+// Its content is only hashed into the pod template, so that changes to it restart the pods.
+func (r *LlamaStackDistributionReconciler) reconcileUserSecret(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if !r.hasUserSecret(instance) {
+		return nil
+	}
+
+	secretName := instance.Spec.Server.SecretRef.SecretName
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: instance.Namespace}, secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			message := fmt.Sprintf("Secret %s/%s not found", instance.Namespace, secretName)
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonSecretNotFound, message)
+			return fmt.Errorf("failed to find referenced Secret %s/%s", instance.Namespace, secretName)
+		}
+		return fmt.Errorf("failed to fetch Secret %s/%s: %w", instance.Namespace, secretName, err)
+	}
+
+	log.FromContext(ctx).V(1).Info("User Secret found", "secretName", secretName, "dataKeys", len(secret.Data))
+	return nil
+}
+
+// reconcileServerCertSecret checks that the Secret holding the serving certificate of the instance exists
+// and holds a certificate and its key. Its content is hashed into the pod template, so that rotating the
+// certificate restarts the pods.
+func (r *LlamaStackDistributionReconciler) reconcileServerCertSecret(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	if !hasServerCert(instance) {
+		return nil
+	}
+
+	secretName := instance.Spec.Server.TLSConfig.ServerCert.SecretName
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: instance.Namespace}, secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			message := fmt.Sprintf("Secret %s/%s not found", instance.Namespace, secretName)
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonSecretNotFound, message)
+			return fmt.Errorf("failed to find referenced Secret %s/%s", instance.Namespace, secretName)
+		}
+		return fmt.Errorf("failed to fetch Secret %s/%s: %w", instance.Namespace, secretName, err)
+	}
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if len(secret.Data[key]) == 0 {
+			return fmt.Errorf("failed to find key '%s' in server certificate Secret %s/%s", key, instance.Namespace, secretName)
+		}
+	}
+
+	log.FromContext(ctx).V(1).Info("Server certificate Secret found", "secretName", secretName)
+	return nil
+}
+
+// checkImagePullSecrets emits a warning event for each image pull Secret of the instance that does not exist.
+// A missing Secret does not fail the reconciliation: the kubelet ignores it, and images that are public or
+// already pulled on the node still start.
+func (r *LlamaStackDistributionReconciler) checkImagePullSecrets(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+	for _, pullSecret := range getImagePullSecrets(instance) {
+		if pullSecret.Name == "" {
+			continue
+		}
+		err := r.Get(ctx, types.NamespacedName{Name: pullSecret.Name, Namespace: instance.Namespace}, &corev1.Secret{})
+		switch {
+		case k8serrors.IsNotFound(err):
+			log.FromContext(ctx).Info("Image pull Secret not found", "secretName", pullSecret.Name)
+			r.recordEvent(instance, corev1.EventTypeWarning, EventReasonImagePullSecretNotFound,
+				fmt.Sprintf("Image pull Secret %s/%s not found, pulling the server images may fail", instance.Namespace, pullSecret.Name))
+		case err != nil:
+			return fmt.Errorf("failed to fetch image pull Secret %s/%s: %w", instance.Namespace, pullSecret.Name, err)
+		}
+	}
+	return nil
+}
+
+// userConfigMapDeletedMessage returns the condition message of a deleted user ConfigMap.
+func userConfigMapDeletedMessage(namespace, name string) string {
+	return fmt.Sprintf("ConfigMap %s/%s was deleted, the pods keep running the last valid configuration until it is recreated",
+		namespace, name)
+}
+
+// wasUserConfigMapFound reports whether the missing user ConfigMap of the spec was found before, i.e. it was deleted
+// rather than never created.
+func wasUserConfigMapFound(instance *llamav1alpha1.LlamaStackDistribution, namespace string) bool {
+	name := instance.RunUserConfig().ConfigMapName
+	if condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady); condition != nil &&
+		condition.Reason == ReasonUserConfigMapDeleted && condition.Message == userConfigMapDeletedMessage(namespace, name) {
+		return true
+	}
+	for _, ref := range instance.Status.References {
+		if ref.Purpose == llamav1alpha1.ReferencePurposeUserConfig && ref.Namespace == namespace && ref.Name == name &&
+			ref.LastObservedHash != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// userConfigMapDeleted reports the deletion of the user ConfigMap. The reconciliation stops before the Deployment,
+// so the pods are not restarted without their configuration.
+func (r *LlamaStackDistributionReconciler) userConfigMapDeleted(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	namespace string) error {
+	name := instance.RunUserConfig().ConfigMapName
+	message := userConfigMapDeletedMessage(namespace, name)
+	if condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady); condition == nil || condition.Reason != ReasonUserConfigMapDeleted {
+		log.FromContext(ctx).Info("Referenced ConfigMap was deleted, keeping the Deployment", "configMapName", name, "configMapNamespace", namespace)
+		r.recordEvent(instance, corev1.EventTypeWarning, EventReasonUserConfigMapDeleted, message)
+	}
+	SetUserConfigMapDeletedCondition(&instance.Status, message)
+	return fmt.Errorf("failed to find ConfigMap %s/%s: %w", namespace, name, errUserConfigMapDeleted)
+}
+
+// isUserConfigValidated reports whether the user ConfigMap with the given hash was already validated successfully.
+func isUserConfigValidated(instance *llamav1alpha1.LlamaStackDistribution, hash string) bool {
+	if !IsConditionTrue(&instance.Status, ConditionTypeUserConfigReady) {
+		return false
+	}
+	for _, ref := range instance.Status.References {
+		if ref.Purpose == llamav1alpha1.ReferencePurposeUserConfig && ref.LastObservedHash == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// getUserConfigKey returns the key of the user ConfigMap holding the run configuration.
+func getUserConfigKey(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if userConfig := instance.RunUserConfig(); userConfig != nil && userConfig.ConfigMapKey != "" {
+		return userConfig.ConfigMapKey
+	}
+	return userConfigRunYAMLKey
+}
+
+// validateUserConfigMap validates the run configuration held by the given key of the user ConfigMap data and,
+// unless disabled by the ValidateConfigMapContent feature flag, that its other YAML entries parse, so that a
+// malformed file mounted next to the run configuration is reported before the server fails to load it.
+func (r *LlamaStackDistributionReconciler) validateUserConfigMap(data map[string]string, key string, containerPort int32) error {
+	if err := validateUserConfigData(data, key, containerPort); err != nil {
+		return err
+	}
+	if !r.ValidateConfigMapContent {
+		return nil
+	}
+	return validateUserConfigYAML(data, key)
+}
+
+// validateUserConfigYAML validates that the entries of the user ConfigMap data other than the run configuration
+// key parse as YAML. Only entries named like YAML or JSON files are checked, since the ConfigMap may also carry
+// scripts or certificates. Entries are checked in key order so that the reported error is stable.
+func validateUserConfigYAML(data map[string]string, key string) error {
+	for _, entry := range slices.Sorted(maps.Keys(data)) {
+		if entry == key || !isYAMLFileName(entry) {
+			continue
+		}
+		var content any
+		if err := yaml.Unmarshal([]byte(data[entry]), &content); err != nil {
+			return fmt.Errorf("failed to parse '%s': %w", entry, err)
+		}
+	}
+	return nil
+}
+
+// isYAMLFileName reports whether the ConfigMap key names a YAML or JSON file.
+func isYAMLFileName(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
 	}
-
-	return deploy.ApplyNetworkPolicy(ctx, r.Client, r.Scheme, instance, networkPolicy, logger)
 }
 
-// reconcileUserConfigMap validates that the referenced ConfigMap exists.
-func (r *LlamaStackDistributionReconciler) reconcileUserConfigMap(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
-	logger := log.FromContext(ctx)
-
-	if !r.hasUserConfigMap(instance) {
-		logger.V(1).Info("No user ConfigMap specified, skipping")
-		return nil
+// validateUserConfigData validates that the given key of the user ConfigMap data holds a run configuration
+// with the top-level fields the llama-stack server requires to start, listening on the container port.
+func validateUserConfigData(data map[string]string, key string, containerPort int32) error {
+	runYAML, exists := data[key]
+	if !exists {
+		keys := slices.Sorted(maps.Keys(data))
+		return fmt.Errorf("failed to find key '%s' in user ConfigMap, available keys: [%s]", key, strings.Join(keys, ", "))
 	}
 
-	// Determine the ConfigMap namespace - default to the same namespace as the LlamaStackDistribution.
-	configMapNamespace := r.getUserConfigMapNamespace(instance)
-
-	logger.V(1).Info("Validating referenced ConfigMap exists",
-		"configMapName", instance.Spec.Server.UserConfig.ConfigMapName,
-		"configMapNamespace", configMapNamespace)
+	var runConfig map[string]any
+	if err := yaml.Unmarshal([]byte(runYAML), &runConfig); err != nil {
+		return fmt.Errorf("failed to parse '%s': %w", key, err)
+	}
+	if len(runConfig) == 0 {
+		return fmt.Errorf("failed to validate '%s': configuration is empty", key)
+	}
+	for _, field := range []string{"version", "providers"} {
+		if _, exists := runConfig[field]; !exists {
+			return fmt.Errorf("failed to validate '%s': missing top-level field '%s'", key, field)
+		}
+	}
+	if _, isMap := runConfig["providers"].(map[string]any); !isMap {
+		return fmt.Errorf("failed to validate '%s': 'providers' must map APIs to their providers", key)
+	}
 
-	// Check if the ConfigMap exists
-	configMap := &corev1.ConfigMap{}
-	err := r.Get(ctx, types.NamespacedName{
-		Name:      instance.Spec.Server.UserConfig.ConfigMapName,
-		Namespace: configMapNamespace,
-	}, configMap)
+	serverPort, err := runConfigServerPort(runConfig, key)
 	if err != nil {
-		if k8serrors.IsNotFound(err) {
-			logger.Error(err, "Referenced ConfigMap not found",
-				"configMapName", instance.Spec.Server.UserConfig.ConfigMapName,
-				"configMapNamespace", configMapNamespace)
-			return fmt.Errorf("failed to find referenced ConfigMap %s/%s", configMapNamespace, instance.Spec.Server.UserConfig.ConfigMapName)
+		return err
+	}
+	// A port left to the server default or substituted from the environment cannot be checked, the container
+	// port may match it through the args or the environment of the container
+	if serverPort != 0 && serverPort != int(containerPort) {
+		return fmt.Errorf("failed to validate '%s': the server listens on port %d but the container port is %d, "+
+			"set spec.server.containerSpec.port to the same port or leave it unset", key, serverPort, containerPort)
+	}
+	return nil
+}
+
+// runConfigServerPort returns the port set explicitly in the server section of the run configuration, or 0 when
+// the port is unknown: not set, or substituted from the environment when the server starts.
+func runConfigServerPort(runConfig map[string]any, key string) (int, error) {
+	server, exists := runConfig["server"]
+	if !exists {
+		return 0, nil
+	}
+	serverConfig, isMap := server.(map[string]any)
+	if !isMap {
+		return 0, fmt.Errorf("failed to validate '%s': 'server' must be a mapping", key)
+	}
+	switch port := serverConfig["port"].(type) {
+	case nil:
+		return 0, nil
+	case int:
+		if port < 1 || port > 65535 {
+			return 0, fmt.Errorf("failed to validate '%s': 'server.port' %d is not a valid port", key, port)
+		}
+		return port, nil
+	case string:
+		if !strings.Contains(port, "${") {
+			return 0, fmt.Errorf("failed to validate '%s': 'server.port' must be a number, got '%s'", key, port)
 		}
-		return fmt.Errorf("failed to fetch ConfigMap %s/%s: %w", configMapNamespace, instance.Spec.Server.UserConfig.ConfigMapName, err)
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("failed to validate '%s': 'server.port' must be a number, got %v", key, port)
 	}
+}
 
-	logger.V(1).Info("User ConfigMap found and validated",
-		"configMap", configMap.Name,
-		"namespace", configMap.Namespace,
-		"dataKeys", len(configMap.Data))
-	return nil
+// inheritUserConfigPort uses the port of the run configuration as the container port of an instance that sets
+// none, so that the container, the Service and the health checks follow the port the server listens on.
+// The port is only set on the instance being reconciled, the stored spec is left unchanged.
+func inheritUserConfigPort(instance *llamav1alpha1.LlamaStackDistribution, data map[string]string, key string) {
+	if instance.Spec.Server.ContainerSpec.Port != 0 {
+		return
+	}
+	var runConfig map[string]any
+	// Unparsable configurations are reported by the validation
+	if err := yaml.Unmarshal([]byte(data[key]), &runConfig); err != nil {
+		return
+	}
+	port, err := runConfigServerPort(runConfig, key)
+	if err != nil || port == 0 || port == int(llamav1alpha1.DefaultServerPort) {
+		return
+	}
+	instance.Spec.Server.ContainerSpec.Port = int32(port)
 }
 
 // isValidPEM validates that the given data contains valid PEM formatted content.
@@ -1170,77 +2889,144 @@ func isValidPEM(data []byte) bool {
 	return block != nil
 }
 
-// reconcileCABundleConfigMap validates that the referenced CA bundle ConfigMap exists.
-func (r *LlamaStackDistributionReconciler) reconcileCABundleConfigMap(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
+// reconcileCABundle validates the CA bundle of the instance and reports the result in the CABundleReady condition.
+// A bundle made of multiple keys, explicitly configured or auto-detected, is concatenated by the operator into
+// the derived ConfigMap mounted by the server pods, so every pod of the same spec trusts the same bundle bytes.
+func (r *LlamaStackDistributionReconciler) reconcileCABundle(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) error {
 	logger := log.FromContext(ctx)
 
-	if !r.hasCABundleConfigMap(instance) {
-		logger.V(1).Info("No CA bundle ConfigMap specified, skipping")
-		return nil
+	var source *corev1.ConfigMap
+	var keys []string
+	if r.hasCABundleConfigMap(instance) {
+		configMap, err := r.reconcileCABundleConfigMap(ctx, instance)
+		if err != nil {
+			SetCABundleReadyCondition(&instance.Status, false, err.Error())
+			return err
+		}
+		source, keys = configMap, instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys
+	} else {
+		configMap, detectedKeys, err := r.detectODHTrustedCABundle(ctx, instance)
+		if err != nil {
+			return err
+		}
+		source, keys = configMap, detectedKeys
+	}
+
+	// A single key is mounted directly from the referenced ConfigMap and needs no derived ConfigMap
+	if source == nil || len(keys) == 0 {
+		if r.hasCABundleConfigMap(instance) {
+			SetCABundleReadyCondition(&instance.Status, true, MessageCABundleValid)
+		} else {
+			RemoveCondition(&instance.Status, ConditionTypeCABundleReady)
+		}
+		return deploy.HandleDisabledCABundle(ctx, r.Client, instance, logger)
+	}
+
+	bundle, _ := concatenateCABundle(source.Data, keys)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploy.GetCABundleConfigMapName(instance),
+			Namespace: instance.Namespace,
+			Labels:    map[string]string{"app.kubernetes.io/instance": instance.Name},
+		},
+		Data: map[string]string{DefaultCABundleKey: bundle},
+	}
+	if err := deploy.ApplyConfigMap(ctx, r.Client, r.Scheme, r.OwnerReferencePolicy, instance, configMap, logger); err != nil {
+		SetCABundleReadyCondition(&instance.Status, false, fmt.Sprintf("Failed to apply CA bundle ConfigMap: %v", err))
+		return err
+	}
+	SetCABundleReadyCondition(&instance.Status, true, MessageCABundleValid)
+	return nil
+}
+
+// concatenateCABundle concatenates the CA bundle keys of a ConfigMap in the given order. Each certificate block is
+// normalized to end with a single newline so that the result only depends on the certificates. Keys missing from
+// the ConfigMap are returned separately.
+func concatenateCABundle(data map[string]string, keys []string) (string, []string) {
+	var bundle strings.Builder
+	var missing []string
+	for _, key := range keys {
+		value, exists := data[key]
+		if !exists {
+			missing = append(missing, key)
+			continue
+		}
+		value = strings.TrimRight(value, " \t\r\n")
+		if value == "" {
+			continue
+		}
+		bundle.WriteString(value)
+		bundle.WriteString("\n")
 	}
+	return bundle.String(), missing
+}
+
+// reconcileCABundleConfigMap validates that the referenced CA bundle ConfigMap exists and holds valid PEM data
+// in every configured key, and returns it.
+func (r *LlamaStackDistributionReconciler) reconcileCABundleConfigMap(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (*corev1.ConfigMap, error) {
+	logger := log.FromContext(ctx)
 
 	// Determine the ConfigMap namespace - default to the same namespace as the LlamaStackDistribution.
 	configMapNamespace := r.getCABundleConfigMapNamespace(instance)
+	configMapName := instance.Spec.Server.TLSConfig.CABundle.ConfigMapName
 
 	logger.V(1).Info("Validating referenced CA bundle ConfigMap exists",
-		"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+		"configMapName", configMapName,
 		"configMapNamespace", configMapNamespace)
 
 	// Check if the ConfigMap exists
 	configMap := &corev1.ConfigMap{}
 	err := r.Get(ctx, types.NamespacedName{
-		Name:      instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+		Name:      configMapName,
 		Namespace: configMapNamespace,
 	}, configMap)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			logger.Error(err, "Referenced CA bundle ConfigMap not found",
-				"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+				"configMapName", configMapName,
 				"configMapNamespace", configMapNamespace)
-			return fmt.Errorf("failed to find referenced CA bundle ConfigMap %s/%s", configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName)
+			return nil, fmt.Errorf("failed to find referenced CA bundle ConfigMap %s/%s", configMapNamespace, configMapName)
 		}
-		return fmt.Errorf("failed to fetch CA bundle ConfigMap %s/%s: %w", configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName, err)
+		return nil, fmt.Errorf("failed to fetch CA bundle ConfigMap %s/%s: %w", configMapNamespace, configMapName, err)
 	}
 
 	// Validate that the specified keys exist in the ConfigMap
 	var keysToValidate []string
 	if len(instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys) > 0 {
 		keysToValidate = instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys
+		if err := validateConfigMapKeys(keysToValidate); err != nil {
+			return nil, fmt.Errorf("failed to validate CA bundle keys of ConfigMap %s/%s: %w", configMapNamespace, configMapName, err)
+		}
 	} else {
 		// Default to DefaultCABundleKey when no keys are specified
 		keysToValidate = []string{DefaultCABundleKey}
 	}
 
-	for _, key := range keysToValidate {
-		if _, exists := configMap.Data[key]; !exists {
-			logger.Error(err, "CA bundle key not found in ConfigMap",
-				"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
-				"configMapNamespace", configMapNamespace,
-				"key", key)
-			return fmt.Errorf("failed to find CA bundle key '%s' in ConfigMap %s/%s", key, configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName)
-		}
-
-		// Validate that the key contains valid PEM data
-		pemData, exists := configMap.Data[key]
-		if !exists {
-			// This should not happen since we checked above, but just to be safe
-			return fmt.Errorf("failed to find CA bundle key '%s' in ConfigMap %s/%s", key, configMapNamespace, instance.Spec.Server.TLSConfig.CABundle.ConfigMapName)
-		}
+	// Report all missing keys at once instead of failing on the first one
+	if _, missing := concatenateCABundle(configMap.Data, keysToValidate); len(missing) > 0 {
+		logger.Error(nil, "CA bundle keys not found in ConfigMap",
+			"configMapName", configMapName,
+			"configMapNamespace", configMapNamespace,
+			"keys", missing)
+		return nil, fmt.Errorf("failed to find CA bundle keys %s in ConfigMap %s/%s",
+			strings.Join(missing, ", "), configMapNamespace, configMapName)
+	}
 
-		if !isValidPEM([]byte(pemData)) {
+	for _, key := range keysToValidate {
+		if !isValidPEM([]byte(configMap.Data[key])) {
 			logger.Error(nil, "CA bundle key contains invalid PEM data",
-				"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+				"configMapName", configMapName,
 				"configMapNamespace", configMapNamespace,
 				"key", key)
-			return fmt.Errorf("failed to validate CA bundle key '%s' in ConfigMap %s/%s: contains invalid PEM data",
+			return nil, fmt.Errorf("failed to validate CA bundle key '%s' in ConfigMap %s/%s: contains invalid PEM data",
 				key,
 				configMapNamespace,
-				instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+				configMapName,
 			)
 		}
 
 		logger.V(1).Info("CA bundle key contains valid PEM data",
-			"configMapName", instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+			"configMapName", configMapName,
 			"configMapNamespace", configMapNamespace,
 			"key", key)
 	}
@@ -1250,7 +3036,7 @@ func (r *LlamaStackDistributionReconciler) reconcileCABundleConfigMap(ctx contex
 		"namespace", configMap.Namespace,
 		"keys", keysToValidate,
 		"dataKeys", len(configMap.Data))
-	return nil
+	return configMap, nil
 }
 
 // getConfigMapHash calculates a hash of the ConfigMap data to detect changes.
@@ -1263,45 +3049,181 @@ func (r *LlamaStackDistributionReconciler) getConfigMapHash(ctx context.Context,
 
 	configMap := &corev1.ConfigMap{}
 	err := r.Get(ctx, types.NamespacedName{
-		Name:      instance.Spec.Server.UserConfig.ConfigMapName,
+		Name:      instance.RunUserConfig().ConfigMapName,
 		Namespace: configMapNamespace,
 	}, configMap)
 	if err != nil {
 		return "", err
 	}
 
-	// Create a content-based hash that will change when the ConfigMap data changes
-	return fmt.Sprintf("%s-%s", configMap.ResourceVersion, configMap.Name), nil
+	return configMapDataHash(configMap), nil
 }
 
-// getCABundleConfigMapHash calculates a hash of the CA bundle ConfigMap data to detect changes.
-func (r *LlamaStackDistributionReconciler) getCABundleConfigMapHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
-	if !r.hasCABundleConfigMap(instance) {
+// getAdditionalConfigMapsHash calculates a hash of the data of all the additional ConfigMaps of the user config,
+// or an empty string if there are none.
+func (r *LlamaStackDistributionReconciler) getAdditionalConfigMapsHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
+	names := getAdditionalConfigMapNames(instance)
+	if len(names) == 0 {
 		return "", nil
 	}
 
-	configMapNamespace := r.getCABundleConfigMapNamespace(instance)
+	hashes := make(map[string]string, len(names))
+	for _, name := range names {
+		hash, err := r.getAdditionalConfigMapHash(ctx, instance, name)
+		if err != nil {
+			return "", err
+		}
+		hashes[name] = hash
+	}
+	return dataHash(hashes, nil), nil
+}
+
+// getAdditionalConfigMapHash calculates a hash of the data of an additional ConfigMap of the user config.
+func (r *LlamaStackDistributionReconciler) getAdditionalConfigMapHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	name string) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: r.getUserConfigMapNamespace(instance)}, configMap); err != nil {
+		return "", err
+	}
+	return configMapDataHash(configMap), nil
+}
+
+// getUserConfigsHash calculates a hash of the data of all the ConfigMaps of UserConfigs, in the order of their
+// namespaced names, or an empty string if there are none.
+func (r *LlamaStackDistributionReconciler) getUserConfigsHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
+	userConfigs := instance.Spec.Server.UserConfigs
+	if len(userConfigs) == 0 {
+		return "", nil
+	}
+
+	hashes := make(map[string]string, len(userConfigs))
+	for _, userConfig := range userConfigs {
+		hash, err := r.getUserConfigHash(ctx, instance, userConfig)
+		if err != nil {
+			return "", err
+		}
+		hashes[getUserConfigNamespace(instance, userConfig)+"/"+userConfig.ConfigMapName] = hash
+	}
+	return dataHash(hashes, nil), nil
+}
+
+// getUserConfigHash calculates a hash of the data of the ConfigMap of a user config.
+func (r *LlamaStackDistributionReconciler) getUserConfigHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	userConfig llamav1alpha1.UserConfigSpec) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Name: userConfig.ConfigMapName, Namespace: getUserConfigNamespace(instance, userConfig)}
+	if err := r.Get(ctx, key, configMap); err != nil {
+		return "", err
+	}
+	return configMapDataHash(configMap), nil
+}
+
+// getUserSecretHash calculates a hash of the Secret referenced by the secretRef of the instance to detect changes.
+func (r *LlamaStackDistributionReconciler) getUserSecretHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
+	if !r.hasUserSecret(instance) {
+		return "", nil
+	}
+
+	return r.getSecretHash(ctx, instance.Namespace, instance.Spec.Server.SecretRef.SecretName)
+}
+
+// getServerCertSecretHash calculates a hash of the Secret holding the serving certificate to detect rotations.
+func (r *LlamaStackDistributionReconciler) getServerCertSecretHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
+	if !hasServerCert(instance) {
+		return "", nil
+	}
+
+	return r.getSecretHash(ctx, instance.Namespace, instance.Spec.Server.TLSConfig.ServerCert.SecretName)
+}
+
+// getSecretHash fetches a Secret and returns the hash of its data.
+func (r *LlamaStackDistributionReconciler) getSecretHash(ctx context.Context, namespace, name string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret); err != nil {
+		return "", err
+	}
+
+	return secretDataHash(secret), nil
+}
+
+// secretDataHash returns a hash of a Secret that changes whenever its data changes.
+// Like configMapDataHash it ignores metadata-only changes.
+func secretDataHash(secret *corev1.Secret) string {
+	return dataHash(nil, secret.Data)
+}
+
+// configMapDataHash returns a hash of a ConfigMap that changes whenever its data changes.
+// It is a SHA-256 over the sorted keys and values of Data and BinaryData, so metadata-only
+// changes such as label or annotation updates do not restart the pods.
+func configMapDataHash(configMap *corev1.ConfigMap) string {
+	return dataHash(configMap.Data, configMap.BinaryData)
+}
+
+// dataHash returns a SHA-256 over the sorted keys and values of the data and binary data of an object.
+func dataHash(data map[string]string, binaryData map[string][]byte) string {
+	hasher := sha256.New()
+	// Length-prefix every field so that different key/value splits cannot produce the same input.
+	writeField := func(field []byte) {
+		fmt.Fprintf(hasher, "%d:", len(field))
+		hasher.Write(field)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		writeField([]byte("data"))
+		writeField([]byte(key))
+		writeField([]byte(data[key]))
+	}
+	for _, key := range slices.Sorted(maps.Keys(binaryData)) {
+		writeField([]byte("binaryData"))
+		writeField([]byte(key))
+		writeField(binaryData[key])
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// getCABundleConfigMapHash calculates a hash of the CA bundle mounted by the server pods to detect changes.
+// Both the referenced ConfigMap and the bundle concatenated by the operator are hashed by content, so pods are
+// only rolled out when the certificates change.
+func (r *LlamaStackDistributionReconciler) getCABundleConfigMapHash(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) (string, error) {
+	if !r.usesDerivedCABundle(ctx, instance) {
+		if !r.hasCABundleConfigMap(instance) {
+			return "", nil
+		}
+
+		configMap := &corev1.ConfigMap{}
+		err := r.Get(ctx, types.NamespacedName{
+			Name:      instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
+			Namespace: r.getCABundleConfigMapNamespace(instance),
+		}, configMap)
+		if err != nil {
+			return "", err
+		}
+
+		return configMapDataHash(configMap), nil
+	}
 
 	configMap := &corev1.ConfigMap{}
 	err := r.Get(ctx, types.NamespacedName{
-		Name:      instance.Spec.Server.TLSConfig.CABundle.ConfigMapName,
-		Namespace: configMapNamespace,
+		Name:      deploy.GetCABundleConfigMapName(instance),
+		Namespace: instance.Namespace,
 	}, configMap)
 	if err != nil {
 		return "", err
 	}
 
-	// Create a content-based hash that will change when the ConfigMap data changes
-	// Include information about which keys are being used
-	var keyInfo string
-	if len(instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys) > 0 {
-		keyInfo = fmt.Sprintf("-%s", strings.Join(instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys, ","))
-	} else {
-		// Default to DefaultCABundleKey when no keys are specified
-		keyInfo = fmt.Sprintf("-%s", DefaultCABundleKey)
-	}
+	sum := sha256.Sum256([]byte(configMap.Data[DefaultCABundleKey]))
+	return hex.EncodeToString(sum[:]), nil
+}
 
-	return fmt.Sprintf("%s-%s%s", configMap.ResourceVersion, configMap.Name, keyInfo), nil
+// usesDerivedCABundle reports whether the server pods mount the CA bundle concatenated by the operator,
+// which is the case for a bundle made of multiple keys or auto-detected from the ODH trusted CA bundle.
+func (r *LlamaStackDistributionReconciler) usesDerivedCABundle(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution) bool {
+	if r.hasCABundleConfigMap(instance) {
+		return len(instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys) > 0
+	}
+	_, keys, err := r.detectODHTrustedCABundle(ctx, instance)
+	return err == nil && len(keys) > 0
 }
 
 // detectODHTrustedCABundle checks if the well-known ODH trusted CA bundle ConfigMap
@@ -1346,6 +3268,9 @@ func (r *LlamaStackDistributionReconciler) detectODHTrustedCABundle(ctx context.
 		}
 	}
 
+	// Map iteration order is random, sort the keys so the bundle is always concatenated in the same order
+	slices.Sort(keys)
+
 	logger.V(1).Info("ODH trusted CA bundle ConfigMap detected",
 		"configMapName", odhTrustedCABundleConfigMap,
 		"namespace", instance.Namespace,
@@ -1360,6 +3285,21 @@ func createDefaultConfigMap(configMapName types.NamespacedName) (*corev1.ConfigM
 		EnableNetworkPolicy: featureflags.FeatureFlag{
 			Enabled: featureflags.NetworkPolicyDefaultValue,
 		},
+		EnableQuotaPreflight: featureflags.FeatureFlag{
+			Enabled: featureflags.QuotaPreflightDefaultValue,
+		},
+		EnableRolloutSerialization: featureflags.FeatureFlag{
+			Enabled: featureflags.RolloutSerializationDefaultValue,
+		},
+		EnableSecurityContextDefaults: featureflags.FeatureFlag{
+			Enabled: featureflags.SecurityContextDefaultsDefaultValue,
+		},
+		EnableDefaultTopologySpread: featureflags.FeatureFlag{
+			Enabled: featureflags.DefaultTopologySpreadDefaultValue,
+		},
+		ValidateConfigMapContent: &featureflags.FeatureFlag{
+			Enabled: featureflags.ValidateConfigMapContentDefaultValue,
+		},
 	}
 
 	featureFlagsYAML, err := yaml.Marshal(featureFlags)
@@ -1379,23 +3319,168 @@ func createDefaultConfigMap(configMapName types.NamespacedName) (*corev1.ConfigM
 }
 
 // parseFeatureFlags extracts and parses feature flags from ConfigMap data.
-func parseFeatureFlags(configMapData map[string]string) (bool, error) {
-	enableNetworkPolicy := featureflags.NetworkPolicyDefaultValue
+// Flags missing from the ConfigMap keep their default value.
+func parseFeatureFlags(configMapData map[string]string) (featureflags.FeatureFlags, error) {
+	flags := featureflags.FeatureFlags{
+		EnableNetworkPolicy:           featureflags.FeatureFlag{Enabled: featureflags.NetworkPolicyDefaultValue},
+		EnableQuotaPreflight:          featureflags.FeatureFlag{Enabled: featureflags.QuotaPreflightDefaultValue},
+		EnableRolloutSerialization:    featureflags.FeatureFlag{Enabled: featureflags.RolloutSerializationDefaultValue},
+		EnableSecurityContextDefaults: featureflags.FeatureFlag{Enabled: featureflags.SecurityContextDefaultsDefaultValue},
+		EnableDefaultTopologySpread:   featureflags.FeatureFlag{Enabled: featureflags.DefaultTopologySpreadDefaultValue},
+		ValidateConfigMapContent:      &featureflags.FeatureFlag{Enabled: featureflags.ValidateConfigMapContentDefaultValue},
+	}
 
 	featureFlagsYAML, exists := configMapData[featureflags.FeatureFlagsKey]
 	if !exists {
-		return enableNetworkPolicy, nil
+		return flags, nil
 	}
 
-	var flags featureflags.FeatureFlags
 	if err := yaml.Unmarshal([]byte(featureFlagsYAML), &flags); err != nil {
-		return false, fmt.Errorf("failed to parse feature flags: %w", err)
+		return featureflags.FeatureFlags{}, fmt.Errorf("failed to parse feature flags: %w", err)
+	}
+
+	return flags, nil
+}
+
+// parseDistributionEntrypoints extracts and parses per-distribution default entrypoints from ConfigMap data.
+func parseDistributionEntrypoints(configMapData map[string]string) (map[string]DistributionEntrypoint, error) {
+	entrypointsYAML, exists := configMapData[distributionEntrypointsKey]
+	if !exists {
+		return nil, nil
+	}
+
+	var entrypoints map[string]DistributionEntrypoint
+	if err := yaml.Unmarshal([]byte(entrypointsYAML), &entrypoints); err != nil {
+		return nil, fmt.Errorf("failed to parse distribution entrypoints: %w", err)
+	}
+
+	for name, entrypoint := range entrypoints {
+		if len(entrypoint.Command) == 0 && len(entrypoint.Args) == 0 {
+			return nil, fmt.Errorf("failed to validate distribution entrypoint %q: command or args must be set", name)
+		}
+	}
+
+	return entrypoints, nil
+}
+
+// parseOwnerReferencePolicy extracts and parses the owner reference policy from ConfigMap data.
+func parseOwnerReferencePolicy(configMapData map[string]string) (deploy.OwnerReferencePolicy, error) {
+	policy := deploy.OwnerReferencePolicy{}
+
+	policyYAML, exists := configMapData[ownerReferencesKey]
+	if !exists {
+		return policy, nil
+	}
+
+	if err := yaml.Unmarshal([]byte(policyYAML), &policy); err != nil {
+		return deploy.OwnerReferencePolicy{}, fmt.Errorf("failed to parse owner reference policy: %w", err)
+	}
+	return policy, nil
+}
+
+// parseNetworkPolicyEnforcement extracts the NetworkPolicy enforcement override from ConfigMap data.
+// It returns nil if enforcement should be detected automatically.
+func parseNetworkPolicyEnforcement(configMapData map[string]string) (*bool, error) {
+	value, exists := configMapData[networkPolicyEnforcementKey]
+	if !exists {
+		return nil, nil
+	}
+
+	switch strings.TrimSpace(value) {
+	case "", "auto":
+		return nil, nil
+	case "enforced":
+		return ptr.To(true), nil
+	case "unverified":
+		return ptr.To(false), nil
+	default:
+		return nil, fmt.Errorf("failed to parse NetworkPolicy enforcement %q: must be one of auto, enforced, unverified", value)
+	}
+}
+
+// parseMinStorageSize extracts the smallest accepted PVC size from ConfigMap data.
+// A value of 0 disables the check.
+func parseMinStorageSize(configMapData map[string]string) (resource.Quantity, error) {
+	value, exists := configMapData[minStorageSizeKey]
+	if !exists || strings.TrimSpace(value) == "" {
+		return DefaultMinStorageSize.DeepCopy(), nil
+	}
+
+	minSize, err := resource.ParseQuantity(strings.TrimSpace(value))
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("failed to parse minimum storage size %q: %w", value, err)
+	}
+	if minSize.Sign() < 0 {
+		return resource.Quantity{}, fmt.Errorf("failed to parse minimum storage size %q: must not be negative", value)
+	}
+	return minSize, nil
+}
+
+// parseUtilityImage extracts the image of the init containers run by the operator from ConfigMap data.
+func parseUtilityImage(configMapData map[string]string) (string, error) {
+	value := strings.TrimSpace(configMapData[utilityImageKey])
+	if value == "" {
+		return DefaultUtilityImage, nil
+	}
+	if strings.ContainsAny(value, " \t\n") {
+		return "", fmt.Errorf("failed to parse utility image %q: must not contain whitespace", value)
+	}
+	return value, nil
+}
+
+// parseInitializingRequeueInterval extracts how often Initializing instances are requeued from ConfigMap data.
+// The value is a number of seconds.
+func parseInitializingRequeueInterval(configMapData map[string]string) (time.Duration, error) {
+	value, exists := configMapData[initializingRequeueSecondsKey]
+	if !exists || strings.TrimSpace(value) == "" {
+		return DefaultInitializingRequeueInterval, nil
+	}
+
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse initializing requeue seconds %q: %w", value, err)
+	}
+	if seconds <= 0 {
+		return 0, fmt.Errorf("failed to parse initializing requeue seconds %q: must be positive", value)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// parseHealthCheckFailureThreshold extracts the number of consecutive failed health checks that moves a ready
+// instance to the Failed phase from ConfigMap data.
+func parseHealthCheckFailureThreshold(configMapData map[string]string) (int, error) {
+	value, exists := configMapData[healthCheckFailureThresholdKey]
+	if !exists || strings.TrimSpace(value) == "" {
+		return DefaultHealthCheckFailureThreshold, nil
+	}
+
+	threshold, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse health check failure threshold %q: %w", value, err)
+	}
+	if threshold <= 0 {
+		return 0, fmt.Errorf("failed to parse health check failure threshold %q: must be positive", value)
+	}
+	return threshold, nil
+}
+
+// parseStatusExportConfig extracts and parses the status export sink from ConfigMap data.
+// It returns nil if the status export is not configured.
+func parseStatusExportConfig(configMapData map[string]string) (*statusexport.Config, error) {
+	configYAML, exists := configMapData[statusexport.ConfigKey]
+	if !exists {
+		return nil, nil
 	}
 
-	return flags.EnableNetworkPolicy.Enabled, nil
+	config := &statusexport.Config{}
+	if err := yaml.Unmarshal([]byte(configYAML), config); err != nil {
+		return nil, fmt.Errorf("failed to parse status export config: %w", err)
+	}
+	return config, nil
 }
 
-// NewLlamaStackDistributionReconciler creates a new reconciler with default image mappings.
+// NewLlamaStackDistributionReconciler creates the reconciler of the operator, configured from the operator ConfigMap.
+// The ConfigMap is created with the default feature flags if it does not exist.
 func NewLlamaStackDistributionReconciler(ctx context.Context, client client.Client, scheme *runtime.Scheme,
 	clusterInfo *cluster.ClusterInfo) (*LlamaStackDistributionReconciler, error) {
 	// get operator namespace
@@ -1430,27 +3515,94 @@ func NewLlamaStackDistributionReconciler(ctx context.Context, client client.Clie
 	}
 
 	// Parse feature flags from ConfigMap
-	enableNetworkPolicy, err := parseFeatureFlags(configMap.Data)
+	flags, err := parseFeatureFlags(configMap.Data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse feature flags: %w", err)
 	}
-	return &LlamaStackDistributionReconciler{
-		Client:              client,
-		Scheme:              scheme,
-		EnableNetworkPolicy: enableNetworkPolicy,
-		ClusterInfo:         clusterInfo,
-		httpClient:          &http.Client{Timeout: 5 * time.Second},
-	}, nil
+
+	r := NewReconciler(client, scheme, WithClusterInfo(clusterInfo), WithFeatureFlags(flags))
+	if err := r.applyOperatorConfig(configMap.Data); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// applyOperatorConfig configures the reconciler from the options of the operator ConfigMap other than the feature flags.
+func (r *LlamaStackDistributionReconciler) applyOperatorConfig(data map[string]string) error {
+	distributionEntrypoints, err := parseDistributionEntrypoints(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse distribution entrypoints: %w", err)
+	}
+
+	ownerReferencePolicy, err := parseOwnerReferencePolicy(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse owner reference policy: %w", err)
+	}
+
+	networkPolicyEnforcement, err := parseNetworkPolicyEnforcement(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse NetworkPolicy enforcement: %w", err)
+	}
+	if networkPolicyEnforcement != nil && r.ClusterInfo != nil {
+		r.ClusterInfo.NetworkPolicyEnforced = *networkPolicyEnforcement
+	}
+
+	minStorageSize, err := parseMinStorageSize(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse minimum storage size: %w", err)
+	}
+
+	initializingRequeueInterval, err := parseInitializingRequeueInterval(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse initializing requeue interval: %w", err)
+	}
+
+	utilityImage, err := parseUtilityImage(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse utility image: %w", err)
+	}
+
+	healthCheckFailureThreshold, err := parseHealthCheckFailureThreshold(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse health check failure threshold: %w", err)
+	}
+
+	configMapWatchNamespaces, err := parseConfigMapWatchNamespaces(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse ConfigMap watch namespaces: %w", err)
+	}
+
+	statusExportConfig, err := parseStatusExportConfig(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse status export config: %w", err)
+	}
+	var statusPublisher statusexport.Publisher
+	if statusExportConfig != nil {
+		publisher, err := statusexport.NewHTTPPublisher(*statusExportConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create status publisher: %w", err)
+		}
+		statusPublisher = publisher
+	}
+
+	r.DistributionEntrypoints = distributionEntrypoints
+	r.OwnerReferencePolicy = ownerReferencePolicy
+	r.StatusPublisher = statusPublisher
+	r.MinStorageSize = minStorageSize
+	r.InitializingRequeueInterval = initializingRequeueInterval
+	r.UtilityImage = utilityImage
+	r.HealthCheckFailureThreshold = healthCheckFailureThreshold
+	r.ConfigMapWatchNamespaces = configMapWatchNamespaces
+	return nil
 }
 
 // NewTestReconciler creates a reconciler for testing, allowing injection of a custom http client and feature flags.
 func NewTestReconciler(client client.Client, scheme *runtime.Scheme, clusterInfo *cluster.ClusterInfo,
 	httpClient *http.Client, enableNetworkPolicy bool) *LlamaStackDistributionReconciler {
-	return &LlamaStackDistributionReconciler{
-		Client:              client,
-		Scheme:              scheme,
-		ClusterInfo:         clusterInfo,
-		httpClient:          httpClient,
-		EnableNetworkPolicy: enableNetworkPolicy,
-	}
+	r := NewReconciler(client, scheme, WithClusterInfo(clusterInfo), WithHTTPClient(httpClient))
+	r.EnableNetworkPolicy = enableNetworkPolicy
+	// Tests enable periodic re-validation and the provider cap explicitly
+	r.UserConfigRevalidationInterval = 0
+	r.MaxStatusProviders = 0
+	return r
 }
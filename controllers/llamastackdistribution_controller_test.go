@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -12,17 +13,28 @@ import (
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	controllers "github.com/llamastack/llama-stack-k8s-operator/controllers"
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/deploy"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
@@ -102,6 +114,32 @@ func TestStorageConfiguration(t *testing.T) {
 				MountPath: "/custom/path",
 			},
 		},
+		{
+			name: "Storage with storage class and access modes",
+			buildInstance: func(namespace string) *llamav1alpha1.LlamaStackDistribution {
+				storage := DefaultTestStorage()
+				storage.StorageClassName = ptr.To("fast-nvme")
+				storage.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+				return NewDistributionBuilder().
+					WithName("test").
+					WithNamespace(namespace).
+					WithReplicas(2).
+					WithStorage(storage).
+					Build()
+			},
+			expectedVolume: corev1.Volume{
+				Name: "lls-storage",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "test-pvc",
+					},
+				},
+			},
+			expectedMount: corev1.VolumeMount{
+				Name:      "lls-storage",
+				MountPath: llamav1alpha1.DefaultMountPath,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -142,6 +180,15 @@ func TestStorageConfiguration(t *testing.T) {
 				} else {
 					AssertPVCHasSize(t, pvc, expectedSize.String())
 				}
+				AssertPVCHasStorageClassAndAccessModes(t, pvc, instance.Spec.Server.Storage)
+
+				// the effective size, including the default, is reported in the status
+				updated := &llamav1alpha1.LlamaStackDistribution{}
+				require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, updated))
+				require.NotNil(t, updated.Status.Storage, "storage status should be set")
+				requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+				require.Zero(t, requested.Cmp(updated.Status.Storage.RequestedSize),
+					"status should report the requested size %s, got %s", requested.String(), updated.Status.Storage.RequestedSize.String())
 			}
 		})
 	}
@@ -255,6 +302,411 @@ server:
 	// so we skip the isConfigMapReferenced checks which rely on field indexing
 }
 
+func TestAdditionalConfigMapsWatchingFunctionality(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-additional-configmaps")
+
+	// The run configuration and the provider files are split across several ConfigMaps
+	userConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: namespace.Name},
+		Data:       map[string]string{"run.yaml": "version: '2'\nimage_name: ollama\n"},
+	}
+	providersConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "providers-config", Namespace: namespace.Name},
+		Data:       map[string]string{"providers.yaml": "inference: []\n"},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), userConfigMap))
+	require.NoError(t, k8sClient.Create(t.Context(), providersConfigMap))
+
+	instance := NewDistributionBuilder().
+		WithName("test-additional-configmaps").
+		WithNamespace(namespace.Name).
+		WithUserConfig(userConfigMap.Name).
+		WithAdditionalConfigMaps(providersConfigMap.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	ReconcileDistribution(t, instance, false)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+
+	initialHash := deployment.Spec.Template.Annotations["configmap.hash/additional-config"]
+	require.NotEmpty(t, initialHash, "additional ConfigMaps hash annotation should be present")
+
+	// Updating an additional ConfigMap must roll the pods, like the user ConfigMap does
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(providersConfigMap), providersConfigMap))
+	providersConfigMap.Data["providers.yaml"] = "inference:\n- provider_id: ollama\n"
+	require.NoError(t, k8sClient.Update(t.Context(), providersConfigMap))
+
+	ReconcileDistribution(t, instance, false)
+
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["configmap.hash/additional-config"]
+			return newHash != initialHash && newHash != ""
+		}, "additional ConfigMaps hash should be updated after an additional ConfigMap data change")
+}
+
+func TestUserConfigsWatchingFunctionality(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	namespace := createTestNamespace(t, "test-user-configs")
+
+	// The run configuration and the model definitions are mounted at their own path
+	runConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-config", Namespace: namespace.Name},
+		Data:       map[string]string{"run.yaml": "version: '2'\nimage_name: ollama\n"},
+	}
+	modelsConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: namespace.Name},
+		Data:       map[string]string{"models.yaml": "models: []\n"},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), runConfigMap))
+	require.NoError(t, k8sClient.Create(t.Context(), modelsConfigMap))
+
+	instance := NewDistributionBuilder().
+		WithName("test-user-configs").
+		WithNamespace(namespace.Name).
+		WithUserConfigs(
+			llamav1alpha1.UserConfigSpec{ConfigMapName: runConfigMap.Name},
+			llamav1alpha1.UserConfigSpec{ConfigMapName: modelsConfigMap.Name, MountPath: "/etc/models"},
+		).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	ReconcileDistribution(t, instance, false)
+
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].VolumeMounts,
+		corev1.VolumeMount{Name: "user-config-1", MountPath: "/etc/models", ReadOnly: true})
+	initialHash := deployment.Spec.Template.Annotations["configmap.hash/user-configs"]
+	require.NotEmpty(t, initialHash, "user ConfigMaps hash annotation should be present")
+
+	// Updating a mounted ConfigMap must roll the pods
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(modelsConfigMap), modelsConfigMap))
+	modelsConfigMap.Data["models.yaml"] = "models:\n- model_id: llama3\n"
+	require.NoError(t, k8sClient.Update(t.Context(), modelsConfigMap))
+
+	ReconcileDistribution(t, instance, false)
+
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["configmap.hash/user-configs"]
+			return newHash != initialHash && newHash != ""
+		}, "user ConfigMaps hash should be updated after a mounted ConfigMap data change")
+}
+
+func TestSecretWatchingFunctionality(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// Create a test namespace
+	namespace := createTestNamespace(t, "test-secret-watch")
+
+	// Create a Secret
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-credentials",
+			Namespace: namespace.Name,
+		},
+		StringData: map[string]string{
+			"VLLM_API_TOKEN": "initial-token",
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), secret))
+
+	// Create a LlamaStackDistribution that references the Secret
+	instance := NewDistributionBuilder().
+		WithName("test-secret-reference").
+		WithNamespace(namespace.Name).
+		WithSecretRef(secret.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// Reconcile to create initial deployment
+	ReconcileDistribution(t, instance, false)
+
+	// Get the initial deployment and check for Secret hash annotation
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+
+	initialHash := deployment.Spec.Template.Annotations["secret.hash/user-secret"]
+	require.NotEmpty(t, initialHash, "Secret hash annotation should be present")
+
+	// Rotate the credentials
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, secret))
+	secret.Data["VLLM_API_TOKEN"] = []byte("rotated-token")
+	require.NoError(t, k8sClient.Update(t.Context(), secret))
+
+	// Trigger reconciliation (in real scenarios this would be triggered by the watch)
+	ReconcileDistribution(t, instance, false)
+
+	// Verify the deployment was updated with a new hash
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["secret.hash/user-secret"]
+			return newHash != initialHash && newHash != ""
+		}, "Secret hash should be updated after Secret data change")
+
+	// Verify the Secret is recorded in the references of the status
+	updatedInstance := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, k8sClient.Get(t.Context(), deploymentKey, updatedInstance))
+	require.Contains(t, updatedInstance.Status.References, llamav1alpha1.ExternalReference{
+		Kind:             "Secret",
+		Namespace:        secret.Namespace,
+		Name:             secret.Name,
+		Purpose:          llamav1alpha1.ReferencePurposeUserSecret,
+		LastObservedHash: deployment.Spec.Template.Annotations["secret.hash/user-secret"],
+	})
+}
+
+func TestServerCertConfiguration(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-server-cert")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: namespace.Name},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       []byte("initial-certificate"),
+			corev1.TLSPrivateKeyKey: []byte("initial-key"),
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), secret))
+	instance := NewDistributionBuilder().
+		WithName("server-cert-test").
+		WithNamespace(namespace.Name).
+		Build()
+	instance.Spec.Server.TLSConfig = &llamav1alpha1.TLSConfig{
+		ServerCert: &llamav1alpha1.ServerCertConfig{SecretName: secret.Name},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert: the certificate is mounted and served ---
+	deployment := &appsv1.Deployment{}
+	deploymentKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	waitForResourceWithKey(t, k8sClient, deploymentKey, deployment)
+	require.Contains(t, deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: controllers.ServerCertVolumeName,
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+			SecretName:  secret.Name,
+			DefaultMode: ptr.To(corev1.SecretVolumeSourceDefaultMode),
+		}},
+	})
+	container := deployment.Spec.Template.Spec.Containers[0]
+	require.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name:      controllers.ServerCertVolumeName,
+		MountPath: controllers.ServerCertMountPath,
+		ReadOnly:  true,
+	})
+	require.Contains(t, container.Args, "--tls-certfile")
+	require.Equal(t, corev1.URISchemeHTTPS, container.ReadinessProbe.HTTPGet.Scheme)
+	initialHash := deployment.Spec.Template.Annotations["secret.hash/server-cert"]
+	require.NotEmpty(t, initialHash, "server certificate hash annotation should be present")
+
+	// --- act: rotate the certificate ---
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(secret), secret))
+	secret.Data[corev1.TLSCertKey] = []byte("rotated-certificate")
+	require.NoError(t, k8sClient.Update(t.Context(), secret))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	waitForResourceWithKeyAndCondition(
+		t, k8sClient, deploymentKey, deployment, func() bool {
+			newHash := deployment.Spec.Template.Annotations["secret.hash/server-cert"]
+			return newHash != initialHash && newHash != ""
+		}, "server certificate hash should be updated after the rotation")
+}
+
+func TestExternalReferencesStatus(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-references")
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-references-config",
+			Namespace: namespace.Name,
+		},
+		Data: map[string]string{
+			"run.yaml": "version: '2'\nimage_name: ollama\nproviders:\n  inference: []\n",
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), configMap))
+
+	instance := NewDistributionBuilder().
+		WithName("test-references").
+		WithNamespace(namespace.Name).
+		WithUserConfig(configMap.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	deployment := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name, deployment)
+
+	updatedInstance := &llamav1alpha1.LlamaStackDistribution{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name, updatedInstance)
+
+	require.Len(t, updatedInstance.Status.References, 1, "status should reference exactly the user ConfigMap")
+	reference := updatedInstance.Status.References[0]
+	require.Equal(t, "ConfigMap", reference.Kind)
+	require.Equal(t, configMap.Namespace, reference.Namespace)
+	require.Equal(t, configMap.Name, reference.Name)
+	require.Equal(t, llamav1alpha1.ReferencePurposeUserConfig, reference.Purpose)
+	require.Equal(t, deployment.Spec.Template.Annotations["configmap.hash/user-config"], reference.LastObservedHash,
+		"the observed hash should match the hash used to roll the deployment")
+}
+
+func TestUserConfigRevalidation(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-user-config-revalidation")
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-revalidation-config",
+			Namespace: namespace.Name,
+		},
+		Data: map[string]string{
+			"run.yaml": "version: '2'\nimage_name: ollama\nproviders:\n  inference: []\n",
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), configMap))
+
+	instance := NewDistributionBuilder().
+		WithName("test-revalidation").
+		WithNamespace(namespace.Name).
+		WithUserConfig(configMap.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	reconciler := createTestReconciler()
+	reconciler.UserConfigRevalidationInterval = time.Minute
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+
+	// --- act (valid config) ---
+	_, err := reconciler.Reconcile(t.Context(), req)
+	require.NoError(t, err, "reconciliation should succeed with a valid user ConfigMap")
+
+	// --- assert ---
+	updatedInstance := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, updatedInstance))
+	condition := meta.FindStatusCondition(updatedInstance.Status.Conditions, controllers.ConditionTypeUserConfigReady)
+	require.NotNil(t, condition, "UserConfigReady condition should be set")
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, deployment))
+	validHash := deployment.Spec.Template.Annotations["configmap.hash/user-config"]
+	require.NotEmpty(t, validHash)
+
+	// --- act (config becomes invalid) ---
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}, configMap))
+	configMap.Data["run.yaml"] = "providers: [unterminated"
+	require.NoError(t, k8sClient.Update(t.Context(), configMap))
+
+	_, err = reconciler.Reconcile(t.Context(), req)
+	require.Error(t, err, "reconciliation should fail once the user ConfigMap is invalid")
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, updatedInstance))
+	condition = meta.FindStatusCondition(updatedInstance.Status.Conditions, controllers.ConditionTypeUserConfigReady)
+	require.NotNil(t, condition, "UserConfigReady condition should be set")
+	require.Equal(t, metav1.ConditionFalse, condition.Status, "condition should flip once the ConfigMap becomes invalid")
+	require.Equal(t, controllers.ReasonUserConfigInvalid, condition.Reason)
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, deployment))
+	require.Equal(t, validHash, deployment.Spec.Template.Annotations["configmap.hash/user-config"],
+		"the pods should keep running the last valid config")
+
+	// --- act (config is fixed) ---
+	require.NoError(t, k8sClient.Get(t.Context(),
+		types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}, configMap))
+	configMap.Data["run.yaml"] = "version: '2'\nimage_name: ollama\nproviders:\n  inference: []\nserver:\n  port: 8321\n"
+	require.NoError(t, k8sClient.Update(t.Context(), configMap))
+
+	_, err = reconciler.Reconcile(t.Context(), req)
+	require.NoError(t, err, "reconciliation should proceed once the user ConfigMap is fixed")
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, updatedInstance))
+	require.True(t, meta.IsStatusConditionTrue(updatedInstance.Status.Conditions, controllers.ConditionTypeUserConfigReady))
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, deployment))
+	require.NotEqual(t, validHash, deployment.Spec.Template.Annotations["configmap.hash/user-config"],
+		"the pods should be rolled with the fixed config")
+}
+
+func TestKueueQueueAdmission(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-kueue")
+	instance := NewDistributionBuilder().
+		WithName("test-kueue").
+		WithNamespace(namespace.Name).
+		WithQueue("gpu-queue", true).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	reconciler := createTestReconciler()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+
+	// --- act & assert (Kueue missing) ---
+	_, err := reconciler.Reconcile(t.Context(), req)
+	require.Error(t, err, "reconciliation should fail when Kueue is not installed")
+	require.Contains(t, err.Error(), "Kueue is not installed")
+
+	// --- act (Kueue installed) ---
+	reconciler.ClusterInfo.KueueAvailable = true
+	_, err = reconciler.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	// --- assert ---
+	deployment := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name, deployment)
+	require.Equal(t, "gpu-queue", deployment.Spec.Template.Labels["kueue.x-k8s.io/queue-name"],
+		"pod template should be labeled with the Kueue queue")
+	require.Equal(t, []corev1.PodSchedulingGate{{Name: "kueue.x-k8s.io/admission"}}, deployment.Spec.Template.Spec.SchedulingGates,
+		"pod template should carry the Kueue admission gate")
+
+	// --- act (a gated pod is waiting for admission) ---
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Name + "-gated",
+			Namespace: instance.Namespace,
+			Labels:    deployment.Spec.Template.Labels,
+		},
+		Spec: *deployment.Spec.Template.Spec.DeepCopy(),
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), pod))
+
+	_, err = reconciler.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	// --- assert ---
+	updatedInstance := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, updatedInstance))
+	require.Equal(t, llamav1alpha1.LlamaStackDistributionPhasePending, updatedInstance.Status.Phase)
+	condition := meta.FindStatusCondition(updatedInstance.Status.Conditions, controllers.ConditionTypeQueuedForResources)
+	require.NotNil(t, condition, "QueuedForResources condition should be set")
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.Equal(t, controllers.ReasonWaitingForAdmission, condition.Reason)
+}
+
 func TestReconcile(t *testing.T) {
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
@@ -319,24 +771,94 @@ func TestReconcile(t *testing.T) {
 	AssertResourceOwnedByInstance(t, deployment, instance)
 	AssertResourceOwnedByInstance(t, networkpolicy, instance)
 	AssertResourceOwnedByInstance(t, serviceAccount, instance)
-}
 
-// Define a custom roundtripper type for testing.
-type mockRoundTripper struct {
-	RoundTripFunc func(req *http.Request) (*http.Response, error)
-}
+	// Egress rules restrict the outbound traffic of the server pods
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), instance))
+	instance.Spec.Server.NetworkPolicy = &llamav1alpha1.NetworkPolicySpec{
+		EgressRules: []llamav1alpha1.EgressRuleSpec{
+			{Namespace: "ollama", PodSelector: map[string]string{"app": "ollama"}, Port: 11434},
+			{Port: 443},
+		},
+	}
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, true)
 
-// RoundTrip satisfies the http.RoundTripper interface and calls the mock function.
-func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	return m.RoundTripFunc(req)
+	npKey := types.NamespacedName{Name: instance.Name + "-network-policy", Namespace: instance.Namespace}
+	waitForResourceWithKeyAndCondition(t, k8sClient, npKey, networkpolicy, func() bool {
+		return len(networkpolicy.Spec.Egress) > 0
+	}, "NetworkPolicy should have egress rules")
+	require.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		networkpolicy.Spec.PolicyTypes)
+	// DNS rule followed by the two requested rules
+	require.Len(t, networkpolicy.Spec.Egress, 3)
+	require.Equal(t, int32(11434), networkpolicy.Spec.Egress[1].Ports[0].Port.IntVal)
+	require.Equal(t, "ollama", networkpolicy.Spec.Egress[1].To[0].NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"])
+	require.Empty(t, networkpolicy.Spec.Egress[2].To, "a rule without destination should allow any address")
+
+	// Removing the egress rules makes the policy ingress-only again
+	require.NoError(t, k8sClient.Get(t.Context(), client.ObjectKeyFromObject(instance), instance))
+	instance.Spec.Server.NetworkPolicy = nil
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, true)
+
+	waitForResourceWithKeyAndCondition(t, k8sClient, npKey, networkpolicy, func() bool {
+		return len(networkpolicy.Spec.Egress) == 0
+	}, "NetworkPolicy egress rules should be removed")
+	AssertNetworkPolicyIsIngressOnly(t, networkpolicy)
 }
 
-// newMockAPIResponse is a test helper that takes any data structure,
-// marshals it to JSON, and returns a complete http response.
-func newMockAPIResponse(t *testing.T, data any) *http.Response {
-	t.Helper()
-	jsonBytes, err := json.Marshal(data)
-	require.NoError(t, err)
+func TestReconcileExtraPorts(t *testing.T) {
+	// --- arrange ---
+	operatorNamespaceName := "test-operator-namespace"
+	t.Setenv("OPERATOR_NAMESPACE", operatorNamespaceName)
+
+	namespace := createTestNamespace(t, "test-extra-ports")
+	instance := NewDistributionBuilder().
+		WithName("test-extra-ports").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		WithPort(llamav1alpha1.DefaultServerPort).
+		Build()
+	instance.Spec.Server.ContainerSpec.ExtraPorts = []corev1.ContainerPort{
+		{Name: "grpc", ContainerPort: 50051},
+		{ContainerPort: 4317},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// --- act ---
+	ReconcileDistribution(t, instance, true)
+
+	service := &corev1.Service{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name+"-service", service)
+	deployment := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name, deployment)
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	waitForResource(t, k8sClient, instance.Namespace, instance.Name+"-network-policy", networkPolicy)
+
+	// --- assert ---
+	require.Len(t, deployment.Spec.Template.Spec.Containers[0].Ports, 3)
+	require.Len(t, service.Spec.Ports, 3)
+	require.Equal(t, llamav1alpha1.DefaultServicePortName, service.Spec.Ports[0].Name, "the server port should stay first")
+	require.Equal(t, llamav1alpha1.DefaultServerPort, service.Spec.Ports[0].Port)
+	AssertContainerPortsExposed(t, service, networkPolicy, deployment)
+}
+
+// Define a custom roundtripper type for testing.
+type mockRoundTripper struct {
+	RoundTripFunc func(req *http.Request) (*http.Response, error)
+}
+
+// RoundTrip satisfies the http.RoundTripper interface and calls the mock function.
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.RoundTripFunc(req)
+}
+
+// newMockAPIResponse is a test helper that takes any data structure,
+// marshals it to JSON, and returns a complete http response.
+func newMockAPIResponse(t *testing.T, data any) *http.Response {
+	t.Helper()
+	jsonBytes, err := json.Marshal(data)
+	require.NoError(t, err)
 
 	return &http.Response{
 		StatusCode: http.StatusOK,
@@ -373,17 +895,32 @@ func TestLlamaStackProviderAndVersionInfo(t *testing.T) {
 		Version: expectedLlamaStackVersionInfo,
 	}
 
+	// define the data structure for the mock models response
+	modelData := struct {
+		Data []map[string]string `json:"data"`
+	}{
+		Data: []map[string]string{
+			{"identifier": "llama3.2:1b", "provider_id": expectedProviderID, "model_type": "llm"},
+		},
+	}
+
 	// create the mock http client that uses our custom roundtripper
 	mockClient := &http.Client{
 		Transport: &mockRoundTripper{
 			// simulate the RoundTrip logic to handle different API paths
 			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path == "/v1/health" {
+					return newMockAPIResponse(t, map[string]string{"status": "OK"}), nil
+				}
 				if req.URL.Path == "/v1/providers" {
 					return newMockAPIResponse(t, providerData), nil
 				}
 				if req.URL.Path == "/v1/version" {
 					return newMockAPIResponse(t, versionData), nil
 				}
+				if req.URL.Path == "/v1/models" {
+					return newMockAPIResponse(t, modelData), nil
+				}
 				return &http.Response{
 					StatusCode: http.StatusNotFound,
 					Body:       io.NopCloser(strings.NewReader("")),
@@ -452,6 +989,296 @@ func TestLlamaStackProviderAndVersionInfo(t *testing.T) {
 	require.Equal(t, expectedLlamaStackVersionInfo,
 		updatedInstance.Status.Version.LlamaStackServerVersion,
 		"server version should match the mock response")
+	// validate models
+	require.Equal(t, []llamav1alpha1.ModelInfo{{ModelID: "llama3.2:1b", ProviderID: expectedProviderID, ModelType: "llm"}},
+		updatedInstance.Status.DistributionConfig.Models, "models should match the mock response")
+	require.Equal(t, int32(1), updatedInstance.Status.DistributionConfig.TotalModels)
+	// validate health check
+	healthCondition := meta.FindStatusCondition(updatedInstance.Status.Conditions, controllers.ConditionTypeHealthCheck)
+	require.NotNil(t, healthCondition, "health check condition should be set")
+	require.Equal(t, metav1.ConditionTrue, healthCondition.Status)
+	require.Contains(t, healthCondition.Message, "/v1/health", "condition should name the probed endpoint")
+}
+
+// TestProviderStatusRecovery drives an instance through a failure and its recovery, checking that the providers
+// in the status stay coherent with the health of the server at every step.
+func TestProviderStatusRecovery(t *testing.T) {
+	// --- arrange: a server whose availability and version can be toggled ---
+	serverUp := true
+	version := "v1"
+	providerData := struct {
+		Data []llamav1alpha1.ProviderInfo `json:"data"`
+	}{
+		Data: []llamav1alpha1.ProviderInfo{{
+			ProviderID:   "mock-ollama",
+			ProviderType: "remote::ollama",
+			API:          "inference",
+			Health:       llamav1alpha1.ProviderHealthStatus{Status: "OK"},
+			Config:       apiextensionsv1.JSON{Raw: []byte(`{"url": "http://mock.server"}`)},
+		}},
+	}
+	mockClient := &http.Client{
+		Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				if !serverUp {
+					return &http.Response{
+						StatusCode: http.StatusServiceUnavailable,
+						Body:       io.NopCloser(strings.NewReader("")),
+						Header:     http.Header{"Content-Type": []string{"application/json"}},
+					}, nil
+				}
+				switch req.URL.Path {
+				case "/v1/health":
+					return newMockAPIResponse(t, map[string]string{"status": "OK"}), nil
+				case "/v1/providers":
+					return newMockAPIResponse(t, providerData), nil
+				default:
+					return newMockAPIResponse(t, map[string]string{"version": version}), nil
+				}
+			},
+		},
+	}
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	reconciler := controllers.NewReconciler(k8sClient, scheme.Scheme,
+		controllers.WithClusterInfo(createTestReconciler().ClusterInfo),
+		controllers.WithHTTPClient(mockClient),
+		controllers.WithClock(fakeClock),
+	)
+
+	namespace := createTestNamespace(t, "test-provider-recovery")
+	instance := NewDistributionBuilder().
+		WithName("provider-recovery-test").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+	_, err := reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, request.NamespacedName, deployment)
+	setReadyReplicas := func(ready int32) {
+		t.Helper()
+		require.NoError(t, k8sClient.Get(t.Context(), request.NamespacedName, deployment))
+		deployment.Status.Replicas = 1
+		deployment.Status.ReadyReplicas = ready
+		require.NoError(t, k8sClient.Status().Update(t.Context(), deployment))
+	}
+	reconcileStatus := func() (ctrl.Result, *llamav1alpha1.LlamaStackDistributionStatus) {
+		t.Helper()
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+		result, err := reconciler.Reconcile(t.Context(), request)
+		require.NoError(t, err)
+		updated := &llamav1alpha1.LlamaStackDistribution{}
+		require.NoError(t, k8sClient.Get(t.Context(), request.NamespacedName, updated))
+		return result, &updated.Status
+	}
+	healthStatus := func(status *llamav1alpha1.LlamaStackDistributionStatus) metav1.ConditionStatus {
+		t.Helper()
+		condition := meta.FindStatusCondition(status.Conditions, controllers.ConditionTypeHealthCheck)
+		require.NotNil(t, condition, "HealthCheck condition should be set")
+		return condition.Status
+	}
+
+	// --- act: the server becomes ready ---
+	setReadyReplicas(1)
+	_, status := reconcileStatus()
+
+	// --- assert: the providers are fetched ---
+	require.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, status.Phase)
+	require.Equal(t, metav1.ConditionTrue, healthStatus(status))
+	require.Len(t, status.DistributionConfig.Providers, 1)
+	require.False(t, status.DistributionConfig.ProvidersStale)
+	require.NotNil(t, status.DistributionConfig.ProvidersLastUpdated)
+	fetchedAt := *status.DistributionConfig.ProvidersLastUpdated
+	require.Equal(t, "v1", status.Version.LlamaStackServerVersion)
+
+	// --- act: the deployment is briefly not ready ---
+	setReadyReplicas(0)
+	_, status = reconcileStatus()
+
+	// --- assert: the last known providers are kept and flagged as stale ---
+	require.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseInitializing, status.Phase)
+	require.Equal(t, metav1.ConditionFalse, healthStatus(status))
+	require.Len(t, status.DistributionConfig.Providers, 1, "providers should not be cleared while the deployment is not ready")
+	require.True(t, status.DistributionConfig.ProvidersStale)
+	require.Equal(t, fetchedAt, *status.DistributionConfig.ProvidersLastUpdated)
+
+	// --- act: the deployment is ready but the server fails once ---
+	serverUp = false
+	setReadyReplicas(1)
+	result, status := reconcileStatus()
+
+	// --- assert: the server stays ready until it fails repeatedly ---
+	require.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, status.Phase)
+	require.Equal(t, int32(1), status.HealthCheck.ConsecutiveFailures)
+	require.Positive(t, result.RequeueAfter, "a failing server should be checked again promptly")
+
+	// --- act: the server keeps failing ---
+	for range controllers.DefaultHealthCheckFailureThreshold - 1 {
+		result, status = reconcileStatus()
+	}
+
+	// --- assert: the server is unhealthy and the providers stay stale ---
+	require.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseFailed, status.Phase)
+	require.Equal(t, metav1.ConditionFalse, healthStatus(status))
+	require.Len(t, status.DistributionConfig.Providers, 1, "providers should not be cleared when they cannot be fetched")
+	require.True(t, status.DistributionConfig.ProvidersStale)
+	require.Equal(t, fetchedAt, *status.DistributionConfig.ProvidersLastUpdated)
+	require.Positive(t, result.RequeueAfter, "stale providers should be refreshed promptly")
+
+	// --- act: the server recovers with a new version ---
+	serverUp = true
+	version = "v2"
+	_, status = reconcileStatus()
+
+	// --- assert: the healthy status is written together with fresh providers and version ---
+	require.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, status.Phase)
+	require.Nil(t, status.HealthCheck)
+	require.Equal(t, metav1.ConditionTrue, healthStatus(status))
+	require.Len(t, status.DistributionConfig.Providers, 1)
+	require.False(t, status.DistributionConfig.ProvidersStale)
+	require.True(t, status.DistributionConfig.ProvidersLastUpdated.After(fetchedAt.Time), "providers should be refetched on recovery")
+	require.Equal(t, "v2", status.Version.LlamaStackServerVersion)
+}
+
+func TestProviderHealthSummary(t *testing.T) {
+	// --- arrange: a server reporting providers in every health status ---
+	newProvider := func(id, status string) llamav1alpha1.ProviderInfo {
+		return llamav1alpha1.ProviderInfo{
+			ProviderID:   id,
+			ProviderType: "remote::" + id,
+			API:          "inference",
+			Health:       llamav1alpha1.ProviderHealthStatus{Status: status},
+			Config:       apiextensionsv1.JSON{Raw: []byte(`{}`)},
+		}
+	}
+	providerData := struct {
+		Data []llamav1alpha1.ProviderInfo `json:"data"`
+	}{
+		Data: []llamav1alpha1.ProviderInfo{
+			newProvider("ollama", "OK"),
+			newProvider("vllm", "OK"),
+			newProvider("tgi", "Error"),
+			newProvider("bedrock", "Not Implemented"),
+		},
+	}
+	mockClient := &http.Client{
+		Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				switch req.URL.Path {
+				case "/v1/health":
+					return newMockAPIResponse(t, map[string]string{"status": "OK"}), nil
+				case "/v1/providers":
+					return newMockAPIResponse(t, providerData), nil
+				default:
+					return newMockAPIResponse(t, map[string]string{"version": "v1"}), nil
+				}
+			},
+		},
+	}
+	reconciler := controllers.NewReconciler(k8sClient, scheme.Scheme,
+		controllers.WithClusterInfo(createTestReconciler().ClusterInfo),
+		controllers.WithHTTPClient(mockClient),
+	)
+
+	namespace := createTestNamespace(t, "test-provider-health-summary")
+	instance := NewDistributionBuilder().
+		WithName("provider-health-summary-test").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+	_, err := reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, request.NamespacedName, deployment)
+	deployment.Status.Replicas = 1
+	deployment.Status.ReadyReplicas = 1
+	require.NoError(t, k8sClient.Status().Update(t.Context(), deployment))
+
+	// --- act ---
+	_, err = reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	// --- assert ---
+	updated := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, k8sClient.Get(t.Context(), request.NamespacedName, updated))
+	config := updated.Status.DistributionConfig
+	require.Len(t, config.Providers, 4)
+	require.Equal(t, int32(2), config.HealthyProviders)
+	require.Equal(t, int32(1), config.UnhealthyProviders)
+	require.Equal(t, "2/4", config.ProvidersHealthy)
+}
+
+func TestHealthCheckConfiguration(t *testing.T) {
+	// arrange
+	var probedPaths []string
+	requestTimeouts := map[string]time.Duration{}
+	mockClient := &http.Client{
+		Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				probedPaths = append(probedPaths, req.URL.Path)
+				if deadline, ok := req.Context().Deadline(); ok {
+					requestTimeouts[req.URL.Path] = time.Until(deadline)
+				}
+				if req.URL.Path == "/healthz" {
+					return newMockAPIResponse(t, map[string]string{"status": "OK"}), nil
+				}
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(strings.NewReader("")),
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+				}, nil
+			},
+		},
+	}
+
+	namespace := createTestNamespace(t, "test-healthcheck")
+	instance := NewDistributionBuilder().
+		WithName("test-healthcheck-instance").
+		WithNamespace(namespace.Name).
+		Build()
+	instance.Spec.Server.HealthCheck = &llamav1alpha1.HealthCheckSpec{Path: "/healthz", TimeoutSeconds: 30}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	reconciler := controllers.NewTestReconciler(k8sClient, scheme.Scheme, createTestReconciler().ClusterInfo, mockClient, false)
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+
+	// act (part 1)
+	_, err := reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, request.NamespacedName, deployment)
+	probe := deployment.Spec.Template.Spec.Containers[0].ReadinessProbe
+	require.NotNil(t, probe)
+	require.Equal(t, "/healthz", probe.HTTPGet.Path, "readiness probe should use the configured health path")
+
+	deployment.Status.ReadyReplicas = 1
+	deployment.Status.Replicas = 1
+	require.NoError(t, k8sClient.Status().Update(t.Context(), deployment))
+
+	// act (part 2)
+	_, err = reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	// assert
+	require.Contains(t, probedPaths, "/healthz", "operator should probe the configured health path")
+	require.NotContains(t, probedPaths, "/v1/health", "operator should not probe the default health path")
+	for _, path := range []string{"/healthz", "/v1/providers", "/v1/version", "/v1/models"} {
+		require.Contains(t, requestTimeouts, path, "request to %s should have a deadline", path)
+		require.Greater(t, requestTimeouts[path], 20*time.Second, "request to %s should use the configured timeout", path)
+		require.LessOrEqual(t, requestTimeouts[path], 30*time.Second, "request to %s should use the configured timeout", path)
+	}
+
+	updatedInstance := &llamav1alpha1.LlamaStackDistribution{}
+	waitForResource(t, k8sClient, namespace.Name, instance.Name, updatedInstance)
+	healthCondition := meta.FindStatusCondition(updatedInstance.Status.Conditions, controllers.ConditionTypeHealthCheck)
+	require.NotNil(t, healthCondition, "health check condition should be set")
+	require.Equal(t, metav1.ConditionTrue, healthCondition.Status)
+	require.Contains(t, healthCondition.Message, "/healthz", "condition should name the probed endpoint")
 }
 
 func TestNetworkPolicyConfiguration(t *testing.T) {
@@ -468,6 +1295,13 @@ func TestNetworkPolicyConfiguration(t *testing.T) {
 				// ensure NetworkPolicy exists by reconciling with feature enabled.
 				ReconcileDistribution(t, instance, true)
 				waitForResource(t, k8sClient, instance.Namespace, instance.Name+"-network-policy", &networkingv1.NetworkPolicy{})
+
+				// the test cluster has no known enforcing network plugin
+				updated := &llamav1alpha1.LlamaStackDistribution{}
+				require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, updated))
+				condition := meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeNetworkPolicyReady)
+				require.NotNil(t, condition, "NetworkPolicyReady condition should be set")
+				require.Equal(t, controllers.ReasonNetworkPolicyUnverified, condition.Reason)
 			},
 		},
 		{
@@ -503,6 +1337,1091 @@ func TestNetworkPolicyConfiguration(t *testing.T) {
 			// --- assert ---
 			npKey := types.NamespacedName{Name: instance.Name + "-network-policy", Namespace: instance.Namespace}
 			AssertNetworkPolicyAbsent(t, k8sClient, npKey)
+			updated := &llamav1alpha1.LlamaStackDistribution{}
+			require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, updated))
+			require.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeNetworkPolicyReady),
+				"NetworkPolicyReady condition should be removed when the feature is disabled")
 		})
 	}
 }
+
+func TestServiceConfiguration(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-service-overrides")
+	instance := NewDistributionBuilder().
+		WithName("service-test").
+		WithNamespace(namespace.Name).
+		Build()
+	instance.Spec.Server.Service = &llamav1alpha1.ServiceOverrides{
+		Type:                     corev1.ServiceTypeLoadBalancer,
+		LoadBalancerSourceRanges: []string{"10.0.0.0/8", "192.0.2.0/24"},
+		SessionAffinity:          corev1.ServiceAffinityClientIP,
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	service := &corev1.Service{}
+	waitForResource(t, k8sClient, instance.Namespace, deploy.GetServiceName(instance), service)
+	AssertServiceExposureMatches(t, service, instance.Spec.Server.Service)
+	AssertServicePortMatches(t, service, corev1.ServicePort{
+		Name:       llamav1alpha1.DefaultServicePortName,
+		Port:       llamav1alpha1.DefaultServerPort,
+		TargetPort: intstr.FromInt(int(llamav1alpha1.DefaultServerPort)),
+		Protocol:   corev1.ProtocolTCP,
+		NodePort:   service.Spec.Ports[0].NodePort,
+	})
+
+	// --- act: source ranges on a ClusterIP Service ---
+	invalid := NewDistributionBuilder().
+		WithName("service-invalid").
+		WithNamespace(namespace.Name).
+		Build()
+	invalid.Spec.Server.Service = &llamav1alpha1.ServiceOverrides{
+		Type:                     corev1.ServiceTypeClusterIP,
+		LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+	}
+	err := k8sClient.Create(t.Context(), invalid)
+
+	// --- assert ---
+	require.True(t, apierrors.IsInvalid(err), "source ranges should be rejected without type LoadBalancer, got %v", err)
+	require.ErrorContains(t, err, "loadBalancerSourceRanges requires type LoadBalancer")
+}
+
+func TestIngressConfiguration(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-ingress")
+	instance := NewDistributionBuilder().
+		WithName("ingress-test").
+		WithNamespace(namespace.Name).
+		Build()
+	instance.Spec.Server.Ingress = &llamav1alpha1.IngressSpec{
+		Enabled:          true,
+		Host:             "llama.example.com",
+		IngressClassName: ptr.To("nginx"),
+		TLSSecretName:    "llama-tls",
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+	instanceKey := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	ingressKey := types.NamespacedName{Name: deploy.GetIngressName(instance), Namespace: namespace.Name}
+	ingress := &networkingv1.Ingress{}
+	waitForResourceWithKey(t, k8sClient, ingressKey, ingress)
+	AssertResourceOwnedByInstance(t, ingress, instance)
+	require.Equal(t, ptr.To("nginx"), ingress.Spec.IngressClassName)
+	require.Len(t, ingress.Spec.Rules, 1)
+	require.Equal(t, "llama.example.com", ingress.Spec.Rules[0].Host)
+	backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+	require.Equal(t, deploy.GetServiceName(instance), backend.Name, "Ingress should route to the generated Service")
+	require.Equal(t, deploy.GetServicePort(instance), backend.Port.Number)
+
+	updated := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, k8sClient.Get(t.Context(), instanceKey, updated))
+	condition := meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeIngressReady)
+	require.NotNil(t, condition, "IngressReady condition should be set")
+	require.Equal(t, controllers.ReasonIngressPending, condition.Reason, "no ingress controller runs in the test cluster")
+
+	// --- act: an ingress controller publishes the address ---
+	ingress.Status.LoadBalancer.Ingress = []networkingv1.IngressLoadBalancerIngress{{IP: "192.0.2.10"}}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), ingress))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), instanceKey, updated))
+	condition = meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeIngressReady)
+	require.NotNil(t, condition, "IngressReady condition should be set")
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.Equal(t, controllers.ReasonIngressAdmitted, condition.Reason)
+
+	// --- act: disable the Ingress ---
+	updated.Spec.Server.Ingress.Enabled = false
+	require.NoError(t, k8sClient.Update(t.Context(), updated))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.Eventually(t, func() bool {
+		err := k8sClient.Get(t.Context(), ingressKey, &networkingv1.Ingress{})
+		return apierrors.IsNotFound(err)
+	}, testTimeout, testInterval, "Ingress should be deleted once disabled")
+	require.NoError(t, k8sClient.Get(t.Context(), instanceKey, updated))
+	require.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, controllers.ConditionTypeIngressReady),
+		"IngressReady condition should be removed when the Ingress is disabled")
+
+	// --- act: a host that is not a DNS name ---
+	invalid := NewDistributionBuilder().
+		WithName("ingress-invalid").
+		WithNamespace(namespace.Name).
+		Build()
+	invalid.Spec.Server.Ingress = &llamav1alpha1.IngressSpec{Enabled: true, Host: "https://llama.example.com"}
+	err := k8sClient.Create(t.Context(), invalid)
+
+	// --- assert ---
+	require.True(t, apierrors.IsInvalid(err), "the host should be rejected, got %v", err)
+	require.ErrorContains(t, err, "spec.server.ingress.host")
+}
+
+func TestGrafanaDashboardConfiguration(t *testing.T) {
+	// --- arrange ---
+	t.Setenv("OPERATOR_NAMESPACE", "test-operator-namespace")
+
+	namespace := createTestNamespace(t, "test-dashboard")
+	instance := NewDistributionBuilder().
+		WithName("dashboard-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		WithDashboard(true).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	dashboardKey := types.NamespacedName{Name: deploy.GetDashboardConfigMapName(instance), Namespace: namespace.Name}
+	dashboard := &corev1.ConfigMap{}
+	waitForResourceWithKey(t, k8sClient, dashboardKey, dashboard)
+	require.Equal(t, "1", dashboard.Labels["grafana_dashboard"], "dashboard should be labeled for the Grafana sidecar")
+	content := dashboard.Data["llama-stack-dashboard.json"]
+	require.Contains(t, content, `pod=~\"dashboard-test-.*\"`, "queries should be scoped to the instance pods")
+	require.Contains(t, content, `namespace=\"`+namespace.Name+`\"`, "queries should be scoped to the instance namespace")
+	require.NotContains(t, content, "__INSTANCE_", "all placeholders should be replaced")
+	AssertResourceOwnedByInstance(t, dashboard, instance)
+
+	// --- act: disable the dashboard ---
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, instance))
+	instance.Spec.Server.Monitoring.Dashboard = false
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.Eventually(t, func() bool {
+		err := k8sClient.Get(t.Context(), dashboardKey, &corev1.ConfigMap{})
+		return apierrors.IsNotFound(err)
+	}, testTimeout, testInterval, "dashboard ConfigMap should be deleted once disabled")
+}
+
+func TestCABundleConfiguration(t *testing.T) {
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-ca-bundle")
+	const certA = "-----BEGIN CERTIFICATE-----\nQUFB\n-----END CERTIFICATE-----"
+	const certB = "-----BEGIN CERTIFICATE-----\nQkJC\n-----END CERTIFICATE-----"
+	source := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "custom-ca-bundle", Namespace: namespace.Name},
+		Data: map[string]string{
+			"a.crt": certA + "\n\n",
+			"b.crt": certB,
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), source))
+
+	instance := NewDistributionBuilder().
+		WithName("ca-bundle-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		WithTLSConfig(source.Name, "b.crt", "a.crt").
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), instance) })
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	bundleKey := types.NamespacedName{Name: deploy.GetCABundleConfigMapName(instance), Namespace: namespace.Name}
+	bundle := &corev1.ConfigMap{}
+	waitForResourceWithKey(t, k8sClient, bundleKey, bundle)
+	require.Equal(t, certB+"\n"+certA+"\n", bundle.Data[controllers.DefaultCABundleKey],
+		"keys should be concatenated in declared order with normalized newlines")
+	AssertResourceOwnedByInstance(t, bundle, instance)
+
+	deployment := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, namespace.Name, instance.Name, deployment)
+	require.Empty(t, deployment.Spec.Template.Spec.InitContainers, "the bundle should not be concatenated by an init container")
+	volume := findVolumeByName(t, deployment, controllers.CABundleVolumeName)
+	require.NotNil(t, volume.ConfigMap)
+	require.Equal(t, bundleKey.Name, volume.ConfigMap.Name, "pods should mount the derived CA bundle ConfigMap")
+	require.NotEmpty(t, deployment.Spec.Template.Annotations["configmap.hash/ca-bundle"])
+
+	// --- act: reference a missing key ---
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, instance))
+	instance.Spec.Server.TLSConfig.CABundle.ConfigMapKeys = []string{"a.crt", "missing.crt"}
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	reconciler := createTestReconciler()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+	_, err := reconciler.Reconcile(t.Context(), req)
+	require.Error(t, err, "reconciliation should fail with a missing CA bundle key")
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, instance))
+	condition := meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeCABundleReady)
+	require.NotNil(t, condition, "CABundleReady condition should be set")
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, controllers.ReasonCABundleInvalid, condition.Reason)
+	require.Contains(t, condition.Message, "missing.crt")
+}
+
+func TestPVCProtectionFinalizer(t *testing.T) {
+	tests := []struct {
+		name      string
+		retainPVC bool
+	}{
+		{name: "PVC is deleted with the instance"},
+		{name: "PVC is retained with the annotation", retainPVC: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// --- arrange ---
+			namespace := createTestNamespace(t, "test-pvc-protection")
+			instance := NewDistributionBuilder().
+				WithName("pvc-protection-test").
+				WithNamespace(namespace.Name).
+				WithDistribution("starter").
+				WithStorage(DefaultTestStorage()).
+				Build()
+			if tt.retainPVC {
+				instance.Annotations = map[string]string{llamav1alpha1.RetainPVCAnnotation: "true"}
+			}
+			require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+			ReconcileDistribution(t, instance, false)
+
+			key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+			require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+			require.Contains(t, instance.Finalizers, llamav1alpha1.PVCProtectionFinalizer, "finalizer should be added with storage")
+			pvcKey := types.NamespacedName{Name: deploy.GetPVCName(instance), Namespace: namespace.Name}
+			pvc := &corev1.PersistentVolumeClaim{}
+			waitForResourceWithKey(t, k8sClient, pvcKey, pvc)
+
+			// --- act ---
+			require.NoError(t, k8sClient.Delete(t.Context(), instance))
+			ReconcileDistribution(t, instance, false)
+
+			// --- assert ---
+			require.Eventually(t, func() bool {
+				return apierrors.IsNotFound(k8sClient.Get(t.Context(), key, &llamav1alpha1.LlamaStackDistribution{}))
+			}, testTimeout, testInterval, "instance should be deleted once the finalizer is removed")
+
+			err := k8sClient.Get(t.Context(), pvcKey, pvc)
+			if tt.retainPVC {
+				require.NoError(t, err, "PVC should be retained")
+				require.Nil(t, pvc.DeletionTimestamp, "PVC should not be deleted")
+				for _, ref := range pvc.OwnerReferences {
+					require.NotEqual(t, instance.UID, ref.UID, "PVC should no longer be owned by the instance")
+				}
+			} else if err == nil {
+				// the PVC may be held by the kubernetes.io/pvc-protection finalizer
+				require.NotNil(t, pvc.DeletionTimestamp, "PVC should be deleted")
+			} else {
+				require.True(t, apierrors.IsNotFound(err), "PVC should be deleted")
+			}
+		})
+	}
+}
+
+func TestClusterResourceCleanupFinalizer(t *testing.T) {
+	// --- arrange: the ClusterRole bound by the rendered ClusterRoleBinding ---
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "system:openshift:scc:anyuid"}}
+	if err := k8sClient.Create(t.Context(), clusterRole); err != nil && !apierrors.IsAlreadyExists(err) {
+		require.NoError(t, err)
+	}
+	t.Cleanup(func() { _ = k8sClient.Delete(t.Context(), clusterRole) })
+
+	// two instances with the same name in different namespaces
+	instances := make([]*llamav1alpha1.LlamaStackDistribution, 0, 2)
+	for range 2 {
+		namespace := createTestNamespace(t, "test-crb-cleanup")
+		instance := NewDistributionBuilder().
+			WithName("crb-cleanup-test").
+			WithNamespace(namespace.Name).
+			WithDistribution("starter").
+			Build()
+		require.NoError(t, k8sClient.Create(t.Context(), instance))
+		ReconcileDistribution(t, instance, false)
+		require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, instance))
+		require.Contains(t, instance.Finalizers, llamav1alpha1.ClusterResourceCleanupFinalizer, "cleanup finalizer should be added")
+		instances = append(instances, instance)
+	}
+	first, second := instances[0], instances[1]
+
+	bindingKey := types.NamespacedName{Name: deploy.GetClusterRoleBindingName(first)}
+	binding := &rbacv1.ClusterRoleBinding{}
+	waitForResourceWithKey(t, k8sClient, bindingKey, binding)
+	require.Equal(t, deploy.GetInstanceLabels(first)[llamav1alpha1.InstanceNamespaceLabel], binding.Labels[llamav1alpha1.InstanceNamespaceLabel],
+		"ClusterRoleBinding should be labeled with the instance that created it")
+
+	// --- act: delete the instance in the other namespace ---
+	require.NoError(t, k8sClient.Delete(t.Context(), second))
+	ReconcileDistribution(t, second, false)
+
+	// --- assert: the binding of the first instance is kept ---
+	require.Eventually(t, func() bool {
+		return apierrors.IsNotFound(k8sClient.Get(t.Context(), client.ObjectKeyFromObject(second), &llamav1alpha1.LlamaStackDistribution{}))
+	}, testTimeout, testInterval, "instance should be deleted once the finalizer is removed")
+	require.NoError(t, k8sClient.Get(t.Context(), bindingKey, binding), "ClusterRoleBinding of another namespace should be kept")
+
+	// --- act: delete the instance owning the binding ---
+	require.NoError(t, k8sClient.Delete(t.Context(), first))
+	recorder := record.NewFakeRecorder(10)
+	reconciler := createTestReconciler()
+	reconciler.Recorder = recorder
+	_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(first)})
+	require.NoError(t, err)
+
+	// --- assert: the binding is deleted with it ---
+	require.Eventually(t, func() bool {
+		return apierrors.IsNotFound(k8sClient.Get(t.Context(), client.ObjectKeyFromObject(first), &llamav1alpha1.LlamaStackDistribution{}))
+	}, testTimeout, testInterval, "instance should be deleted once the finalizer is removed")
+	require.True(t, apierrors.IsNotFound(k8sClient.Get(t.Context(), bindingKey, binding)), "ClusterRoleBinding should be deleted")
+	require.Contains(t, drainEvents(recorder),
+		"Normal "+controllers.EventReasonClusterRoleBindingDeleted+" Deleted ClusterRoleBinding "+bindingKey.Name)
+}
+
+func TestStorageBackendChange(t *testing.T) {
+	// --- arrange: an instance running with emptyDir storage ---
+	namespace := createTestNamespace(t, "test-storage-backend")
+	instance := NewDistributionBuilder().
+		WithName("storage-backend-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	key := types.NamespacedName{Name: instance.Name, Namespace: namespace.Name}
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, key, deployment)
+	AssertDeploymentUsesEmptyDirStorage(t, deployment)
+
+	// --- act: switch to a PVC without acknowledgment ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.Server.Storage = DefaultTestStorage()
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+
+	recorder := record.NewFakeRecorder(10)
+	reconciler := createTestReconciler()
+	reconciler.Recorder = recorder
+	_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+
+	// --- assert: the switch is blocked ---
+	require.Error(t, err, "unacknowledged storage backend change should be blocked")
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	AssertDeploymentUsesEmptyDirStorage(t, deployment)
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	condition := meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeStorageBackendChanged)
+	require.NotNil(t, condition, "StorageBackendChanged condition should be set")
+	require.Equal(t, controllers.ReasonStorageBackendChangeBlocked, condition.Reason)
+
+	// --- act: acknowledge the opposite change ---
+	instance.Annotations = map[string]string{llamav1alpha1.AcknowledgeStorageChangeAnnotation: "pvc->emptyDir"}
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	_, err = reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+
+	// --- assert: the acknowledgment does not cover this switch ---
+	require.Error(t, err, "an acknowledgment of another switch should not unblock the change")
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	AssertDeploymentUsesEmptyDirStorage(t, deployment)
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+
+	// --- act: acknowledge the change ---
+	instance.Annotations = map[string]string{llamav1alpha1.AcknowledgeStorageChangeAnnotation: "emptyDir->pvc"}
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	_, err = reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+
+	// --- assert: the switch is applied with a warning ---
+	require.NoError(t, err)
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	AssertDeploymentUsesPVCStorage(t, deployment, deploy.GetPVCName(instance))
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	condition = meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeStorageBackendChanged)
+	require.NotNil(t, condition, "StorageBackendChanged condition should report the applied change")
+	require.Equal(t, controllers.ReasonStorageBackendChangeAcknowledged, condition.Reason)
+	require.Contains(t, drainEvents(recorder),
+		"Warning "+controllers.EventReasonStorageBackendChanged+" Storage backend changed from emptyDir to PersistentVolumeClaim, the data is not migrated")
+}
+
+func TestQuotaPreflight(t *testing.T) {
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-quota-preflight")
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: namespace.Name},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("1"),
+				corev1.ResourcePods:        resource.MustParse("2"),
+			},
+		},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), quota))
+	// envtest runs no quota controller, so the usage of the single server pod is simulated
+	quota.Status = corev1.ResourceQuotaStatus{
+		Hard: quota.Spec.Hard,
+		Used: corev1.ResourceList{
+			corev1.ResourceRequestsCPU: resource.MustParse("500m"),
+			corev1.ResourcePods:        resource.MustParse("1"),
+		},
+	}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), quota))
+
+	instance := NewDistributionBuilder().
+		WithName("quota-preflight-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		WithReplicas(1).
+		WithResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+		}).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	recorder := record.NewFakeRecorder(10)
+	reconciler := createTestReconciler()
+	reconciler.EnableQuotaPreflight = true
+	reconciler.Recorder = recorder
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+
+	// --- act: reconcile within the quota ---
+	_, err := reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	condition := meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeQuotaWouldBeExceeded)
+	require.NotNil(t, condition, "QuotaWouldBeExceeded condition should be set")
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, controllers.ReasonWithinQuota, condition.Reason)
+
+	// --- act: scale beyond the quota ---
+	instance.Spec.Replicas = 3
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	_, err = reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err, "the quota pre-flight should be advisory")
+
+	// --- assert: the condition warns and the Deployment is still scaled ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	condition = meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeQuotaWouldBeExceeded)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.Equal(t, controllers.ReasonQuotaExceeded, condition.Reason)
+	require.Contains(t, condition.Message, "pods in ResourceQuota compute requires 3, 2 available")
+	require.Contains(t, condition.Message, "requests.cpu in ResourceQuota compute requires 1500m, 1 available")
+	require.Contains(t, drainEvents(recorder), "Warning "+controllers.EventReasonQuotaWouldBeExceeded+" "+condition.Message)
+
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	require.Equal(t, int32(3), *deployment.Spec.Replicas)
+
+	// --- act: disable the pre-flight ---
+	reconciler.EnableQuotaPreflight = false
+	_, err = reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	require.Nil(t, meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeQuotaWouldBeExceeded),
+		"QuotaWouldBeExceeded condition should be removed when the pre-flight is disabled")
+}
+
+func TestAutoscaling(t *testing.T) {
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-autoscaling")
+	instance := NewDistributionBuilder().
+		WithName("autoscaling-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	instance.Spec.Autoscaling = &llamav1alpha1.AutoscalingSpec{
+		MinReplicas:                    ptr.To(int32(2)),
+		MaxReplicas:                    4,
+		TargetCPUUtilizationPercentage: ptr.To(int32(75)),
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	hpaKey := types.NamespacedName{Name: deploy.GetHorizontalPodAutoscalerName(instance), Namespace: namespace.Name}
+	waitForResourceWithKey(t, k8sClient, hpaKey, hpa)
+	AssertResourceOwnedByInstance(t, hpa, instance)
+	require.Equal(t, instance.Name, hpa.Spec.ScaleTargetRef.Name)
+	require.Equal(t, ptr.To(int32(2)), hpa.Spec.MinReplicas)
+	require.Equal(t, int32(4), hpa.Spec.MaxReplicas)
+
+	// the replicas set by the HorizontalPodAutoscaler are kept
+	key := types.NamespacedName{Name: instance.Name, Namespace: namespace.Name}
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, key, deployment)
+	deployment.Spec.Replicas = ptr.To(int32(3))
+	require.NoError(t, k8sClient.Update(t.Context(), deployment))
+	ReconcileDistribution(t, instance, false)
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	require.Equal(t, int32(3), *deployment.Spec.Replicas, "replicas should be left to the HorizontalPodAutoscaler")
+
+	// the replicas observed by the HorizontalPodAutoscaler are reported in the status
+	require.NoError(t, k8sClient.Get(t.Context(), hpaKey, hpa))
+	hpa.Status.CurrentReplicas = 3
+	hpa.Status.DesiredReplicas = 4
+	require.NoError(t, k8sClient.Status().Update(t.Context(), hpa))
+	ReconcileDistribution(t, instance, false)
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	require.Equal(t, &llamav1alpha1.AutoscalingStatus{CurrentReplicas: 3, DesiredReplicas: 4}, instance.Status.Autoscaling)
+
+	// removing autoscaling deletes the HorizontalPodAutoscaler and enforces the replicas of the spec again
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.Autoscaling = nil
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	require.Eventually(t, func() bool {
+		return apierrors.IsNotFound(k8sClient.Get(t.Context(), hpaKey, &autoscalingv2.HorizontalPodAutoscaler{}))
+	}, testTimeout, testInterval, "HorizontalPodAutoscaler should be deleted")
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	require.Equal(t, instance.Spec.Replicas, *deployment.Spec.Replicas, "replicas of the spec should be enforced")
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	require.Nil(t, instance.Status.Autoscaling, "autoscaling status should be cleared")
+}
+
+func TestDisruptionBudget(t *testing.T) {
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-disruption-budget")
+	instance := NewDistributionBuilder().
+		WithName("pdb-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	instance.Spec.Replicas = 3
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	key := types.NamespacedName{Name: instance.Name, Namespace: namespace.Name}
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	pdb := &policyv1.PodDisruptionBudget{}
+	pdbKey := types.NamespacedName{Name: deploy.GetPodDisruptionBudgetName(instance), Namespace: namespace.Name}
+	waitForResourceWithKey(t, k8sClient, pdbKey, pdb)
+	AssertResourceOwnedByInstance(t, pdb, instance)
+	require.Equal(t, ptr.To(intstr.FromInt32(1)), pdb.Spec.MinAvailable, "multiple replicas should keep one pod available")
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, key, deployment)
+	require.Equal(t, deployment.Spec.Selector, pdb.Spec.Selector, "PodDisruptionBudget should select the pods of the Deployment")
+
+	// --- act: an explicit budget replaces the default ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.DisruptionBudget = &llamav1alpha1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("50%"))}
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), pdbKey, pdb))
+	require.Nil(t, pdb.Spec.MinAvailable)
+	require.Equal(t, ptr.To(intstr.FromString("50%")), pdb.Spec.MaxUnavailable)
+
+	// --- act: a single replica without a budget needs no PodDisruptionBudget ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.DisruptionBudget = nil
+	instance.Spec.Replicas = 1
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.Eventually(t, func() bool {
+		return apierrors.IsNotFound(k8sClient.Get(t.Context(), pdbKey, &policyv1.PodDisruptionBudget{}))
+	}, testTimeout, testInterval, "PodDisruptionBudget should be deleted")
+
+	// --- act: both bounds are rejected ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.DisruptionBudget = &llamav1alpha1.PodDisruptionBudgetSpec{
+		MinAvailable:   ptr.To(intstr.FromInt32(1)),
+		MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+	}
+	err := k8sClient.Update(t.Context(), instance)
+
+	// --- assert ---
+	require.True(t, apierrors.IsInvalid(err), "minAvailable and maxUnavailable should be mutually exclusive, got %v", err)
+}
+
+func TestServiceAccountAnnotations(t *testing.T) {
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-service-account")
+	instance := NewDistributionBuilder().
+		WithName("sa-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	instance.Spec.Server.PodOverrides = &llamav1alpha1.PodOverrides{
+		ServiceAccountAnnotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/llama-stack"},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	key := types.NamespacedName{Name: instance.Name, Namespace: namespace.Name}
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	serviceAccount := &corev1.ServiceAccount{}
+	saKey := types.NamespacedName{Name: deploy.GetServiceAccountName(instance), Namespace: namespace.Name}
+	waitForResourceWithKey(t, k8sClient, saKey, serviceAccount)
+	AssertResourceOwnedByInstance(t, serviceAccount, instance)
+	require.Equal(t, "arn:aws:iam::123456789012:role/llama-stack", serviceAccount.Annotations["eks.amazonaws.com/role-arn"])
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, key, deployment)
+	AssertServiceAccountDeploymentAlign(t, deployment, serviceAccount)
+
+	// --- act: the annotation changes and the cluster adds a Secret to the ServiceAccount ---
+	serviceAccount.Secrets = []corev1.ObjectReference{{Name: "sa-test-sa-dockercfg"}}
+	require.NoError(t, k8sClient.Update(t.Context(), serviceAccount))
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.Server.PodOverrides.ServiceAccountAnnotations["eks.amazonaws.com/role-arn"] = "arn:aws:iam::123456789012:role/other"
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.Eventually(t, func() bool {
+		if err := k8sClient.Get(t.Context(), saKey, serviceAccount); err != nil {
+			return false
+		}
+		return serviceAccount.Annotations["eks.amazonaws.com/role-arn"] == "arn:aws:iam::123456789012:role/other"
+	}, testTimeout, testInterval, "ServiceAccount annotation should be updated")
+	require.Equal(t, []corev1.ObjectReference{{Name: "sa-test-sa-dockercfg"}}, serviceAccount.Secrets,
+		"Secrets added by the cluster should be kept")
+}
+
+func TestMetadataPropagation(t *testing.T) {
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-metadata")
+	instance := NewDistributionBuilder().
+		WithName("metadata-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	instance.Spec.Labels = map[string]string{"cost-center": "ml-platform", "app.kubernetes.io/instance": "other"}
+	instance.Spec.Server.DeploymentLabels = map[string]string{"team": "inference"}
+	instance.Spec.Server.PodAnnotations = map[string]string{"sidecar.istio.io/inject": "true"}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	key := types.NamespacedName{Name: instance.Name, Namespace: namespace.Name}
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, key, deployment)
+	require.Equal(t, "ml-platform", deployment.Labels["cost-center"])
+	require.Equal(t, "inference", deployment.Labels["team"])
+	require.Equal(t, "true", deployment.Spec.Template.Annotations["sidecar.istio.io/inject"])
+	require.Equal(t, instance.Name, deployment.Spec.Template.Labels["app.kubernetes.io/instance"],
+		"operator labels should take precedence")
+	service := &corev1.Service{}
+	waitForResourceWithKey(t, k8sClient, types.NamespacedName{Name: deploy.GetServiceName(instance), Namespace: namespace.Name}, service)
+	require.Equal(t, "ml-platform", service.Labels["cost-center"])
+	require.Equal(t, instance.Name, service.Labels["app.kubernetes.io/instance"], "operator labels should take precedence")
+
+	// --- act: the labels and annotations are removed from the instance ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.Labels = nil
+	instance.Spec.Server.DeploymentLabels = nil
+	instance.Spec.Server.PodAnnotations = nil
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.Eventually(t, func() bool {
+		if err := k8sClient.Get(t.Context(), key, deployment); err != nil {
+			return false
+		}
+		_, hasCostCenter := deployment.Labels["cost-center"]
+		_, hasTeam := deployment.Labels["team"]
+		_, hasInject := deployment.Spec.Template.Annotations["sidecar.istio.io/inject"]
+		return !hasCostCenter && !hasTeam && !hasInject
+	}, testTimeout, testInterval, "removed labels and annotations should be removed from the Deployment")
+	require.Eventually(t, func() bool {
+		if err := k8sClient.Get(t.Context(), client.ObjectKeyFromObject(service), service); err != nil {
+			return false
+		}
+		_, hasCostCenter := service.Labels["cost-center"]
+		return !hasCostCenter
+	}, testTimeout, testInterval, "removed labels should be removed from the Service")
+}
+
+func TestAutoRollback(t *testing.T) {
+	// --- arrange: roll out a first pod template to completion ---
+	namespace := createTestNamespace(t, "test-auto-rollback")
+	instance := NewDistributionBuilder().
+		WithName("auto-rollback-test").
+		WithNamespace(namespace.Name).
+		WithDistribution("starter").
+		Build()
+	instance.Spec.Server.Rollout = &llamav1alpha1.RolloutSpec{AutoRollback: true, ProgressDeadlineSeconds: ptr.To(int32(120))}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, key, deployment)
+	require.Equal(t, int32(120), *deployment.Spec.ProgressDeadlineSeconds)
+	goodImage := deployment.Spec.Template.Spec.Containers[0].Image
+
+	// envtest runs no Deployment controller, so the rollout status is simulated
+	deployment.Status = appsv1.DeploymentStatus{
+		ObservedGeneration: deployment.Generation,
+		Replicas:           1,
+		UpdatedReplicas:    1,
+		AvailableReplicas:  1,
+	}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), deployment))
+	ReconcileDistribution(t, instance, false)
+
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	require.NotNil(t, instance.Status.Rollout, "rollout status should be recorded")
+	require.NotEmpty(t, instance.Status.Rollout.LastGoodTemplateHash, "completed pod template should be recorded as known-good")
+
+	// --- act: roll out a failing pod template whose rollout stalls ---
+	instance.Spec.Server.ContainerSpec.Env = append(instance.Spec.Server.ContainerSpec.Env,
+		corev1.EnvVar{Name: "BROKEN_SETTING", Value: "true"})
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "BROKEN_SETTING", Value: "true"},
+		"new pod template should be rolled out")
+	deployment.Status = appsv1.DeploymentStatus{
+		ObservedGeneration: deployment.Generation,
+		Replicas:           2,
+		UpdatedReplicas:    1,
+		AvailableReplicas:  1,
+		Conditions: []appsv1.DeploymentCondition{{
+			Type:    appsv1.DeploymentProgressing,
+			Status:  corev1.ConditionFalse,
+			Reason:  "ProgressDeadlineExceeded",
+			Message: `ReplicaSet "auto-rollback-test-2" has timed out progressing.`,
+		}},
+	}
+	require.NoError(t, k8sClient.Status().Update(t.Context(), deployment))
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	require.NotContains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "BROKEN_SETTING", Value: "true"},
+		"Deployment should be rolled back to the known-good pod template")
+	require.Equal(t, goodImage, deployment.Spec.Template.Spec.Containers[0].Image)
+
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	condition := meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeRollbackTriggered)
+	require.NotNil(t, condition, "RollbackTriggered condition should be set")
+	require.Equal(t, metav1.ConditionTrue, condition.Status)
+	require.Equal(t, controllers.ReasonRolloutStalled, condition.Reason)
+	require.NotEmpty(t, instance.Status.Rollout.FailedTemplateHash)
+
+	// the failed pod template is not rolled out again while the spec is unchanged
+	ReconcileDistribution(t, instance, false)
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	require.NotContains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "BROKEN_SETTING", Value: "true"},
+		"Deployment should stay on the known-good pod template")
+
+	// a new pod template in the spec is rolled out and clears the condition
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	instance.Spec.Server.ContainerSpec.Env[len(instance.Spec.Server.ContainerSpec.Env)-1].Value = "false"
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	ReconcileDistribution(t, instance, false)
+
+	require.NoError(t, k8sClient.Get(t.Context(), key, deployment))
+	require.Contains(t, deployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "BROKEN_SETTING", Value: "false"},
+		"fixed pod template should be rolled out")
+	require.NoError(t, k8sClient.Get(t.Context(), key, instance))
+	require.Nil(t, meta.FindStatusCondition(instance.Status.Conditions, controllers.ConditionTypeRollbackTriggered),
+		"RollbackTriggered condition should be removed")
+}
+
+func TestAdoptExistingDeployment(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange: render the Deployment the operator would create in a scratch namespace ---
+	scratchNamespace := createTestNamespace(t, "test-adopt-scratch")
+	scratch := NewDistributionBuilder().
+		WithName("test-adopt").
+		WithNamespace(scratchNamespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), scratch))
+	ReconcileDistribution(t, scratch, false)
+	rendered := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, scratch.Namespace, scratch.Name, rendered)
+
+	// hand-rolled copy of the Deployment with matching specs, as left behind by raw manifests
+	namespace := createTestNamespace(t, "test-adopt")
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-adopt", Namespace: namespace.Name},
+		Spec:       *rendered.Spec.DeepCopy(),
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), existing))
+	waitForResource(t, k8sClient, existing.Namespace, existing.Name, existing)
+	generationBefore := existing.Generation
+
+	instance := NewDistributionBuilder().
+		WithName("test-adopt").
+		WithNamespace(namespace.Name).
+		Build()
+	instance.Annotations = map[string]string{llamav1alpha1.AdoptExistingAnnotation: "true"}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	recorder := record.NewFakeRecorder(10)
+	reconciler := createTestReconciler()
+	reconciler.Recorder = recorder
+
+	// --- act ---
+	_, err := reconciler.Reconcile(t.Context(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace},
+	})
+	require.NoError(t, err)
+
+	// --- assert ---
+	adopted := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, namespace.Name, instance.Name, adopted)
+	AssertResourceOwnedByInstance(t, adopted, instance)
+	require.Equal(t, existing.UID, adopted.UID, "Deployment should be adopted in place")
+	require.Equal(t, generationBefore, adopted.Generation, "adopting a matching Deployment should not roll out the pods")
+	require.Equal(t, existing.Spec.Template, adopted.Spec.Template, "pod template should be unchanged")
+
+	require.Contains(t, drainEvents(recorder), "Normal "+controllers.EventReasonResourceAdopted+" Adopted existing Deployment test-adopt")
+}
+
+func TestReconcilePause(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-reconcile-pause")
+	instance := NewDistributionBuilder().
+		WithName("test-pause").
+		WithNamespace(namespace.Name).
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+	recorder := record.NewFakeRecorder(20)
+	reconciler := createTestReconciler()
+	reconciler.Recorder = recorder
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+	_, err := reconciler.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	deployment := &appsv1.Deployment{}
+	waitForResource(t, k8sClient, namespace.Name, instance.Name, deployment)
+	drainEvents(recorder)
+
+	// --- act: the instance is paused, then scaled ---
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, instance))
+	instance.Annotations = map[string]string{llamav1alpha1.ReconcilePausedAnnotation: "true"}
+	instance.Spec.Replicas = 2
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	result, err := reconciler.Reconcile(t.Context(), req)
+
+	// --- assert: the Deployment is left alone ---
+	require.NoError(t, err)
+	require.Equal(t, ctrl.Result{}, result)
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, instance))
+	require.Equal(t, llamav1alpha1.LlamaStackDistributionPhasePaused, instance.Status.Phase)
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, deployment))
+	require.Equal(t, int32(1), *deployment.Spec.Replicas, "a paused instance should not be scaled")
+	require.Contains(t, drainEvents(recorder),
+		"Normal "+controllers.EventReasonReconcilePaused+" Reconciliation paused by the "+llamav1alpha1.ReconcilePausedAnnotation+" annotation")
+
+	// --- act: the instance is resumed ---
+	instance.Annotations[llamav1alpha1.ReconcilePausedAnnotation] = "false"
+	require.NoError(t, k8sClient.Update(t.Context(), instance))
+	_, err = reconciler.Reconcile(t.Context(), req)
+
+	// --- assert: the pending change is applied ---
+	require.NoError(t, err)
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, instance))
+	require.NotEqual(t, llamav1alpha1.LlamaStackDistributionPhasePaused, instance.Status.Phase)
+	require.NoError(t, k8sClient.Get(t.Context(), req.NamespacedName, deployment))
+	require.Equal(t, int32(2), *deployment.Spec.Replicas)
+	require.Contains(t, drainEvents(recorder), "Normal "+controllers.EventReasonReconcileResumed+" Reconciliation resumed")
+}
+
+func TestReconcileEvents(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// arrange
+	t.Setenv("OPERATOR_NAMESPACE", "test-operator-namespace")
+	mockClient := &http.Client{
+		Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Body:       io.NopCloser(strings.NewReader("")),
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+				}, nil
+			},
+		},
+	}
+
+	namespace := createTestNamespace(t, "test-events")
+	instance := NewDistributionBuilder().
+		WithName("test-events").
+		WithNamespace(namespace.Name).
+		WithUserConfig("test-events-config").
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	recorder := record.NewFakeRecorder(20)
+	reconciler := controllers.NewTestReconciler(k8sClient, scheme.Scheme, createTestReconciler().ClusterInfo, mockClient, true)
+	reconciler.Recorder = recorder
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}}
+
+	// act: reconcile without the referenced ConfigMap
+	_, err := reconciler.Reconcile(t.Context(), request)
+	require.Error(t, err, "reconciliation should fail while the user ConfigMap is missing")
+
+	// assert
+	events := drainEvents(recorder)
+	require.Contains(t, events, fmt.Sprintf("Warning %s ConfigMap %s/test-events-config not found",
+		controllers.EventReasonConfigMapNotFound, namespace.Name))
+	require.True(t, slices.ContainsFunc(events, func(event string) bool {
+		return strings.HasPrefix(event, "Warning "+controllers.EventReasonFailed+" ")
+	}), "a Failed event should be recorded, got %v", events)
+
+	// act: create the ConfigMap
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-events-config", Namespace: namespace.Name},
+		Data:       map[string]string{"run.yaml": "version: '2'\nimage_name: ollama\nproviders:\n  inference: []\n"},
+	}
+	require.NoError(t, k8sClient.Create(t.Context(), configMap))
+	_, err = reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	// assert
+	events = drainEvents(recorder)
+	require.Contains(t, events, fmt.Sprintf("Normal %s Validated ConfigMap %s/test-events-config",
+		controllers.EventReasonUserConfigValidated, namespace.Name))
+	require.Contains(t, events, "Normal "+controllers.EventReasonNetworkPolicyCreated+" Created NetworkPolicy test-events-network-policy")
+	require.Contains(t, events, "Normal "+controllers.EventReasonDeploymentCreated+" Created Deployment test-events")
+
+	// act: mark the deployment ready, the mock server reports unhealthy
+	deployment := &appsv1.Deployment{}
+	waitForResourceWithKey(t, k8sClient, request.NamespacedName, deployment)
+	deployment.Status.ReadyReplicas = 1
+	deployment.Status.Replicas = 1
+	require.NoError(t, k8sClient.Status().Update(t.Context(), deployment))
+	_, err = reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	// assert: a single failed health check is tolerated
+	events = drainEvents(recorder)
+	require.Contains(t, events, "Normal "+controllers.EventReasonReady+" "+controllers.MessageDeploymentReady)
+	require.False(t, slices.ContainsFunc(events, func(event string) bool {
+		return strings.Contains(event, controllers.EventReasonHealthCheckFailed)
+	}), "a single failed health check should not record events, got %v", events)
+	require.False(t, slices.ContainsFunc(events, func(event string) bool {
+		return strings.Contains(event, "NetworkPolicy")
+	}), "an unchanged NetworkPolicy should not record events, got %v", events)
+
+	// act: the server keeps failing up to the failure threshold
+	for range controllers.DefaultHealthCheckFailureThreshold - 1 {
+		_, err = reconciler.Reconcile(t.Context(), request)
+		require.NoError(t, err)
+	}
+
+	// assert
+	events = drainEvents(recorder)
+	require.True(t, slices.ContainsFunc(events, func(event string) bool {
+		return strings.HasPrefix(event, "Warning "+controllers.EventReasonHealthCheckFailed+" ")
+	}), "a HealthCheckFailed event should be recorded, got %v", events)
+
+	// act: the server stays unhealthy
+	_, err = reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	// assert: the failure is only recorded on the transition
+	events = drainEvents(recorder)
+	require.False(t, slices.ContainsFunc(events, func(event string) bool {
+		return strings.Contains(event, controllers.EventReasonHealthCheckFailed)
+	}), "a server staying unhealthy should not record events, got %v", events)
+
+	// act: disable the NetworkPolicy feature
+	reconciler.EnableNetworkPolicy = false
+	_, err = reconciler.Reconcile(t.Context(), request)
+	require.NoError(t, err)
+
+	// assert
+	events = drainEvents(recorder)
+	require.True(t, slices.ContainsFunc(events, func(event string) bool {
+		return strings.HasPrefix(event, "Normal "+controllers.EventReasonNetworkPolicyDeleted+" Deleted NetworkPolicy test-events-network-policy")
+	}), "a NetworkPolicyDeleted event should be recorded, got %v", events)
+
+	// act: reconcile an instance with an unknown distribution name
+	unsupported := NewDistributionBuilder().
+		WithName("test-events-unsupported").
+		WithNamespace(namespace.Name).
+		WithDistribution("unknown").
+		Build()
+	require.NoError(t, k8sClient.Create(t.Context(), unsupported))
+	_, err = reconciler.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(unsupported)})
+	require.Error(t, err)
+
+	// assert
+	events = drainEvents(recorder)
+	require.True(t, slices.ContainsFunc(events, func(event string) bool {
+		return strings.HasPrefix(event, "Warning "+controllers.EventReasonDistributionNotSupported+" ")
+	}), "a DistributionNotSupported event should be recorded, got %v", events)
+}
+
+func TestMaintenanceJobs(t *testing.T) {
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+
+	// --- arrange ---
+	namespace := createTestNamespace(t, "test-maintenance")
+	instance := NewDistributionBuilder().
+		WithName("test-maintenance").
+		WithNamespace(namespace.Name).
+		WithStorage(DefaultTestStorage()).
+		Build()
+	instance.Spec.Server.MaintenanceJobs = []llamav1alpha1.MaintenanceJobSpec{{
+		Name:     "warm-cache",
+		Schedule: "0 2 * * *",
+		Command:  []string{"llama", "model", "download"},
+	}}
+	require.NoError(t, k8sClient.Create(t.Context(), instance))
+
+	// --- act ---
+	ReconcileDistribution(t, instance, false)
+
+	// --- assert ---
+	cronJob := &batchv1.CronJob{}
+	waitForResource(t, k8sClient, namespace.Name, "test-maintenance-warm-cache", cronJob)
+	AssertResourceOwnedByInstance(t, cronJob, instance)
+	require.Equal(t, "0 2 * * *", cronJob.Spec.Schedule)
+	podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+	require.Equal(t, testImage, podSpec.Containers[0].Image, "job should default to the server image")
+	require.Equal(t, "test-maintenance-pvc", podSpec.Volumes[0].PersistentVolumeClaim.ClaimName,
+		"job should mount the server storage volume")
+
+	updatedInstance := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, k8sClient.Get(t.Context(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, updatedInstance))
+	require.Equal(t, []llamav1alpha1.MaintenanceJobStatus{{Name: "warm-cache"}}, updatedInstance.Status.MaintenanceJobs,
+		"status should list the job before its first run")
+
+	// --- act: remove the job ---
+	updatedInstance.Spec.Server.MaintenanceJobs = nil
+	require.NoError(t, k8sClient.Update(t.Context(), updatedInstance))
+	ReconcileDistribution(t, updatedInstance, false)
+
+	// --- assert ---
+	require.Eventually(t, func() bool {
+		err := k8sClient.Get(t.Context(), types.NamespacedName{Name: cronJob.Name, Namespace: cronJob.Namespace}, &batchv1.CronJob{})
+		return apierrors.IsNotFound(err)
+	}, testTimeout, testInterval, "CronJob should be deleted once the job is removed")
+}
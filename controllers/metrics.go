@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sync"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// providerHealthTransitions counts the changes of the health status of the providers of an instance.
+	providerHealthTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "llamastack_provider_health_transitions_total",
+		Help: "Number of times the health status of a provider changed, by the status it changed to.",
+	}, []string{"namespace", "instance", "provider_id", "to_status"})
+
+	// providerHealthStatus reports the current health status of the providers of an instance.
+	providerHealthStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "llamastack_provider_health_status",
+		Help: "Current health status of a provider, set to 1 for the status the provider reports.",
+	}, []string{"namespace", "instance", "provider_id", "status"})
+
+	// serverProviders reports the number of providers stored in the status of an instance.
+	serverProviders = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "llamastack_server_providers",
+		Help: "Number of providers of a llama-stack server stored in the status of its instance.",
+	}, []string{"namespace", "instance"})
+
+	// serverHealthy reports the result of the last health check of the server of an instance.
+	serverHealthy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "llamastack_server_healthy",
+		Help: "Result of the last health check of a llama-stack server, 1 if it passed and 0 otherwise.",
+	}, []string{"namespace", "instance"})
+
+	// distributionsByPhase reports the number of instances in each phase.
+	distributionsByPhase = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "llamastack_distributions",
+		Help: "Number of LlamaStackDistributions, by phase.",
+	}, []string{"phase"})
+
+	// instancePhases holds the phase counted in distributionsByPhase for every instance.
+	instancePhases      = map[types.NamespacedName]llamav1alpha1.DistributionPhase{}
+	instancePhasesMutex sync.Mutex
+)
+
+func init() {
+	metrics.Registry.MustRegister(providerHealthTransitions, providerHealthStatus, serverProviders, serverHealthy, distributionsByPhase)
+}
+
+// recordProviderHealthMetrics updates the provider health metrics of the instance from the providers about to be
+// stored in its status. A transition is counted for every provider whose health status differs from the one
+// previously stored. Only the providers kept in the status are exported, so the number of series is bounded by
+// the provider cap.
+func recordProviderHealthMetrics(key types.NamespacedName, previous, current []llamav1alpha1.ProviderInfo) {
+	previousStatus := make(map[string]string, len(previous))
+	for _, provider := range previous {
+		previousStatus[provider.ProviderID] = provider.Health.Status
+	}
+
+	serverProviders.WithLabelValues(key.Namespace, key.Name).Set(float64(len(current)))
+	exported := make(map[string]bool, len(current))
+	for _, provider := range current {
+		exported[provider.ProviderID] = true
+		status := provider.Health.Status
+		if before, known := previousStatus[provider.ProviderID]; known && before != status {
+			providerHealthTransitions.WithLabelValues(key.Namespace, key.Name, provider.ProviderID, status).Inc()
+		}
+		providerHealthStatus.DeletePartialMatch(providerLabels(key, provider.ProviderID))
+		providerHealthStatus.WithLabelValues(key.Namespace, key.Name, provider.ProviderID, status).Set(1)
+	}
+
+	// Providers that are gone or no longer fit in the status stop reporting a current status
+	for providerID := range previousStatus {
+		if !exported[providerID] {
+			providerHealthStatus.DeletePartialMatch(providerLabels(key, providerID))
+		}
+	}
+}
+
+// recordServerHealthMetric records the result of the last health check of the instance.
+func recordServerHealthMetric(key types.NamespacedName, healthy bool) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	serverHealthy.WithLabelValues(key.Namespace, key.Name).Set(value)
+}
+
+// recordPhaseMetric counts the instance in its current phase, and no longer in its previous one.
+func recordPhaseMetric(key types.NamespacedName, phase llamav1alpha1.DistributionPhase) {
+	instancePhasesMutex.Lock()
+	defer instancePhasesMutex.Unlock()
+	previous, known := instancePhases[key]
+	if known && previous == phase {
+		return
+	}
+	if known {
+		distributionsByPhase.WithLabelValues(string(previous)).Dec()
+	}
+	instancePhases[key] = phase
+	distributionsByPhase.WithLabelValues(string(phase)).Inc()
+}
+
+// forgetInstanceMetrics deletes the series of a deleted instance and no longer counts it in its phase.
+func forgetInstanceMetrics(key types.NamespacedName) {
+	labels := prometheus.Labels{"namespace": key.Namespace, "instance": key.Name}
+	providerHealthTransitions.DeletePartialMatch(labels)
+	providerHealthStatus.DeletePartialMatch(labels)
+	serverProviders.DeletePartialMatch(labels)
+	serverHealthy.DeletePartialMatch(labels)
+
+	instancePhasesMutex.Lock()
+	defer instancePhasesMutex.Unlock()
+	if phase, known := instancePhases[key]; known {
+		distributionsByPhase.WithLabelValues(string(phase)).Dec()
+		delete(instancePhases, key)
+	}
+}
+
+// providerLabels returns the labels selecting the series of a provider of the instance.
+func providerLabels(key types.NamespacedName, providerID string) prometheus.Labels {
+	return prometheus.Labels{"namespace": key.Namespace, "instance": key.Name, "provider_id": providerID}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestProviderHealthMetrics(t *testing.T) {
+	key := types.NamespacedName{Namespace: "team-metrics", Name: "test-instance"}
+	otherKey := types.NamespacedName{Namespace: "team-metrics", Name: "other-instance"}
+	t.Cleanup(func() {
+		forgetInstanceMetrics(key)
+		forgetInstanceMetrics(otherKey)
+	})
+	provider := func(id, status string) llamav1alpha1.ProviderInfo {
+		return llamav1alpha1.ProviderInfo{ProviderID: id, Health: llamav1alpha1.ProviderHealthStatus{Status: status}}
+	}
+	transitions := func(providerID, toStatus string) float64 {
+		return testutil.ToFloat64(providerHealthTransitions.WithLabelValues(key.Namespace, key.Name, providerID, toStatus))
+	}
+	// Other tests may export series as well, so only those of the test namespace are counted
+	seriesOf := func(collector prometheus.Collector) int {
+		ch := make(chan prometheus.Metric)
+		go func() {
+			collector.Collect(ch)
+			close(ch)
+		}()
+		count := 0
+		for metric := range ch {
+			m := &dto.Metric{}
+			require.NoError(t, metric.Write(m))
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "namespace" && label.GetValue() == key.Namespace {
+					count++
+				}
+			}
+		}
+		return count
+	}
+	series := func() int {
+		return seriesOf(providerHealthStatus)
+	}
+
+	// --- act: the providers are fetched for the first time ---
+	recordProviderHealthMetrics(key, nil, []llamav1alpha1.ProviderInfo{provider("ollama", "OK"), provider("vllm", "OK")})
+	recordProviderHealthMetrics(otherKey, nil, []llamav1alpha1.ProviderInfo{provider("ollama", "OK")})
+
+	// --- assert: no transition without a previous status ---
+	assert.Zero(t, seriesOf(providerHealthTransitions))
+	assert.Equal(t, 3, series())
+	assert.InDelta(t, 1, testutil.ToFloat64(providerHealthStatus.WithLabelValues(key.Namespace, key.Name, "ollama", "OK")), 0)
+
+	// --- act: ollama flaps ---
+	previous := []llamav1alpha1.ProviderInfo{provider("ollama", "OK"), provider("vllm", "OK")}
+	flapped := []llamav1alpha1.ProviderInfo{provider("ollama", "Error"), provider("vllm", "OK")}
+	recordProviderHealthMetrics(key, previous, flapped)
+	recordProviderHealthMetrics(key, flapped, previous)
+
+	// --- assert ---
+	assert.InDelta(t, 1, transitions("ollama", "Error"), 0)
+	assert.InDelta(t, 1, transitions("ollama", "OK"), 0)
+	assert.Equal(t, 2, seriesOf(providerHealthTransitions), "vllm should not have transitioned")
+	assert.Equal(t, 3, series(), "only the current status of a provider should be reported")
+
+	// --- act: vllm no longer fits in the status ---
+	recordProviderHealthMetrics(key, previous, []llamav1alpha1.ProviderInfo{provider("ollama", "OK")})
+
+	// --- assert ---
+	assert.Equal(t, 2, series())
+
+	// --- act: the instance is deleted ---
+	forgetInstanceMetrics(key)
+
+	// --- assert: the series of the other instance are kept ---
+	assert.Zero(t, seriesOf(providerHealthTransitions))
+	assert.Equal(t, 1, series())
+}
+
+func TestInstanceMetrics(t *testing.T) {
+	withFastServerRequestBackoff(t)
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team-instance-metrics"},
+	}
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+	cli := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+	healthy := true
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if !healthy {
+			return newTestResponse(http.StatusServiceUnavailable, ""), nil
+		}
+		return newTestResponse(http.StatusOK, `{"data": [{"provider_id": "ollama", "health": {"status": "OK"}}]}`), nil
+	})}
+	r := NewReconciler(cli, testScheme, WithHTTPClient(httpClient))
+	t.Cleanup(func() { forgetInstanceMetrics(key) })
+	// Other tests count instances as well, so only the changes made by this test are compared
+	failedBefore := testutil.ToFloat64(distributionsByPhase.WithLabelValues(string(llamav1alpha1.LlamaStackDistributionPhaseFailed)))
+	readyBefore := testutil.ToFloat64(distributionsByPhase.WithLabelValues(string(llamav1alpha1.LlamaStackDistributionPhaseReady)))
+	errorsBefore := testutil.ToFloat64(metrics.ReconcileErrorsTotal.WithLabelValues(ReasonInsufficientPermissions))
+
+	// --- act: the reconciliation fails ---
+	reconcileErr := fmt.Errorf("failed to reconcile ConfigMaps: %w", errInsufficientPermissions)
+	require.NoError(t, r.updateStatus(t.Context(), instance, nil, reconcileErr))
+
+	// --- assert ---
+	assert.InDelta(t, failedBefore+1,
+		testutil.ToFloat64(distributionsByPhase.WithLabelValues(string(llamav1alpha1.LlamaStackDistributionPhaseFailed))), 0)
+	assert.InDelta(t, errorsBefore+1, testutil.ToFloat64(metrics.ReconcileErrorsTotal.WithLabelValues(ReasonInsufficientPermissions)), 0)
+
+	// --- act: the server becomes ready and passes its health check ---
+	instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseReady
+	recordPhaseMetric(key, instance.Status.Phase)
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert: the instance moved to another phase, the metrics are served by the registry ---
+	assert.InDelta(t, failedBefore,
+		testutil.ToFloat64(distributionsByPhase.WithLabelValues(string(llamav1alpha1.LlamaStackDistributionPhaseFailed))), 0)
+	assert.InDelta(t, readyBefore+1,
+		testutil.ToFloat64(distributionsByPhase.WithLabelValues(string(llamav1alpha1.LlamaStackDistributionPhaseReady))), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(serverHealthy.WithLabelValues(key.Namespace, key.Name)), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(serverProviders.WithLabelValues(key.Namespace, key.Name)), 0)
+	families, err := ctrlmetrics.Registry.Gather()
+	require.NoError(t, err)
+	names := make([]string, 0, len(families))
+	for _, family := range families {
+		names = append(names, family.GetName())
+	}
+	assert.Subset(t, names, []string{"llamastack_distributions", "llamastack_server_healthy", "llamastack_server_providers"})
+
+	// --- act: the health check fails ---
+	healthy = false
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert ---
+	assert.Zero(t, testutil.ToFloat64(serverHealthy.WithLabelValues(key.Namespace, key.Name)))
+
+	// --- act: the instance is deleted ---
+	forgetInstanceMetrics(key)
+
+	// --- assert ---
+	assert.InDelta(t, readyBefore,
+		testutil.ToFloat64(distributionsByPhase.WithLabelValues(string(llamav1alpha1.LlamaStackDistributionPhaseReady))), 0)
+	labels := prometheus.Labels{"namespace": key.Namespace, "instance": key.Name}
+	assert.Zero(t, serverHealthy.DeletePartialMatch(labels), "the health series should be deleted with the instance")
+	assert.Zero(t, serverProviders.DeletePartialMatch(labels), "the provider count series should be deleted with the instance")
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/featureflags"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Option configures a reconciler created by NewReconciler.
+type Option func(*LlamaStackDistributionReconciler)
+
+// WithHTTPClient sets the client used to probe the health and providers of the llama-stack servers.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(r *LlamaStackDistributionReconciler) {
+		r.httpClient = httpClient
+	}
+}
+
+// WithClusterInfo sets the detected capabilities of the cluster and the distribution images.
+func WithClusterInfo(clusterInfo *cluster.ClusterInfo) Option {
+	return func(r *LlamaStackDistributionReconciler) {
+		r.ClusterInfo = clusterInfo
+	}
+}
+
+// WithFeatureFlags sets the feature flags, which are otherwise all disabled except the user ConfigMap
+// content validation. A flag enabled by default keeps its default unless it is set in flags.
+func WithFeatureFlags(flags featureflags.FeatureFlags) Option {
+	return func(r *LlamaStackDistributionReconciler) {
+		r.EnableNetworkPolicy = flags.EnableNetworkPolicy.Enabled
+		r.EnableQuotaPreflight = flags.EnableQuotaPreflight.Enabled
+		r.EnableRolloutSerialization = flags.EnableRolloutSerialization.Enabled
+		r.EnableSecurityContextDefaults = flags.EnableSecurityContextDefaults.Enabled
+		r.EnableDefaultTopologySpread = flags.EnableDefaultTopologySpread.Enabled
+		r.ValidateConfigMapContent = flags.ValidateConfigMapContent.EnabledOr(featureflags.ValidateConfigMapContentDefaultValue)
+	}
+}
+
+// WithClock sets the clock used for status timestamps and to suspend the health probing of failing endpoints.
+func WithClock(clock clock.PassiveClock) Option {
+	return func(r *LlamaStackDistributionReconciler) {
+		r.clock = clock
+	}
+}
+
+// NewReconciler creates a reconciler for embedding the controller in another manager. Unlike
+// NewLlamaStackDistributionReconciler it does not read the operator ConfigMap: every dependency
+// is either defaulted or injected through the options.
+func NewReconciler(client client.Client, scheme *runtime.Scheme, opts ...Option) *LlamaStackDistributionReconciler {
+	r := &LlamaStackDistributionReconciler{
+		Client: client,
+		Scheme: scheme,
+		// Requests are bounded by the health check timeout of each instance
+		httpClient:                     &http.Client{},
+		clock:                          clock.RealClock{},
+		UserConfigRevalidationInterval: DefaultUserConfigRevalidationInterval,
+		InitializingRequeueInterval:    DefaultInitializingRequeueInterval,
+		MaxStatusProviders:             DefaultMaxStatusProviders,
+		ValidateConfigMapContent:       featureflags.ValidateConfigMapContentDefaultValue,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	r.healthBreaker = newHealthCheckBreaker(endpointBreakerFailureThreshold, endpointBreakerOpenInterval)
+	r.healthBreaker.now = r.clock.Now
+	r.rolloutLocks = newRolloutLocks()
+	r.serverClients = newServerClients()
+	return r
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/featureflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewReconciler(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+	t.Run("defaults", func(t *testing.T) {
+		r := NewReconciler(cli, scheme.Scheme)
+
+		assert.Equal(t, cli, r.Client)
+		assert.NotNil(t, r.httpClient)
+		assert.Equal(t, clock.RealClock{}, r.clock)
+		assert.False(t, r.EnableNetworkPolicy)
+		assert.False(t, r.EnableQuotaPreflight)
+		assert.False(t, r.EnableSecurityContextDefaults)
+		assert.False(t, r.EnableDefaultTopologySpread)
+		assert.True(t, r.ValidateConfigMapContent)
+		assert.Equal(t, DefaultUserConfigRevalidationInterval, r.UserConfigRevalidationInterval)
+		assert.Equal(t, DefaultMaxStatusProviders, r.MaxStatusProviders)
+		require.NotNil(t, r.healthBreaker)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		httpClient := &http.Client{Timeout: time.Second}
+		clusterInfo := &cluster.ClusterInfo{RouteAvailable: true}
+		fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		r := NewReconciler(cli, scheme.Scheme,
+			WithHTTPClient(httpClient),
+			WithClusterInfo(clusterInfo),
+			WithFeatureFlags(featureflags.FeatureFlags{
+				EnableNetworkPolicy:           featureflags.FeatureFlag{Enabled: true},
+				EnableQuotaPreflight:          featureflags.FeatureFlag{Enabled: true},
+				EnableSecurityContextDefaults: featureflags.FeatureFlag{Enabled: true},
+				EnableDefaultTopologySpread:   featureflags.FeatureFlag{Enabled: true},
+			}),
+			WithClock(fakeClock),
+		)
+
+		assert.Same(t, httpClient, r.httpClient)
+		assert.Same(t, clusterInfo, r.ClusterInfo)
+		assert.True(t, r.EnableNetworkPolicy)
+		assert.True(t, r.EnableQuotaPreflight)
+		assert.True(t, r.EnableSecurityContextDefaults)
+		assert.True(t, r.EnableDefaultTopologySpread)
+		assert.True(t, r.ValidateConfigMapContent, "a flag missing from partial flags should keep its default")
+		assert.Equal(t, fakeClock.Now(), r.now())
+	})
+
+	t.Run("flags enabled by default can be disabled", func(t *testing.T) {
+		r := NewReconciler(cli, scheme.Scheme, WithFeatureFlags(featureflags.FeatureFlags{
+			ValidateConfigMapContent: &featureflags.FeatureFlag{Enabled: false},
+		}))
+
+		assert.False(t, r.ValidateConfigMapContent)
+	})
+
+	t.Run("clock drives the health check breaker", func(t *testing.T) {
+		fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		r := NewReconciler(cli, scheme.Scheme, WithClock(fakeClock))
+		key := types.NamespacedName{Namespace: "test-ns", Name: "test-instance"}
+
+		for range endpointBreakerFailureThreshold {
+			r.healthBreaker.RecordFailure(key)
+		}
+		retryAfter, open := r.healthBreaker.RetryAfter(key)
+		require.True(t, open)
+		assert.Equal(t, endpointBreakerOpenInterval, retryAfter)
+
+		fakeClock.SetTime(fakeClock.Now().Add(endpointBreakerOpenInterval))
+		assert.True(t, r.healthBreaker.Allow(key), "probing should resume once the fake clock passes the open interval")
+	})
+}
+
+func TestStampConditionTransitions(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	previous := []metav1.Condition{
+		{Type: ConditionTypeDeploymentReady, Status: metav1.ConditionTrue, LastTransitionTime: earlier},
+		{Type: ConditionTypeHealthCheck, Status: metav1.ConditionTrue, LastTransitionTime: earlier},
+	}
+	status := &llamav1alpha1.LlamaStackDistributionStatus{}
+	SetDeploymentReadyCondition(status, true, MessageDeploymentReady)
+	SetHealthCheckCondition(status, false, "Health check failed")
+	SetStorageReadyCondition(status, true, MessageStorageReady)
+
+	StampConditionTransitions(status, previous, now)
+
+	assert.Equal(t, earlier, GetCondition(status, ConditionTypeDeploymentReady).LastTransitionTime,
+		"an unchanged status should keep its transition time")
+	assert.Equal(t, metav1.NewTime(now), GetCondition(status, ConditionTypeHealthCheck).LastTransitionTime)
+	assert.Equal(t, metav1.NewTime(now), GetCondition(status, ConditionTypeStorageReady).LastTransitionTime)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// errInsufficientPermissions marks a failure to read a referenced object the operator has no RBAC permissions for.
+var errInsufficientPermissions = errors.New("operator lacks the permissions to read a referenced object")
+
+// canGetConfigMap asks the API server with a SelfSubjectAccessReview whether the operator may get a ConfigMap.
+func (r *LlamaStackDistributionReconciler) canGetConfigMap(ctx context.Context, namespace, name string) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "get",
+				Resource:  "configmaps",
+				Name:      name,
+			},
+		},
+	}
+	if err := r.Create(ctx, review); err != nil {
+		return false, fmt.Errorf("failed to review access to ConfigMap %s/%s: %w", namespace, name, err)
+	}
+	return review.Status.Allowed, nil
+}
+
+// configMapAccessForbidden reports that the operator may not get a referenced ConfigMap in the
+// InsufficientPermissions condition, and returns an error classified as errInsufficientPermissions.
+// The event is only emitted when the permissions go missing, not on every recheck.
+func (r *LlamaStackDistributionReconciler) configMapAccessForbidden(instance *llamav1alpha1.LlamaStackDistribution,
+	namespace, name string) error {
+	message := fmt.Sprintf("Operator is not allowed to get configmaps in namespace %s, grant it get on configmaps there to read ConfigMap %s",
+		namespace, name)
+	if !IsConditionTrue(&instance.Status, ConditionTypeInsufficientPermissions) {
+		r.recordEvent(instance, corev1.EventTypeWarning, EventReasonInsufficientPermissions, message)
+	}
+	SetInsufficientPermissionsCondition(&instance.Status, message)
+	return fmt.Errorf("failed to get ConfigMap %s/%s: %w", namespace, name, errInsufficientPermissions)
+}
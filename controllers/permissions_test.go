@@ -0,0 +1,113 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestUserConfigMapAccessForbidden(t *testing.T) {
+	const validRunYAML = "version: '2'\nproviders:\n  inference: []\n"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-config", Namespace: "shared"},
+		Data:       map[string]string{"run.yaml": validRunYAML},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team-a"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "shared-config", ConfigMapNamespace: "shared"},
+			},
+		},
+	}
+
+	// The fake API server forbids reading ConfigMaps until the operator is granted access.
+	granted := false
+	configMapGets := 0
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if _, ok := obj.(*corev1.ConfigMap); ok {
+				configMapGets++
+				if !granted {
+					return k8serrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, key.Name, errors.New("RBAC denied"))
+				}
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if review, ok := obj.(*authorizationv1.SelfSubjectAccessReview); ok {
+				review.Status.Allowed = granted
+				return nil
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, scheme.Scheme)
+	r.Recorder = recorder
+
+	// --- act: the operator may not read the ConfigMap ---
+	err := r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorIs(t, err, errInsufficientPermissions)
+	condition := GetCondition(&instance.Status, ConditionTypeInsufficientPermissions)
+	require.NotNil(t, condition, "InsufficientPermissions condition should be set")
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonConfigMapAccessForbidden, condition.Reason)
+	assert.Contains(t, condition.Message, "get configmaps in namespace shared")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonInsufficientPermissions)
+
+	result, err := requeueForReconcileError(err)
+	require.NoError(t, err, "missing permissions should not be retried with backoff")
+	assert.Equal(t, ctrl.Result{RequeueAfter: insufficientPermissionsRequeueInterval}, result)
+
+	// --- act: the periodic recheck still finds no access ---
+	err = r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorIs(t, err, errInsufficientPermissions)
+	assert.Equal(t, 1, configMapGets, "the ConfigMap should not be read while the access review denies it")
+	assert.Empty(t, recorder.Events, "the event should only be emitted when the permissions go missing")
+
+	// --- act: the operator is granted access ---
+	granted = true
+	err = r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.Nil(t, GetCondition(&instance.Status, ConditionTypeInsufficientPermissions), "condition should be removed once access is granted")
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeUserConfigReady))
+}
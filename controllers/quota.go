@@ -0,0 +1,186 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// podResources returns the effective requests and limits of a pod, computed the way the scheduler and the
+// ResourceQuota admission do: the sum over the containers, at least the largest init container, plus the overhead.
+func podResources(spec *corev1.PodSpec) (corev1.ResourceList, corev1.ResourceList) {
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+	for _, container := range spec.Containers {
+		addResources(requests, container.Resources.Requests)
+		addResources(limits, container.Resources.Limits)
+	}
+	for _, container := range spec.InitContainers {
+		maxResources(requests, container.Resources.Requests)
+		maxResources(limits, container.Resources.Limits)
+	}
+	addResources(requests, spec.Overhead)
+	addResources(limits, spec.Overhead)
+	return requests, limits
+}
+
+// addResources adds the quantities of add to total.
+func addResources(total, add corev1.ResourceList) {
+	for name, quantity := range add {
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
+
+// maxResources raises the quantities of total to those of other where they are larger.
+func maxResources(total, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if current, ok := total[name]; !ok || quantity.Cmp(current) > 0 {
+			total[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// quotaUsage returns how much of the quota resource name the given number of pods consume,
+// and false if the pods are not accounted against it.
+func quotaUsage(name corev1.ResourceName, replicas int32, requests, limits corev1.ResourceList) (resource.Quantity, bool) {
+	var perPod resource.Quantity
+	switch {
+	case name == corev1.ResourcePods:
+		return *resource.NewQuantity(int64(replicas), resource.DecimalSI), true
+	case name == corev1.ResourceCPU || name == corev1.ResourceMemory || name == corev1.ResourceEphemeralStorage:
+		perPod = requests[name]
+	case strings.HasPrefix(string(name), corev1.DefaultResourceRequestsPrefix):
+		perPod = requests[corev1.ResourceName(strings.TrimPrefix(string(name), corev1.DefaultResourceRequestsPrefix))]
+	case strings.HasPrefix(string(name), "limits."):
+		perPod = limits[corev1.ResourceName(strings.TrimPrefix(string(name), "limits."))]
+	default:
+		return resource.Quantity{}, false
+	}
+
+	total := resource.Quantity{Format: perPod.Format}
+	for range replicas {
+		total.Add(perPod)
+	}
+	return total, true
+}
+
+// exceededQuotas compares the pods of the desired Deployment against the ResourceQuotas of the namespace and
+// returns a description of every quota resource they would exceed. The pods of the current Deployment are
+// replaced by the desired ones, so their usage is released first. Scoped quotas are skipped since they only
+// apply to some pods.
+func exceededQuotas(quotas []corev1.ResourceQuota, desired *appsv1.Deployment, current *appsv1.Deployment) []string {
+	desiredReplicas := replicasOf(desired)
+	desiredRequests, desiredLimits := podResources(&desired.Spec.Template.Spec)
+	var currentReplicas int32
+	currentRequests, currentLimits := corev1.ResourceList{}, corev1.ResourceList{}
+	if current != nil {
+		currentReplicas = replicasOf(current)
+		currentRequests, currentLimits = podResources(&current.Spec.Template.Spec)
+	}
+
+	var exceeded []string
+	for _, quota := range quotas {
+		if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+			continue
+		}
+		hard := quota.Status.Hard
+		if len(hard) == 0 {
+			hard = quota.Spec.Hard
+		}
+		for name, limit := range hard {
+			required, accounted := quotaUsage(name, desiredReplicas, desiredRequests, desiredLimits)
+			if !accounted || required.IsZero() {
+				continue
+			}
+			released, _ := quotaUsage(name, currentReplicas, currentRequests, currentLimits)
+
+			available := limit.DeepCopy()
+			used := quota.Status.Used[name]
+			available.Sub(used)
+			available.Add(released)
+			if required.Cmp(available) > 0 {
+				if available.Sign() < 0 {
+					available = resource.Quantity{Format: available.Format}
+				}
+				exceeded = append(exceeded, fmt.Sprintf("%s in ResourceQuota %s requires %s, %s available",
+					name, quota.Name, required.String(), available.String()))
+			}
+		}
+	}
+	slices.Sort(exceeded)
+	return exceeded
+}
+
+// replicasOf returns the replica count of a Deployment, defaulting to one like the API server does.
+func replicasOf(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *deployment.Spec.Replicas
+}
+
+// checkResourceQuotas sets the QuotaWouldBeExceeded condition by comparing the pods of the Deployment about to
+// be applied against the ResourceQuotas of the namespace. The check is advisory: failures to read the quotas
+// are logged and never block the reconciliation.
+func (r *LlamaStackDistributionReconciler) checkResourceQuotas(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	deployment *appsv1.Deployment) {
+	logger := log.FromContext(ctx)
+
+	quotas := &corev1.ResourceQuotaList{}
+	if err := r.List(ctx, quotas, client.InNamespace(instance.Namespace)); err != nil {
+		logger.Error(err, "failed to list ResourceQuotas, skipping quota pre-flight")
+		return
+	}
+
+	existing := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(deployment), existing); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			logger.Error(err, "failed to fetch deployment, skipping quota pre-flight")
+			return
+		}
+		existing = nil
+	}
+
+	// An autoscaler may scale up to the maximum, so the quota has to fit it
+	desired := deployment.DeepCopy()
+	if desired.Spec.Replicas == nil {
+		_, maxReplicas := replicaRange(instance)
+		desired.Spec.Replicas = &maxReplicas
+	}
+
+	exceeded := exceededQuotas(quotas.Items, desired, existing)
+	alreadyExceeded := meta.IsStatusConditionTrue(instance.Status.Conditions, ConditionTypeQuotaWouldBeExceeded)
+	SetQuotaWouldBeExceededCondition(&instance.Status, exceeded)
+	if len(exceeded) > 0 && !alreadyExceeded {
+		condition := meta.FindStatusCondition(instance.Status.Conditions, ConditionTypeQuotaWouldBeExceeded)
+		r.recordEvent(instance, corev1.EventTypeWarning, EventReasonQuotaWouldBeExceeded, condition.Message)
+		logger.Info("Deployment would exceed the namespace ResourceQuotas", "exceeded", exceeded)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestExceededQuotas(t *testing.T) {
+	deployment := func(replicas int32, requests, limits corev1.ResourceList) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To(replicas),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:      "llama-stack",
+							Resources: corev1.ResourceRequirements{Requests: requests, Limits: limits},
+						}},
+					},
+				},
+			},
+		}
+	}
+	quota := func(name string, hard, used corev1.ResourceList) corev1.ResourceQuota {
+		return corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+			Status:     corev1.ResourceQuotaStatus{Used: used},
+		}
+	}
+	cpu := func(value string) corev1.ResourceList {
+		return corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(value)}
+	}
+
+	tests := []struct {
+		name     string
+		quotas   []corev1.ResourceQuota
+		desired  *appsv1.Deployment
+		current  *appsv1.Deployment
+		expected []string
+	}{
+		{
+			name:    "no quota",
+			desired: deployment(3, cpu("1"), nil),
+		},
+		{
+			name:    "within quota",
+			quotas:  []corev1.ResourceQuota{quota("compute", corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2")}, nil)},
+			desired: deployment(2, cpu("1"), nil),
+		},
+		{
+			name: "scaling up beyond the quota",
+			quotas: []corev1.ResourceQuota{quota("compute",
+				corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("2")}, nil)},
+			desired: deployment(3, cpu("1"), nil),
+			expected: []string{
+				"pods in ResourceQuota compute requires 3, 2 available",
+				"requests.cpu in ResourceQuota compute requires 3, 2 available",
+			},
+		},
+		{
+			name: "usage of other workloads reduces the headroom",
+			quotas: []corev1.ResourceQuota{quota("compute",
+				corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}, cpu("3"))},
+			desired:  deployment(2, cpu("1"), nil),
+			expected: []string{"cpu in ResourceQuota compute requires 2, 1 available"},
+		},
+		{
+			name: "usage of the current pods is released",
+			quotas: []corev1.ResourceQuota{quota("compute",
+				corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")}, cpu("4"))},
+			desired: deployment(2, cpu("2"), nil),
+			current: deployment(2, cpu("1"), nil),
+		},
+		{
+			name: "limits and extended resources",
+			quotas: []corev1.ResourceQuota{quota("gpu", corev1.ResourceList{
+				corev1.ResourceLimitsMemory:           resource.MustParse("8Gi"),
+				"requests.nvidia.com/gpu":             resource.MustParse("1"),
+				corev1.ResourcePersistentVolumeClaims: resource.MustParse("0"),
+			}, nil)},
+			desired: deployment(2,
+				corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")}),
+			expected: []string{"requests.nvidia.com/gpu in ResourceQuota gpu requires 2, 1 available"},
+		},
+		{
+			name: "scoped quota is skipped",
+			quotas: []corev1.ResourceQuota{{
+				ObjectMeta: metav1.ObjectMeta{Name: "best-effort"},
+				Spec: corev1.ResourceQuotaSpec{
+					Hard:   corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+					Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort},
+				},
+			}},
+			desired: deployment(3, nil, nil),
+		},
+		{
+			name: "enforced hard limits take precedence over the spec",
+			quotas: []corev1.ResourceQuota{{
+				ObjectMeta: metav1.ObjectMeta{Name: "compute"},
+				Spec:       corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")}},
+				Status:     corev1.ResourceQuotaStatus{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")}},
+			}},
+			desired:  deployment(2, nil, nil),
+			expected: []string{"pods in ResourceQuota compute requires 2, 1 available"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, exceededQuotas(tt.quotas, tt.desired, tt.current))
+		})
+	}
+}
+
+func TestPodResources(t *testing.T) {
+	spec := &corev1.PodSpec{
+		InitContainers: []corev1.Container{{
+			Name:      "init",
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")}},
+		}},
+		Containers: []corev1.Container{
+			{Name: "a", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			}}},
+			{Name: "b", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			}}},
+		},
+		Overhead: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+	}
+
+	requests, limits := podResources(spec)
+
+	cpu := requests[corev1.ResourceCPU]
+	memory := requests[corev1.ResourceMemory]
+	assert.Equal(t, "3", cpu.String(), "the largest init container should win over the sum of the containers")
+	assert.Equal(t, "2176Mi", memory.String(), "the containers and the overhead should be summed")
+	overhead := limits[corev1.ResourceMemory]
+	assert.Equal(t, "128Mi", overhead.String())
+}
@@ -20,11 +20,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"net/url"
+	"path"
 	"regexp"
+	"slices"
 	"strings"
 
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/deploy"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -35,17 +48,63 @@ const (
 	// maxConfigMapKeyLength defines the maximum allowed length for ConfigMap keys
 	// based on Kubernetes DNS subdomain name limits.
 	maxConfigMapKeyLength = 253
+	// maxPort is the highest valid container port.
+	maxPort = 65535
+	// maxPrivilegedPort is the highest port reserved to privileged processes.
+	maxPrivilegedPort = 1023
+)
+
+// storageVolumeName is the name of the volume holding the data of the server.
+const storageVolumeName = "lls-storage"
+
+// tmpVolumeName is the name of the volume mounted on /tmp of a server container with a read-only root filesystem.
+const tmpVolumeName = "lls-tmp"
+
+// storageOwnerID is the user and group owning the storage volume, the user the server images run as.
+const storageOwnerID = int64(1001)
+
+// defaultInitContainerResources are the resources of the init containers added by the operator unless overridden
+// in the pod overrides, so that the pods are admitted in namespaces with a LimitRange or ResourceQuota.
+var defaultInitContainerResources = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+		corev1.ResourceMemory: resource.MustParse("32Mi"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+		corev1.ResourceMemory: resource.MustParse("64Mi"),
+	},
+}
+
+// Storage backends of the server data volume.
+const (
+	storageBackendEmptyDir = "emptyDir"
+	storageBackendPVC      = "PersistentVolumeClaim"
 )
 
 // Readiness probe configuration.
 const (
 	readinessProbeInitialDelaySeconds = 15 // Time to wait before the first probe
 	readinessProbePeriodSeconds       = 10 // How often to probe
-	readinessProbeTimeoutSeconds      = 5  // When the probe times out
 	readinessProbeFailureThreshold    = 3  // Pod is marked Unhealthy after 3 consecutive failures
 	readinessProbeSuccessThreshold    = 1  // Pod is marked Ready after 1 successful probe
 )
 
+// Liveness probe configuration. The initial delay leaves time for the server to load its models.
+const (
+	livenessProbeInitialDelaySeconds = 60 // Time to wait before the first probe
+	livenessProbePeriodSeconds       = 30 // How often to probe
+	livenessProbeFailureThreshold    = 3  // Container is restarted after 3 consecutive failures
+)
+
+// Kueue integration.
+const (
+	// kueueQueueNameLabel is the pod label Kueue uses to select the LocalQueue.
+	kueueQueueNameLabel = "kueue.x-k8s.io/queue-name"
+	// kueueAdmissionGate is the scheduling gate Kueue removes once the pod is admitted.
+	kueueAdmissionGate = "kueue.x-k8s.io/admission"
+)
+
 // validConfigMapKeyRegex defines allowed characters for ConfigMap keys.
 // Kubernetes ConfigMap keys must be valid DNS subdomain names or data keys.
 var validConfigMapKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-_.]*[a-zA-Z0-9])?$`)
@@ -78,27 +137,15 @@ func buildContainerSpec(ctx context.Context, r *LlamaStackDistributionReconciler
 		Name:            getContainerName(instance),
 		Image:           image,
 		Resources:       instance.Spec.Server.ContainerSpec.Resources,
-		ImagePullPolicy: corev1.PullAlways,
-		Ports:           []corev1.ContainerPort{{ContainerPort: getContainerPort(instance)}},
-		ReadinessProbe: &corev1.Probe{
-			ProbeHandler: corev1.ProbeHandler{
-				HTTPGet: &corev1.HTTPGetAction{
-					Path: "/v1/health",
-					Port: intstr.FromInt(int(getContainerPort(instance))),
-				},
-			},
-			InitialDelaySeconds: readinessProbeInitialDelaySeconds,
-			PeriodSeconds:       readinessProbePeriodSeconds,
-			TimeoutSeconds:      readinessProbeTimeoutSeconds,
-			FailureThreshold:    readinessProbeFailureThreshold,
-			SuccessThreshold:    readinessProbeSuccessThreshold,
-		},
+		ImagePullPolicy: getImagePullPolicy(instance, image),
+		Ports:           append([]corev1.ContainerPort{{ContainerPort: getContainerPort(instance)}}, deploy.GetExtraPorts(instance)...),
 	}
 
-	// Configure environment variables and mounts
+	// Configure probes, environment variables and mounts
+	configureContainerProbes(instance, &container)
 	configureContainerEnvironment(ctx, r, instance, &container)
 	configureContainerMounts(ctx, r, instance, &container)
-	configureContainerCommands(instance, &container)
+	configureContainerCommands(r, instance, &container)
 
 	return container
 }
@@ -111,12 +158,81 @@ func getContainerName(instance *llamav1alpha1.LlamaStackDistribution) string {
 	return llamav1alpha1.DefaultContainerName
 }
 
+// getImagePullPolicy returns the pull policy of the server image, using the policy of the spec if specified.
+// An image pinned by digest cannot change, so it is only pulled when missing from the node.
+func getImagePullPolicy(instance *llamav1alpha1.LlamaStackDistribution, image string) corev1.PullPolicy {
+	if instance.Spec.Server.ContainerSpec.ImagePullPolicy != "" {
+		return instance.Spec.Server.ContainerSpec.ImagePullPolicy
+	}
+	if strings.Contains(image, "@") {
+		return corev1.PullIfNotPresent
+	}
+	return corev1.PullAlways
+}
+
 // getContainerPort returns the container port, using custom port if specified.
 func getContainerPort(instance *llamav1alpha1.LlamaStackDistribution) int32 {
-	if instance.Spec.Server.ContainerSpec.Port != 0 {
-		return instance.Spec.Server.ContainerSpec.Port
+	// Same port as the Service targets, including the default port of a distribution only defining env vars
+	return deploy.GetServicePort(instance)
+}
+
+// getHealthCheckContainerPort returns the container port serving the health endpoint.
+func getHealthCheckContainerPort(instance *llamav1alpha1.LlamaStackDistribution) int32 {
+	if hc := instance.Spec.Server.HealthCheck; hc != nil && hc.Port != 0 {
+		return hc.Port
+	}
+	return getContainerPort(instance)
+}
+
+// configureContainerProbes sets the readiness, liveness and startup probes of the container.
+// Probes supplied by the user are used verbatim. The readiness and liveness probes default to HTTP probes
+// against the health check endpoint, the startup probe is only set if supplied.
+func configureContainerProbes(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+	probes := instance.Spec.Server.ContainerSpec.Probes
+	if probes != nil && probes.Disable {
+		return
+	}
+
+	container.ReadinessProbe = newHealthCheckProbe(instance,
+		readinessProbeInitialDelaySeconds, readinessProbePeriodSeconds, readinessProbeFailureThreshold, readinessProbeSuccessThreshold)
+	container.LivenessProbe = newHealthCheckProbe(instance,
+		livenessProbeInitialDelaySeconds, livenessProbePeriodSeconds, livenessProbeFailureThreshold, 1)
+
+	if probes == nil {
+		return
+	}
+	if probes.Readiness != nil {
+		container.ReadinessProbe = probes.Readiness.DeepCopy()
+	}
+	if probes.Liveness != nil {
+		container.LivenessProbe = probes.Liveness.DeepCopy()
+	}
+	if probes.Startup != nil {
+		container.StartupProbe = probes.Startup.DeepCopy()
+	}
+}
+
+// newHealthCheckProbe returns an HTTP probe against the health check endpoint of the server.
+func newHealthCheckProbe(instance *llamav1alpha1.LlamaStackDistribution, initialDelaySeconds, periodSeconds, failureThreshold, successThreshold int32) *corev1.Probe {
+	// The scheme defaults to HTTP
+	var scheme corev1.URIScheme
+	if hasServerCert(instance) {
+		scheme = corev1.URISchemeHTTPS
+	}
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   deploy.GetHealthCheckPath(instance),
+				Port:   intstr.FromInt(int(getHealthCheckContainerPort(instance))),
+				Scheme: scheme,
+			},
+		},
+		InitialDelaySeconds: initialDelaySeconds,
+		PeriodSeconds:       periodSeconds,
+		TimeoutSeconds:      deploy.GetHealthCheckTimeoutSeconds(instance),
+		FailureThreshold:    failureThreshold,
+		SuccessThreshold:    successThreshold,
 	}
-	return llamav1alpha1.DefaultServerPort
 }
 
 // configureContainerEnvironment sets up environment variables for the container.
@@ -127,15 +243,15 @@ func configureContainerEnvironment(ctx context.Context, r *LlamaStackDistributio
 	// on the same volume as the storage. This is not critical but useful if the server is
 	// restarted so the models and datasets are not lost and need to be downloaded again.
 	// For more information, see https://huggingface.co/docs/datasets/en/cache
-	container.Env = append(container.Env, corev1.EnvVar{
+	operatorEnv := []corev1.EnvVar{{
 		Name:  "HF_HOME",
 		Value: mountPath,
-	})
+	}}
 
 	// Add CA bundle environment variable if TLS config is specified
 	if instance.Spec.Server.TLSConfig != nil && instance.Spec.Server.TLSConfig.CABundle != nil {
 		// Set SSL_CERT_FILE to point to the specific CA bundle file
-		container.Env = append(container.Env, corev1.EnvVar{
+		operatorEnv = append(operatorEnv, corev1.EnvVar{
 			Name:  "SSL_CERT_FILE",
 			Value: CABundleMountPath,
 		})
@@ -143,15 +259,33 @@ func configureContainerEnvironment(ctx context.Context, r *LlamaStackDistributio
 		// Check for auto-detected ODH trusted CA bundle
 		if _, keys, err := r.detectODHTrustedCABundle(ctx, instance); err == nil && len(keys) > 0 {
 			// Set SSL_CERT_FILE to point to the auto-detected consolidated CA bundle
-			container.Env = append(container.Env, corev1.EnvVar{
+			operatorEnv = append(operatorEnv, corev1.EnvVar{
 				Name:  "SSL_CERT_FILE",
 				Value: CABundleMountPath,
 			})
 		}
 	}
 
-	// Finally, add the user provided env vars
-	container.Env = append(container.Env, instance.Spec.Server.ContainerSpec.Env...)
+	// Finally, merge in the user provided env vars
+	container.Env = append(container.Env, mergeEnvVars(operatorEnv, instance.Spec.Server.ContainerSpec.Env)...)
+
+	// Variables from the sources are overridden by those of Env, so the operator managed ones are kept
+	container.EnvFrom = append(container.EnvFrom, instance.Spec.Server.ContainerSpec.EnvFrom...)
+}
+
+// mergeEnvVars renders the operator and user env vars in a canonical order so that the same set of
+// variables always produces the same container spec and does not trigger a rollout.
+// Operator vars are sorted by name and come first. User vars keep their order, as they may reference
+// earlier variables with $(VAR_NAME), and replace operator vars of the same name.
+func mergeEnvVars(operatorEnv, userEnv []corev1.EnvVar) []corev1.EnvVar {
+	env := make([]corev1.EnvVar, 0, len(operatorEnv)+len(userEnv))
+	for _, envVar := range operatorEnv {
+		if !slices.ContainsFunc(userEnv, func(userVar corev1.EnvVar) bool { return userVar.Name == envVar.Name }) {
+			env = append(env, envVar)
+		}
+	}
+	slices.SortStableFunc(env, func(a, b corev1.EnvVar) int { return strings.Compare(a.Name, b.Name) })
+	return append(env, userEnv...)
 }
 
 // configureContainerMounts sets up volume mounts for the container.
@@ -164,14 +298,23 @@ func configureContainerMounts(ctx context.Context, r *LlamaStackDistributionReco
 
 	// Add CA bundle volume mount if TLS config is specified or auto-detected
 	addCABundleVolumeMount(ctx, r, instance, container)
+
+	// Add serving certificate volume mount if the server serves HTTPS
+	addServerCertVolumeMount(instance, container)
 }
 
 // configureContainerCommands sets up container commands and args.
-func configureContainerCommands(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+func configureContainerCommands(r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
 	// Override the container entrypoint to use the custom config file if user config is specified
-	if instance.Spec.Server.UserConfig != nil && instance.Spec.Server.UserConfig.ConfigMapName != "" {
+	if instance.RunUserConfig() != nil {
 		container.Command = []string{"python", "-m", "llama_stack.distribution.server.server"}
-		container.Args = []string{"--config", "/etc/llama-stack/run.yaml"}
+		container.Args = []string{"--config", path.Join(getUserConfigMountPath(instance), userConfigRunYAMLKey)}
+
+		// Distributions that are not started through the Python module provide their own entrypoint
+		if entrypoint, exists := getDistributionEntrypoint(r, instance); exists {
+			container.Command = entrypoint.Command
+			container.Args = entrypoint.Args
+		}
 	}
 
 	// Apply user-specified command and args (takes precedence)
@@ -181,7 +324,25 @@ func configureContainerCommands(instance *llamav1alpha1.LlamaStackDistribution,
 
 	if len(instance.Spec.Server.ContainerSpec.Args) > 0 {
 		container.Args = instance.Spec.Server.ContainerSpec.Args
+		return
+	}
+
+	// Serve the mounted certificate, user-specified args have to pass the flags themselves
+	if hasServerCert(instance) {
+		container.Args = slices.Concat(container.Args, []string{
+			"--tls-certfile", path.Join(ServerCertMountPath, corev1.TLSCertKey),
+			"--tls-keyfile", path.Join(ServerCertMountPath, corev1.TLSPrivateKeyKey),
+		})
+	}
+}
+
+// getDistributionEntrypoint returns the configured default entrypoint for the instance's distribution, if any.
+func getDistributionEntrypoint(r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution) (DistributionEntrypoint, bool) {
+	if r == nil || instance.Spec.Server.Distribution.Name == "" {
+		return DistributionEntrypoint{}, false
 	}
+	entrypoint, exists := r.DistributionEntrypoints[instance.Spec.Server.Distribution.Name]
+	return entrypoint, exists
 }
 
 // getMountPath returns the mount path, using custom path if specified.
@@ -196,24 +357,56 @@ func getMountPath(instance *llamav1alpha1.LlamaStackDistribution) string {
 func addStorageVolumeMount(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
 	mountPath := getMountPath(instance)
 	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
-		Name:      "lls-storage",
+		Name:      storageVolumeName,
 		MountPath: mountPath,
 	})
 }
 
-// addUserConfigVolumeMount adds the user config volume mount to the container if specified.
+// addUserConfigVolumeMount adds the user config volume mount to the container if specified, and a mount for each
+// ConfigMap of UserConfigs mounted at its own path.
 func addUserConfigVolumeMount(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
-	if instance.Spec.Server.UserConfig != nil && instance.Spec.Server.UserConfig.ConfigMapName != "" {
+	if instance.RunUserConfig() == nil {
+		return
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      "user-config",
+		MountPath: getUserConfigMountPath(instance),
+		ReadOnly:  true,
+	})
+	for i, userConfig := range instance.MountedUserConfigs() {
 		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
-			Name:      "user-config",
-			MountPath: "/etc/llama-stack/",
+			Name:      mountedUserConfigVolumeName(i),
+			MountPath: userConfig.MountPath,
 			ReadOnly:  true,
 		})
 	}
 }
 
+// getUserConfigMountPath returns the directory of the user ConfigMap holding the run configuration.
+func getUserConfigMountPath(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if userConfig := instance.RunUserConfig(); userConfig != nil && userConfig.MountPath != "" {
+		return userConfig.MountPath
+	}
+	return userConfigMountPath
+}
+
+// mountedUserConfigVolumeName returns the name of the volume of the i-th ConfigMap of UserConfigs mounted at its
+// own path.
+func mountedUserConfigVolumeName(i int) string {
+	return fmt.Sprintf("user-config-%d", i+1)
+}
+
+// getUserConfigNamespace returns the namespace of the ConfigMap of a user config, the namespace of the instance
+// by default.
+func getUserConfigNamespace(instance *llamav1alpha1.LlamaStackDistribution, userConfig llamav1alpha1.UserConfigSpec) string {
+	if userConfig.ConfigMapNamespace != "" {
+		return userConfig.ConfigMapNamespace
+	}
+	return instance.Namespace
+}
+
 // addCABundleVolumeMount adds the CA bundle volume mount to the container if TLS config is specified.
-// For multiple keys: the init container writes DefaultCABundleKey to the root of the emptyDir volume,
+// For multiple keys: the operator writes DefaultCABundleKey to the derived CA bundle ConfigMap,
 // and the main container mounts it with SubPath to CABundleMountPath.
 // For single key: the main container directly mounts the ConfigMap key.
 // Also handles auto-detected ODH trusted CA bundle ConfigMaps.
@@ -239,100 +432,62 @@ func addCABundleVolumeMount(ctx context.Context, r *LlamaStackDistributionReconc
 	}
 }
 
+// addServerCertVolumeMount adds the serving certificate volume mount to the container if specified.
+func addServerCertVolumeMount(instance *llamav1alpha1.LlamaStackDistribution, container *corev1.Container) {
+	if !hasServerCert(instance) {
+		return
+	}
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      ServerCertVolumeName,
+		MountPath: ServerCertMountPath,
+		ReadOnly:  true,
+	})
+}
+
+// configureServerCert adds the volume of the Secret holding the serving certificate to the pod if specified.
+func configureServerCert(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
+	if !hasServerCert(instance) {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: ServerCertVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: instance.Spec.Server.TLSConfig.ServerCert.SecretName,
+			},
+		},
+	})
+}
+
+// getServerScheme returns the URL scheme served by the llama-stack server.
+func getServerScheme(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if hasServerCert(instance) {
+		return "https"
+	}
+	return "http"
+}
+
 // createCABundleVolume creates the appropriate volume configuration for CA bundles.
 // For single key: uses direct ConfigMap volume.
-// For multiple keys: uses emptyDir volume with InitContainer to concatenate keys.
-func createCABundleVolume(caBundleConfig *llamav1alpha1.CABundleConfig) corev1.Volume {
-	// For multiple keys, we'll use an emptyDir that gets populated by an InitContainer
+// For multiple keys: uses the derived ConfigMap holding the keys concatenated by the operator.
+func createCABundleVolume(instance *llamav1alpha1.LlamaStackDistribution, caBundleConfig *llamav1alpha1.CABundleConfig) corev1.Volume {
+	configMapName := caBundleConfig.ConfigMapName
 	if len(caBundleConfig.ConfigMapKeys) > 0 {
-		return corev1.Volume{
-			Name: CABundleVolumeName,
-			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
-			},
-		}
+		configMapName = deploy.GetCABundleConfigMapName(instance)
 	}
 
-	// For single key (legacy behavior), use direct ConfigMap volume
 	return corev1.Volume{
 		Name: CABundleVolumeName,
 		VolumeSource: corev1.VolumeSource{
 			ConfigMap: &corev1.ConfigMapVolumeSource{
 				LocalObjectReference: corev1.LocalObjectReference{
-					Name: caBundleConfig.ConfigMapName,
+					Name: configMapName,
 				},
 			},
 		},
 	}
 }
 
-// createCABundleInitContainer creates an InitContainer that concatenates multiple CA bundle keys
-// from a ConfigMap into a single file in the shared ca-bundle volume.
-func createCABundleInitContainer(caBundleConfig *llamav1alpha1.CABundleConfig) (corev1.Container, error) {
-	// Validate ConfigMap keys for security
-	if err := validateConfigMapKeys(caBundleConfig.ConfigMapKeys); err != nil {
-		return corev1.Container{}, fmt.Errorf("failed to validate ConfigMap keys: %w", err)
-	}
-
-	// Build the file list as a shell array embedded in the script
-	// This ensures the arguments are properly passed to the script
-	var fileListBuilder strings.Builder
-	for i, key := range caBundleConfig.ConfigMapKeys {
-		if i > 0 {
-			fileListBuilder.WriteString(" ")
-		}
-		// Quote each key to handle any special characters safely
-		fileListBuilder.WriteString(fmt.Sprintf("%q", key))
-	}
-	fileList := fileListBuilder.String()
-
-	// Use a secure script approach that embeds the file list directly
-	// This eliminates the issue with arguments not being passed to sh -c
-	script := fmt.Sprintf(`#!/bin/sh
-set -e
-output_file="%s"
-source_dir="%s"
-
-# Clear the output file
-> "$output_file"
-
-# Process each validated key file (keys are pre-validated)
-for key in %s; do
-    file_path="$source_dir/$key"
-    if [ -f "$file_path" ]; then
-        cat "$file_path" >> "$output_file"
-        echo >> "$output_file"  # Add newline between certificates
-    else
-        echo "Warning: Certificate file $file_path not found" >&2
-    fi
-done`, CABundleTempPath, CABundleSourceDir, fileList)
-
-	return corev1.Container{
-		Name:    CABundleInitName,
-		Image:   "registry.access.redhat.com/ubi9/ubi-minimal:latest",
-		Command: []string{"/bin/sh", "-c", script},
-		// No Args needed since we embed the file list in the script
-		VolumeMounts: []corev1.VolumeMount{
-			{
-				Name:      CABundleSourceVolName,
-				MountPath: CABundleSourceDir,
-				ReadOnly:  true,
-			},
-			{
-				Name:      CABundleVolumeName,
-				MountPath: CABundleTempDir,
-			},
-		},
-		SecurityContext: &corev1.SecurityContext{
-			AllowPrivilegeEscalation: &[]bool{false}[0],
-			RunAsNonRoot:             &[]bool{false}[0],
-			Capabilities: &corev1.Capabilities{
-				Drop: []corev1.Capability{"ALL"},
-			},
-		},
-	}, nil
-}
-
 // configurePodStorage configures the pod storage and returns the complete pod spec.
 func configurePodStorage(ctx context.Context, r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution, container corev1.Container) corev1.PodSpec {
 	podSpec := corev1.PodSpec{
@@ -340,7 +495,7 @@ func configurePodStorage(ctx context.Context, r *LlamaStackDistributionReconcile
 	}
 
 	// Configure storage volumes and init containers
-	configureStorage(instance, &podSpec)
+	configureStorage(instance, &podSpec, r.initContainerImage(instance))
 
 	// Configure TLS CA bundle (with auto-detection support)
 	configureTLSCABundle(ctx, r, instance, &podSpec)
@@ -348,33 +503,136 @@ func configurePodStorage(ctx context.Context, r *LlamaStackDistributionReconcile
 	// Configure user config
 	configureUserConfig(instance, &podSpec)
 
-	// Apply pod overrides including ServiceAccount, volumes, and volume mounts
+	// Configure the serving certificate
+	configureServerCert(instance, &podSpec)
+
+	// Apply pod overrides including ServiceAccount, volumes, volume mounts and security contexts
 	configurePodOverrides(instance, &podSpec)
 
+	// Restrict the server container unless the spec sets its security context
+	if r != nil && r.EnableSecurityContextDefaults {
+		configureSecurityContextDefaults(&podSpec)
+	}
+
+	// Keep /tmp writable on a read-only root filesystem, the storage volume is writable already
+	configureTmpVolume(&podSpec)
+
+	// Constrain the nodes the pods are scheduled on
+	configurePodScheduling(instance, &podSpec, r != nil && r.EnableDefaultTopologySpread)
+
 	return podSpec
 }
 
-// configureStorage handles storage volume configuration.
-func configureStorage(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
+// configurePodScheduling copies the node selector, tolerations, affinity, topology spread constraints, runtime class
+// and scheduler name of the server spec to the pod spec, the fields set in the pod overrides taking precedence. Without
+// an affinity or topology spread constraints, the pods of a multi-replica server are spread across nodes, and across
+// zones too when defaultTopologySpread is set.
+func configurePodScheduling(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec, defaultTopologySpread bool) {
+	podSpec.NodeSelector = instance.Spec.Server.NodeSelector
+	podSpec.Tolerations = instance.Spec.Server.Tolerations
+	podSpec.Affinity = instance.Spec.Server.Affinity
+	podSpec.RuntimeClassName = instance.Spec.Server.RuntimeClassName
+	podSpec.SchedulerName = instance.Spec.Server.SchedulerName
+	podSpec.TopologySpreadConstraints = instance.Spec.Server.TopologySpreadConstraints
+	if overrides := instance.Spec.Server.PodOverrides; overrides != nil {
+		configureSchedulingOverrides(overrides, podSpec)
+	}
+	if len(podSpec.TopologySpreadConstraints) > 0 {
+		return
+	}
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = defaultPodAntiAffinity(instance)
+	}
+	if defaultTopologySpread {
+		podSpec.TopologySpreadConstraints = defaultTopologySpreadConstraints(instance)
+	}
+}
+
+// defaultTopologySpreadConstraints returns a soft constraint spreading the server pods across zones, or nil if at
+// most one replica runs. Pods still schedule when the nodes have no zone label or a zone is full.
+func defaultTopologySpreadConstraints(instance *llamav1alpha1.LlamaStackDistribution) []corev1.TopologySpreadConstraint {
+	if _, maxReplicas := replicaRange(instance); maxReplicas <= 1 {
+		return nil
+	}
+	return []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       corev1.LabelTopologyZone,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: deploy.GetSelectorLabels(instance)},
+	}}
+}
+
+// configureSchedulingOverrides replaces the scheduling fields of the pod spec with those set in the pod overrides.
+func configureSchedulingOverrides(overrides *llamav1alpha1.PodOverrides, podSpec *corev1.PodSpec) {
+	if len(overrides.NodeSelector) > 0 {
+		podSpec.NodeSelector = overrides.NodeSelector
+	}
+	if len(overrides.Tolerations) > 0 {
+		podSpec.Tolerations = overrides.Tolerations
+	}
+	if overrides.Affinity != nil {
+		podSpec.Affinity = overrides.Affinity
+	}
+	if len(overrides.TopologySpreadConstraints) > 0 {
+		podSpec.TopologySpreadConstraints = overrides.TopologySpreadConstraints
+	}
+}
+
+// defaultPodAntiAffinity returns a soft anti-affinity spreading the server pods across nodes, or nil if at most one
+// replica runs. The rule is only preferred, so all the pods still schedule on a single-node cluster.
+func defaultPodAntiAffinity(instance *llamav1alpha1.LlamaStackDistribution) *corev1.Affinity {
+	if _, maxReplicas := replicaRange(instance); maxReplicas <= 1 {
+		return nil
+	}
+	return &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: 100,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app.kubernetes.io/instance": instance.Name},
+					},
+					TopologyKey: corev1.LabelHostname,
+				},
+			}},
+		},
+	}
+}
+
+// configureStorage handles storage volume configuration. The utility image runs the init container fixing the
+// permissions of the PVC, if requested.
+func configureStorage(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec, utilityImage string) {
 	if instance.Spec.Server.Storage != nil {
-		configurePersistentStorage(instance, podSpec)
+		configurePersistentStorage(instance, podSpec, utilityImage)
 	} else {
 		configureEmptyDirStorage(podSpec)
 	}
 }
 
-// configurePersistentStorage sets up PVC-based storage with init container for permissions.
-func configurePersistentStorage(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
+// configurePersistentStorage sets up PVC-based storage, writable through the fsGroup of the pods or, when opted in,
+// an init container changing its owner.
+func configurePersistentStorage(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec, utilityImage string) {
 	// Use PVC for persistent storage
 	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-		Name: "lls-storage",
+		Name: storageVolumeName,
 		VolumeSource: corev1.VolumeSource{
 			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-				ClaimName: instance.Name + "-pvc",
+				ClaimName: deploy.GetPVCName(instance),
+				ReadOnly:  isReadOnlyStorage(instance.Spec.Server.Storage),
 			},
 		},
 	})
 
+	// The kubelet makes the volume group-writable for the fsGroup. An explicit pod security context replaces
+	// this one, see configurePodOverrides
+	if !instance.Spec.Server.Storage.PermissionsInitContainer {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{
+			FSGroup:             ptr.To(ptr.Deref(instance.Spec.Server.Storage.FSGroup, storageOwnerID)),
+			FSGroupChangePolicy: ptr.To(corev1.FSGroupChangeOnRootMismatch),
+		}
+		return
+	}
+
 	// Add init container to fix permissions on the PVC mount.
 	mountPath := llamav1alpha1.DefaultMountPath
 	if instance.Spec.Server.Storage.MountPath != "" {
@@ -383,14 +641,14 @@ func configurePersistentStorage(instance *llamav1alpha1.LlamaStackDistribution,
 
 	commands := []string{
 		fmt.Sprintf("mkdir -p %s 2>&1 || echo 'Warning: Could not create directory'", mountPath),
-		fmt.Sprintf("(chown 1001:0 %s 2>&1 || echo 'Warning: Could not change ownership')", mountPath),
+		fmt.Sprintf("(chown %d:0 %s 2>&1 || echo 'Warning: Could not change ownership')", storageOwnerID, mountPath),
 		fmt.Sprintf("ls -la %s 2>&1", mountPath),
 	}
 	command := strings.Join(commands, " && ")
 
 	initContainer := corev1.Container{
 		Name:  "update-pvc-permissions",
-		Image: "registry.access.redhat.com/ubi9/ubi-minimal:latest",
+		Image: utilityImage,
 		Command: []string{
 			"/bin/sh",
 			"-c",
@@ -399,7 +657,7 @@ func configurePersistentStorage(instance *llamav1alpha1.LlamaStackDistribution,
 		},
 		VolumeMounts: []corev1.VolumeMount{
 			{
-				Name:      "lls-storage",
+				Name:      storageVolumeName,
 				MountPath: mountPath,
 			},
 		},
@@ -407,16 +665,164 @@ func configurePersistentStorage(instance *llamav1alpha1.LlamaStackDistribution,
 			RunAsUser:  ptr.To(int64(0)), // Run as root to be able to change ownership
 			RunAsGroup: ptr.To(int64(0)),
 		},
+		Resources: getInitContainerResources(instance),
 	}
 
 	podSpec.InitContainers = append(podSpec.InitContainers, initContainer)
 }
 
+// getInitContainerResources returns the resources of the init containers added by the operator, the defaults unless
+// overridden in the pod overrides.
+func getInitContainerResources(instance *llamav1alpha1.LlamaStackDistribution) corev1.ResourceRequirements {
+	if overrides := instance.Spec.Server.PodOverrides; overrides != nil && overrides.InitContainerResources != nil {
+		return *overrides.InitContainerResources.DeepCopy()
+	}
+	return *defaultInitContainerResources.DeepCopy()
+}
+
+// isReadOnlyStorage reports whether the PVC can only be mounted read-only.
+func isReadOnlyStorage(storage *llamav1alpha1.StorageSpec) bool {
+	if len(storage.AccessModes) == 0 {
+		return false
+	}
+	for _, mode := range storage.AccessModes {
+		if mode != corev1.ReadOnlyMany {
+			return false
+		}
+	}
+	return true
+}
+
+// validateStorageSize validates that the requested PVC size is at least minSize. A zero minSize disables the check.
+func validateStorageSize(instance *llamav1alpha1.LlamaStackDistribution, minSize resource.Quantity) error {
+	if instance.Spec.Server.Storage == nil || minSize.IsZero() {
+		return nil
+	}
+	size := deploy.GetStorageSize(instance)
+	if size.Cmp(minSize) < 0 {
+		return fmt.Errorf("failed to validate storage size %s: must be at least %s", size.String(), minSize.String())
+	}
+	return nil
+}
+
+// desiredStorageBackend returns the storage backend of the server data volume requested by the spec.
+func desiredStorageBackend(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.Server.Storage != nil {
+		return storageBackendPVC
+	}
+	return storageBackendEmptyDir
+}
+
+// deploymentStorageBackend returns the storage backend of the server data volume of a Deployment,
+// or an empty string if the Deployment has no data volume backed by emptyDir or a PVC.
+func deploymentStorageBackend(deployment *appsv1.Deployment) string {
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name != storageVolumeName {
+			continue
+		}
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			return storageBackendPVC
+		case volume.EmptyDir != nil:
+			return storageBackendEmptyDir
+		}
+	}
+	return ""
+}
+
+// storageChangeAcknowledgment returns the value of the acknowledgment annotation allowing the switch of the storage
+// backend between from and to, e.g. emptyDir->pvc.
+func storageChangeAcknowledgment(from, to string) string {
+	shortName := func(backend string) string {
+		if backend == storageBackendPVC {
+			return "pvc"
+		}
+		return backend
+	}
+	return shortName(from) + "->" + shortName(to)
+}
+
+// isStorageChangeAcknowledged reports whether the instance allows switching the storage backend from one backend to
+// another without migrating the data. The acknowledgment names the switch, so that an annotation left behind does not
+// approve a later switch in the other direction.
+func isStorageChangeAcknowledged(instance *llamav1alpha1.LlamaStackDistribution, from, to string) bool {
+	return instance.GetAnnotations()[llamav1alpha1.AcknowledgeStorageChangeAnnotation] == storageChangeAcknowledgment(from, to)
+}
+
+// isReconcilePaused reports whether the reconciliation of the instance is suspended by the pause annotation.
+func isReconcilePaused(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance.GetAnnotations()[llamav1alpha1.ReconcilePausedAnnotation] == "true"
+}
+
+// storageSizeWarning returns a hint if the PVC size uses a decimal unit, or an empty string otherwise.
+// A size like 10G is 10^10 bytes, about 7% less than the 10Gi users usually mean.
+func storageSizeWarning(instance *llamav1alpha1.LlamaStackDistribution) string {
+	storage := instance.Spec.Server.Storage
+	if storage == nil || storage.Size == nil || storage.Size.Format != resource.DecimalSI {
+		return ""
+	}
+	return "Storage size uses a decimal unit, use a binary unit such as Gi for sizes in powers of 1024"
+}
+
+// storageAccessModeWarning returns a warning if the requested PVC access modes do not fit the replica count,
+// or an empty string otherwise. ReadWriteMany volumes are not needed by a single replica and are not
+// supported by every StorageClass, so provisioning may fail or be slower than with ReadWriteOnce.
+func storageAccessModeWarning(instance *llamav1alpha1.LlamaStackDistribution) string {
+	storage := instance.Spec.Server.Storage
+	if _, maxReplicas := replicaRange(instance); storage == nil || maxReplicas != 1 {
+		return ""
+	}
+	if slices.Contains(storage.AccessModes, corev1.ReadWriteMany) {
+		return "ReadWriteMany storage requested for a single replica, ReadWriteOnce is sufficient"
+	}
+	return ""
+}
+
+// runtimeClassWarning returns a warning if a RuntimeClass is set but the server container requests no GPU, or an
+// empty string otherwise. GPU RuntimeClasses usually only expose the devices allocated to the container.
+func runtimeClassWarning(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.Server.RuntimeClassName == nil {
+		return ""
+	}
+	resources := instance.Spec.Server.ContainerSpec.Resources
+	for _, list := range []corev1.ResourceList{resources.Requests, resources.Limits} {
+		for name := range list {
+			if isGPUResource(name) {
+				return ""
+			}
+		}
+	}
+	return "RuntimeClass set without GPU resources, the server container may not get any GPU device"
+}
+
+// privilegedPortWarning returns a warning if the server container listens on a privileged port below 1024, or an
+// empty string otherwise. Binding such a port requires the NET_BIND_SERVICE capability or a root user on most
+// container runtimes.
+func privilegedPortWarning(instance *llamav1alpha1.LlamaStackDistribution) string {
+	ports := []int32{getContainerPort(instance)}
+	for _, port := range deploy.GetExtraPorts(instance) {
+		ports = append(ports, port.ContainerPort)
+	}
+	for _, port := range ports {
+		if port <= maxPrivilegedPort {
+			return fmt.Sprintf("Server container port %d is privileged, the server may fail to listen on it without the NET_BIND_SERVICE capability", port)
+		}
+	}
+	return ""
+}
+
+// isGPUResource reports whether the resource is a GPU exposed by a device plugin, such as nvidia.com/gpu,
+// amd.com/gpu or gpu.intel.com/i915.
+func isGPUResource(name corev1.ResourceName) bool {
+	domain, resource, found := strings.Cut(string(name), "/")
+	return found && (resource == "gpu" || strings.HasPrefix(domain, "gpu."))
+}
+
 // configureEmptyDirStorage sets up temporary storage using emptyDir.
 func configureEmptyDirStorage(podSpec *corev1.PodSpec) {
 	// Use emptyDir for non-persistent storage
 	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-		Name: "lls-storage",
+		Name: storageVolumeName,
 		VolumeSource: corev1.VolumeSource{
 			EmptyDir: &corev1.EmptyDirVolumeSource{},
 		},
@@ -424,8 +830,8 @@ func configureEmptyDirStorage(podSpec *corev1.PodSpec) {
 }
 
 // configureTLSCABundle handles TLS CA bundle configuration.
-// For multiple keys: adds a ca-bundle-init init container that concatenates all keys into a single file
-// in a shared emptyDir volume, which the main container then mounts via SubPath.
+// For multiple keys: mounts the derived ConfigMap into which the operator concatenated all keys,
+// see reconcileCABundle.
 // For single key: uses a direct ConfigMap volume mount.
 // If no explicit CA bundle is configured, it checks for the well-known ODH trusted CA bundle ConfigMap.
 func configureTLSCABundle(ctx context.Context, r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
@@ -433,7 +839,7 @@ func configureTLSCABundle(ctx context.Context, r *LlamaStackDistributionReconcil
 
 	// Handle explicit CA bundle configuration first
 	if tlsConfig != nil && tlsConfig.CABundle != nil {
-		addExplicitCABundle(ctx, tlsConfig.CABundle, podSpec)
+		podSpec.Volumes = append(podSpec.Volumes, createCABundleVolume(instance, tlsConfig.CABundle))
 		return
 	}
 
@@ -443,38 +849,6 @@ func configureTLSCABundle(ctx context.Context, r *LlamaStackDistributionReconcil
 	}
 }
 
-// addExplicitCABundle handles explicitly configured CA bundles.
-func addExplicitCABundle(ctx context.Context, caBundleConfig *llamav1alpha1.CABundleConfig, podSpec *corev1.PodSpec) {
-	// Add CA bundle InitContainer if multiple keys are specified
-	if len(caBundleConfig.ConfigMapKeys) > 0 {
-		caBundleInitContainer, err := createCABundleInitContainer(caBundleConfig)
-		if err != nil {
-			log.FromContext(ctx).Error(err, "Failed to create CA bundle init container")
-			return
-		}
-		podSpec.InitContainers = append(podSpec.InitContainers, caBundleInitContainer)
-	}
-
-	// Add CA bundle ConfigMap volume
-	volume := createCABundleVolume(caBundleConfig)
-	podSpec.Volumes = append(podSpec.Volumes, volume)
-
-	// Add source ConfigMap volume for multiple keys scenario
-	if len(caBundleConfig.ConfigMapKeys) > 0 {
-		sourceVolume := corev1.Volume{
-			Name: CABundleSourceVolName,
-			VolumeSource: corev1.VolumeSource{
-				ConfigMap: &corev1.ConfigMapVolumeSource{
-					LocalObjectReference: corev1.LocalObjectReference{
-						Name: caBundleConfig.ConfigMapName,
-					},
-				},
-			},
-		}
-		podSpec.Volumes = append(podSpec.Volumes, sourceVolume)
-	}
-}
-
 // addAutoDetectedCABundle handles auto-detection of ODH trusted CA bundle ConfigMap.
 func addAutoDetectedCABundle(ctx context.Context, r *LlamaStackDistributionReconciler, instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
 	if r == nil {
@@ -493,37 +867,12 @@ func addAutoDetectedCABundle(ctx context.Context, r *LlamaStackDistributionRecon
 		return
 	}
 
-	// Create a virtual CA bundle config for auto-detected ConfigMap
+	// Create a virtual CA bundle config for auto-detected ConfigMap, its keys are concatenated like explicit keys
 	autoCaBundleConfig := &llamav1alpha1.CABundleConfig{
 		ConfigMapName: configMap.Name,
 		ConfigMapKeys: keys, // Use all available keys
 	}
-
-	// Use the same logic as explicit configuration
-	caBundleInitContainer, err := createCABundleInitContainer(autoCaBundleConfig)
-	if err != nil {
-		// Log error and skip auto-detected CA bundle configuration
-		log.FromContext(ctx).Error(err, "Failed to create CA bundle init container for auto-detected ConfigMap")
-		return
-	}
-	podSpec.InitContainers = append(podSpec.InitContainers, caBundleInitContainer)
-
-	// Add CA bundle emptyDir volume for auto-detected ConfigMap
-	volume := createCABundleVolume(autoCaBundleConfig)
-	podSpec.Volumes = append(podSpec.Volumes, volume)
-
-	// Add source ConfigMap volume for auto-detected ConfigMap
-	sourceVolume := corev1.Volume{
-		Name: CABundleSourceVolName,
-		VolumeSource: corev1.VolumeSource{
-			ConfigMap: &corev1.ConfigMapVolumeSource{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: configMap.Name,
-				},
-			},
-		},
-	}
-	podSpec.Volumes = append(podSpec.Volumes, sourceVolume)
+	podSpec.Volumes = append(podSpec.Volumes, createCABundleVolume(instance, autoCaBundleConfig))
 
 	log.FromContext(ctx).Info("Auto-configured ODH trusted CA bundle",
 		"configMapName", configMap.Name,
@@ -532,31 +881,89 @@ func addAutoDetectedCABundle(ctx context.Context, r *LlamaStackDistributionRecon
 
 // configureUserConfig handles user configuration setup.
 func configureUserConfig(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
-	userConfig := instance.Spec.Server.UserConfig
-	if userConfig == nil || userConfig.ConfigMapName == "" {
+	userConfig := instance.RunUserConfig()
+	if userConfig == nil {
 		return
 	}
 
 	// Add ConfigMap volume if user config is specified
-	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-		Name: "user-config",
-		VolumeSource: corev1.VolumeSource{
-			ConfigMap: &corev1.ConfigMapVolumeSource{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: userConfig.ConfigMapName,
-				},
-			},
+	source := &corev1.ConfigMapVolumeSource{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: userConfig.ConfigMapName,
 		},
-	})
+	}
+	// A custom key is projected as run.yaml, where the server reads its configuration
+	if key := getUserConfigKey(instance); key != userConfigRunYAMLKey {
+		source.Items = []corev1.KeyToPath{{Key: key, Path: userConfigRunYAMLKey}}
+	}
+
+	additionalConfigMaps := getAdditionalConfigMapNames(instance)
+	if len(additionalConfigMaps) == 0 {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         "user-config",
+			VolumeSource: corev1.VolumeSource{ConfigMap: source},
+		})
+	} else {
+		// The additional ConfigMaps are projected into the same directory as the run configuration
+		sources := []corev1.VolumeProjection{{
+			ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: source.LocalObjectReference, Items: source.Items},
+		}}
+		for _, name := range additionalConfigMaps {
+			sources = append(sources, corev1.VolumeProjection{
+				ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: name}},
+			})
+		}
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         "user-config",
+			VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: sources}},
+		})
+	}
+
+	// Each other ConfigMap of UserConfigs gets its own volume, mounted at its own path
+	for i, mounted := range instance.MountedUserConfigs() {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: mountedUserConfigVolumeName(i),
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: mounted.ConfigMapName},
+			}},
+		})
+	}
+}
+
+// getAdditionalConfigMapNames returns the names of the additional ConfigMaps of the user config, without duplicates.
+func getAdditionalConfigMapNames(instance *llamav1alpha1.LlamaStackDistribution) []string {
+	userConfig := instance.RunUserConfig()
+	if userConfig == nil {
+		return nil
+	}
+	var names []string
+	for _, name := range userConfig.AdditionalConfigMaps {
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// getImagePullSecrets returns the image pull Secrets of the server pods, those of the pod overrides taking
+// precedence over those of the server spec.
+func getImagePullSecrets(instance *llamav1alpha1.LlamaStackDistribution) []corev1.LocalObjectReference {
+	if overrides := instance.Spec.Server.PodOverrides; overrides != nil && len(overrides.ImagePullSecrets) > 0 {
+		return overrides.ImagePullSecrets
+	}
+	return instance.Spec.Server.ImagePullSecrets
 }
 
 // configurePodOverrides applies pod-level overrides from the LlamaStackDistribution spec.
 func configurePodOverrides(instance *llamav1alpha1.LlamaStackDistribution, podSpec *corev1.PodSpec) {
 	// Set ServiceAccount name - use override if specified, otherwise use default
-	if instance.Spec.Server.PodOverrides != nil && instance.Spec.Server.PodOverrides.ServiceAccountName != "" {
-		podSpec.ServiceAccountName = instance.Spec.Server.PodOverrides.ServiceAccountName
-	} else {
-		podSpec.ServiceAccountName = instance.Name + "-sa"
+	podSpec.ServiceAccountName = getServiceAccountName(instance)
+
+	// Pull the images with the Secrets of the spec
+	podSpec.ImagePullSecrets = getImagePullSecrets(instance)
+
+	if securityContext := instance.Spec.Server.ContainerSpec.ContainerSecurityContext; securityContext != nil && len(podSpec.Containers) > 0 {
+		podSpec.Containers[0].SecurityContext = securityContext.DeepCopy()
 	}
 
 	// Apply other pod overrides if specified
@@ -572,7 +979,548 @@ func configurePodOverrides(instance *llamav1alpha1.LlamaStackDistribution, podSp
 				podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, instance.Spec.Server.PodOverrides.VolumeMounts...)
 			}
 		}
+
+		if instance.Spec.Server.PodOverrides.PodSecurityContext != nil {
+			podSpec.SecurityContext = instance.Spec.Server.PodOverrides.PodSecurityContext.DeepCopy()
+		}
+
+		// Add the sidecars after the server container, which stays the first one
+		for _, sidecar := range instance.Spec.Server.PodOverrides.Sidecars {
+			podSpec.Containers = append(podSpec.Containers, *sidecar.DeepCopy())
+		}
+	}
+}
+
+// configureSecurityContextDefaults sets a restricted security context on the server container when the spec does
+// not set one. The defaults are not set on the pod, so that the init container fixing the permissions of the
+// storage volume can still run as root.
+func configureSecurityContextDefaults(podSpec *corev1.PodSpec) {
+	if len(podSpec.Containers) == 0 || podSpec.Containers[0].SecurityContext != nil {
+		return
+	}
+	podSpec.Containers[0].SecurityContext = &corev1.SecurityContext{
+		RunAsNonRoot:             ptr.To(true),
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+}
+
+// configureTmpVolume mounts an emptyDir on /tmp of the server container if its root filesystem is read-only, so that
+// the server and the libraries it uses can still write temporary files. A volume mounted on /tmp by the spec is kept.
+func configureTmpVolume(podSpec *corev1.PodSpec) {
+	if len(podSpec.Containers) == 0 {
+		return
+	}
+	container := &podSpec.Containers[0]
+	if container.SecurityContext == nil || !ptr.Deref(container.SecurityContext.ReadOnlyRootFilesystem, false) {
+		return
+	}
+	for _, mount := range container.VolumeMounts {
+		if path.Clean(mount.MountPath) == "/tmp" {
+			return
+		}
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         tmpVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: tmpVolumeName, MountPath: "/tmp"})
+}
+
+// getServiceAccountName returns the ServiceAccount of the server pods, using the override if specified.
+func getServiceAccountName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.Server.PodOverrides != nil && instance.Spec.Server.PodOverrides.ServiceAccountName != "" {
+		return instance.Spec.Server.PodOverrides.ServiceAccountName
+	}
+	return deploy.GetServiceAccountName(instance)
+}
+
+// buildServiceAccount creates the ServiceAccount of the server pods. It keeps the labels and the annotation of the
+// ServiceAccount previously rendered from the manifests.
+func buildServiceAccount(instance *llamav1alpha1.LlamaStackDistribution) *corev1.ServiceAccount {
+	annotations := map[string]string{}
+	if storage := instance.Spec.Server.Storage; storage != nil && storage.PermissionsInitContainer {
+		// Used by OpenShift to assign the anyuid SCC, which allows the init container to run as root
+		annotations["openshift.io/scc"] = "anyuid"
+	}
+	if instance.Spec.Server.PodOverrides != nil {
+		maps.Copy(annotations, instance.Spec.Server.PodOverrides.ServiceAccountAnnotations)
+	}
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploy.GetServiceAccountName(instance),
+			Namespace: instance.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   instance.Name,
+				"app.kubernetes.io/managed-by": "llama-stack-operator",
+				"app.kubernetes.io/part-of":    "llama-stack",
+			},
+			Annotations: annotations,
+		},
+	}
+}
+
+// buildMaintenanceCronJob creates the CronJob running a maintenance job against the server storage volume.
+// The pods are not labeled as server pods so they are not selected by the Service or the NetworkPolicy.
+func buildMaintenanceCronJob(instance *llamav1alpha1.LlamaStackDistribution, job llamav1alpha1.MaintenanceJobSpec,
+	serverImage, utilityImage string) *batchv1.CronJob {
+	image := job.Image
+	if image == "" {
+		image = serverImage
+	}
+
+	container := corev1.Container{
+		Name:    "maintenance",
+		Image:   image,
+		Command: job.Command,
+		Args:    job.Args,
+		// Point the Hugging Face cache to the storage volume like the server does
+		Env: []corev1.EnvVar{{Name: "HF_HOME", Value: getMountPath(instance)}},
+	}
+	addStorageVolumeMount(instance, &container)
+
+	podSpec := corev1.PodSpec{
+		Containers:         []corev1.Container{container},
+		RestartPolicy:      corev1.RestartPolicyOnFailure,
+		ServiceAccountName: getServiceAccountName(instance),
+	}
+	configureStorage(instance, &podSpec, utilityImage)
+
+	labels := deploy.GetCommonLabels(instance, map[string]string{
+		"app.kubernetes.io/instance":  instance.Name,
+		"app.kubernetes.io/component": "maintenance",
+		deploy.MaintenanceJobLabel:    job.Name,
+	})
+
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploy.GetMaintenanceCronJobName(instance, job.Name),
+			Namespace: instance.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: job.Schedule,
+			Suspend:  ptr.To(job.Suspend),
+			// Runs share the storage volume, so a run never overlaps with the previous one
+			ConcurrencyPolicy: batchv1.ForbidConcurrent,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: labels},
+						Spec:       podSpec,
+					},
+				},
+			},
+		},
+	}
+}
+
+// getMaintenanceJobStatus reports the last run of a maintenance job from the status of its CronJob.
+func getMaintenanceJobStatus(jobName string, cronJob *batchv1.CronJob) llamav1alpha1.MaintenanceJobStatus {
+	status := llamav1alpha1.MaintenanceJobStatus{
+		Name:               jobName,
+		LastScheduleTime:   cronJob.Status.LastScheduleTime,
+		LastSuccessfulTime: cronJob.Status.LastSuccessfulTime,
+	}
+	switch {
+	case len(cronJob.Status.Active) > 0:
+		status.LastJobStatus = llamav1alpha1.MaintenanceJobStateRunning
+	case status.LastScheduleTime == nil:
+		// The job never ran
+	case status.LastSuccessfulTime != nil && !status.LastSuccessfulTime.Before(status.LastScheduleTime):
+		status.LastJobStatus = llamav1alpha1.MaintenanceJobStateSucceeded
+	default:
+		status.LastJobStatus = llamav1alpha1.MaintenanceJobStateFailed
+	}
+	return status
+}
+
+// buildIngress builds the Ingress routing external traffic to the server Service.
+func buildIngress(instance *llamav1alpha1.LlamaStackDistribution) *networkingv1.Ingress {
+	spec := instance.Spec.Server.Ingress
+	path := spec.Path
+	if path == "" {
+		path = llamav1alpha1.DefaultIngressPath
+	}
+
+	rule := networkingv1.IngressRule{
+		Host: spec.Host,
+		IngressRuleValue: networkingv1.IngressRuleValue{
+			HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{
+					{
+						Path:     path,
+						PathType: ptr.To(networkingv1.PathTypePrefix),
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: deploy.GetServiceName(instance),
+								Port: networkingv1.ServiceBackendPort{Number: deploy.GetServicePort(instance)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        deploy.GetIngressName(instance),
+			Namespace:   instance.Namespace,
+			Labels:      map[string]string{"app.kubernetes.io/instance": instance.Name},
+			Annotations: spec.Annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: spec.IngressClassName,
+			Rules:            []networkingv1.IngressRule{rule},
+		},
+	}
+
+	if spec.TLSSecretName != "" {
+		tls := networkingv1.IngressTLS{SecretName: spec.TLSSecretName}
+		if spec.Host != "" {
+			tls.Hosts = []string{spec.Host}
+		}
+		ingress.Spec.TLS = []networkingv1.IngressTLS{tls}
+	}
+	return ingress
+}
+
+// isIngressAdmitted reports whether an ingress controller published an address for the Ingress.
+func isIngressAdmitted(ingress *networkingv1.Ingress) bool {
+	return len(ingress.Status.LoadBalancer.Ingress) > 0
+}
+
+// getIngressURL returns the external URL of the server exposed through the Ingress, or an empty string
+// if it is not known yet. Without a host the URL uses the address published by the ingress controller.
+func getIngressURL(instance *llamav1alpha1.LlamaStackDistribution, ingress *networkingv1.Ingress) string {
+	spec := instance.Spec.Server.Ingress
+	host := spec.Host
+	if host == "" && isIngressAdmitted(ingress) {
+		address := ingress.Status.LoadBalancer.Ingress[0]
+		host = address.Hostname
+		if host == "" {
+			host = address.IP
+		}
+	}
+	if host == "" {
+		return ""
+	}
+
+	scheme := "http"
+	if spec.TLSSecretName != "" {
+		scheme = "https"
+	}
+	return (&url.URL{Scheme: scheme, Host: host, Path: spec.Path}).String()
+}
+
+// replicaRange returns the lower and upper limit of the number of server replicas. Without autoscaling
+// both are the replicas of the spec.
+func replicaRange(instance *llamav1alpha1.LlamaStackDistribution) (int32, int32) {
+	autoscaling := instance.Spec.Autoscaling
+	if autoscaling == nil {
+		return instance.Spec.Replicas, instance.Spec.Replicas
+	}
+	minReplicas := int32(1)
+	if autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+	}
+	return minReplicas, autoscaling.MaxReplicas
+}
+
+// buildHorizontalPodAutoscaler builds the HorizontalPodAutoscaler scaling the server Deployment.
+func buildHorizontalPodAutoscaler(instance *llamav1alpha1.LlamaStackDistribution) *autoscalingv2.HorizontalPodAutoscaler {
+	spec := instance.Spec.Autoscaling
+	minReplicas, maxReplicas := replicaRange(instance)
+
+	var metrics []autoscalingv2.MetricSpec
+	if spec.TargetCPUUtilizationPercentage != nil {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceCPU, *spec.TargetCPUUtilizationPercentage))
+	}
+	if spec.TargetMemoryUtilizationPercentage != nil {
+		metrics = append(metrics, resourceUtilizationMetric(corev1.ResourceMemory, *spec.TargetMemoryUtilizationPercentage))
+	}
+	for _, metric := range spec.Metrics {
+		metrics = append(metrics, *metric.DeepCopy())
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploy.GetHorizontalPodAutoscalerName(instance),
+			Namespace: instance.Namespace,
+			Labels:    map[string]string{"app.kubernetes.io/instance": instance.Name},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       instance.Name,
+			},
+			MinReplicas: ptr.To(minReplicas),
+			MaxReplicas: maxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+// getDisruptionBudget returns the disruption budget of the server pods, or nil if they need none. Without an explicit
+// budget, one pod is kept available as soon as more than one replica runs. A single replica gets no default budget,
+// since keeping it available would block node drains entirely.
+func getDisruptionBudget(instance *llamav1alpha1.LlamaStackDistribution) *llamav1alpha1.PodDisruptionBudgetSpec {
+	if instance.Spec.DisruptionBudget != nil {
+		return instance.Spec.DisruptionBudget
+	}
+	if minReplicas, _ := replicaRange(instance); minReplicas > 1 {
+		return &llamav1alpha1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(1))}
+	}
+	return nil
+}
+
+// buildPodDisruptionBudget builds the PodDisruptionBudget of the server pods.
+func buildPodDisruptionBudget(instance *llamav1alpha1.LlamaStackDistribution, budget *llamav1alpha1.PodDisruptionBudgetSpec) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploy.GetPodDisruptionBudgetName(instance),
+			Namespace: instance.Namespace,
+			Labels:    map[string]string{"app.kubernetes.io/instance": instance.Name},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:       &metav1.LabelSelector{MatchLabels: deploy.GetSelectorLabels(instance)},
+			MinAvailable:   budget.MinAvailable,
+			MaxUnavailable: budget.MaxUnavailable,
+		},
+	}
+}
+
+// resourceUtilizationMetric returns a metric targeting the average utilization of a resource of the server pods.
+func resourceUtilizationMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(utilization),
+			},
+		},
+	}
+}
+
+// getRouteSpec returns the Route requested by the spec, or the Route an enabled Ingress is exposed through on
+// clusters serving the OpenShift Route API.
+func getRouteSpec(instance *llamav1alpha1.LlamaStackDistribution) *llamav1alpha1.RouteSpec {
+	if instance.IsRouteEnabled() {
+		return instance.Spec.Server.Route
+	}
+	ingress := instance.Spec.Server.Ingress
+	return &llamav1alpha1.RouteSpec{
+		Enabled:     ingress.Enabled,
+		Host:        ingress.Host,
+		Path:        ingress.Path,
+		Annotations: ingress.Annotations,
+		EdgeTLS:     ingress.EdgeTLS,
+	}
+}
+
+// validateRoute validates that a requested Route can be created: the cluster must serve the OpenShift Route API,
+// and the Ingress, which is exposed through the same Route there, must not be enabled as well.
+func validateRoute(instance *llamav1alpha1.LlamaStackDistribution, routeAvailable bool) error {
+	if !instance.IsRouteEnabled() {
+		return nil
+	}
+	if !routeAvailable {
+		return errors.New("failed to validate route: the cluster does not serve the OpenShift route.openshift.io/v1 API, " +
+			"use spec.server.ingress to expose the server instead")
+	}
+	if instance.IsIngressEnabled() {
+		return errors.New("failed to validate route: spec.server.route and spec.server.ingress cannot both be enabled")
 	}
+	return nil
+}
+
+// buildRoute builds the OpenShift Route routing external traffic to the server Service.
+func buildRoute(instance *llamav1alpha1.LlamaStackDistribution) *unstructured.Unstructured {
+	spec := getRouteSpec(instance)
+
+	routeSpec := map[string]any{
+		"to": map[string]any{
+			"kind":   "Service",
+			"name":   deploy.GetServiceName(instance),
+			"weight": int64(100),
+		},
+		"port": map[string]any{
+			"targetPort": "http",
+		},
+	}
+	if spec.Host != "" {
+		routeSpec["host"] = spec.Host
+	}
+	if spec.Path != "" {
+		routeSpec["path"] = spec.Path
+	}
+	if spec.EdgeTLS {
+		routeSpec["tls"] = map[string]any{
+			"termination":                   "edge",
+			"insecureEdgeTerminationPolicy": "Redirect",
+		}
+	}
+
+	route := deploy.NewRoute()
+	route.SetName(deploy.GetRouteName(instance))
+	route.SetNamespace(instance.Namespace)
+	route.SetLabels(map[string]string{"app.kubernetes.io/instance": instance.Name})
+	route.SetAnnotations(spec.Annotations)
+	route.Object["spec"] = routeSpec
+	return route
+}
+
+// buildServiceMonitor returns the ServiceMonitor scraping the server metrics through the Service of the instance.
+func buildServiceMonitor(instance *llamav1alpha1.LlamaStackDistribution) *unstructured.Unstructured {
+	serviceMonitor := deploy.NewServiceMonitor()
+	serviceMonitor.SetName(deploy.GetServiceMonitorName(instance))
+	serviceMonitor.SetNamespace(instance.Namespace)
+	serviceMonitor.SetLabels(map[string]string{"app.kubernetes.io/instance": instance.Name})
+	serviceMonitor.Object["spec"] = map[string]any{
+		"selector": map[string]any{
+			"matchLabels": map[string]any{
+				"app.kubernetes.io/instance":   instance.Name,
+				"app.kubernetes.io/managed-by": "llama-stack-operator",
+			},
+		},
+		"endpoints": []any{
+			map[string]any{
+				"port": deploy.GetMetricsServicePortName(instance),
+				"path": deploy.GetMetricsPath(instance),
+			},
+		},
+	}
+	return serviceMonitor
+}
+
+// buildIngressRules returns the ingress rules of the NetworkPolicy. The llama-stack clients of all namespaces reach the
+// server and extra ports, while the pods of the operator namespace also reach a health endpoint served on a separate port.
+func buildIngressRules(instance *llamav1alpha1.LlamaStackDistribution, operatorNamespace string) []networkingv1.NetworkPolicyIngressRule {
+	serverPorts := []networkingv1.NetworkPolicyPort{newNetworkPolicyPort(corev1.ProtocolTCP, deploy.GetServicePort(instance))}
+	for _, extraPort := range deploy.GetExtraPorts(instance) {
+		if !hasNetworkPolicyPort(serverPorts, extraPort.Protocol, extraPort.ContainerPort) {
+			serverPorts = append(serverPorts, newNetworkPolicyPort(extraPort.Protocol, extraPort.ContainerPort))
+		}
+	}
+
+	operatorPorts := slices.Clone(serverPorts)
+	if healthPort := deploy.GetHealthCheckPort(instance); !hasNetworkPolicyPort(operatorPorts, corev1.ProtocolTCP, healthPort) {
+		operatorPorts = append(operatorPorts, newNetworkPolicyPort(corev1.ProtocolTCP, healthPort))
+	}
+
+	clientsRule := networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{
+			{ // to match all pods in all namespaces
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"app.kubernetes.io/part-of": llamav1alpha1.DefaultContainerName,
+					},
+				},
+				NamespaceSelector: &metav1.LabelSelector{}, // Empty namespaceSelector to match all namespaces
+			},
+		},
+		Ports: serverPorts,
+	}
+	operatorRule := networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{
+			{ // to match all pods in matched namespace
+				PodSelector: &metav1.LabelSelector{},
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"kubernetes.io/metadata.name": operatorNamespace,
+					},
+				},
+			},
+		},
+		Ports: operatorPorts,
+	}
+	return []networkingv1.NetworkPolicyIngressRule{clientsRule, operatorRule}
+}
+
+// newNetworkPolicyPort returns a NetworkPolicy port allowing the given port number.
+func newNetworkPolicyPort(protocol corev1.Protocol, port int32) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(protocol),
+		Port:     ptr.To(intstr.FromInt32(port)),
+	}
+}
+
+// buildEgressRules returns the egress rules of the NetworkPolicy, or nil when outbound traffic is not restricted.
+// DNS lookups are always allowed, otherwise the server could not resolve the destinations of the rules.
+func buildEgressRules(instance *llamav1alpha1.LlamaStackDistribution) []networkingv1.NetworkPolicyEgressRule {
+	if instance.Spec.Server.NetworkPolicy == nil || len(instance.Spec.Server.NetworkPolicy.EgressRules) == 0 {
+		return nil
+	}
+
+	dnsPort := intstr.FromInt32(53)
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: ptr.To(corev1.ProtocolUDP), Port: &dnsPort},
+				{Protocol: ptr.To(corev1.ProtocolTCP), Port: &dnsPort},
+			},
+		},
+	}
+	for _, spec := range instance.Spec.Server.NetworkPolicy.EgressRules {
+		rule := networkingv1.NetworkPolicyEgressRule{}
+		if spec.Namespace != "" || len(spec.PodSelector) > 0 {
+			peer := networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: spec.PodSelector}}
+			if spec.Namespace != "" {
+				peer.NamespaceSelector = &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": spec.Namespace},
+				}
+			}
+			rule.To = []networkingv1.NetworkPolicyPeer{peer}
+		}
+		if spec.Port != 0 {
+			protocol := spec.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+			port := intstr.FromInt32(spec.Port)
+			rule.Ports = []networkingv1.NetworkPolicyPort{{Protocol: &protocol, Port: &port}}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// getRouteHost returns the host admitted by the router for the Route, or an empty string if it is not admitted yet.
+func getRouteHost(route *unstructured.Unstructured) string {
+	ingresses, _, _ := unstructured.NestedSlice(route.Object, "status", "ingress")
+	for _, item := range ingresses {
+		routeIngress, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditions, _, _ := unstructured.NestedSlice(routeIngress, "conditions")
+		for _, c := range conditions {
+			condition, ok := c.(map[string]any)
+			if ok && condition["type"] == "Admitted" && condition["status"] == string(corev1.ConditionTrue) {
+				host, _, _ := unstructured.NestedString(routeIngress, "host")
+				return host
+			}
+		}
+	}
+	return ""
+}
+
+// getRouteURL returns the external URL of the server exposed through an admitted Route.
+func getRouteURL(instance *llamav1alpha1.LlamaStackDistribution, host string) string {
+	if host == "" {
+		return ""
+	}
+	spec := getRouteSpec(instance)
+	scheme := "http"
+	if spec.EdgeTLS {
+		scheme = "https"
+	}
+	return (&url.URL{Scheme: scheme, Host: host, Path: spec.Path}).String()
 }
 
 // validateDistribution validates the distribution configuration.
@@ -582,7 +1530,7 @@ func (r *LlamaStackDistributionReconciler) validateDistribution(instance *llamav
 		if r.ClusterInfo == nil {
 			return errors.New("failed to initialize cluster info")
 		}
-		if _, exists := r.ClusterInfo.DistributionImages[instance.Spec.Server.Distribution.Name]; !exists {
+		if _, exists := r.ClusterInfo.Distributions()[instance.Spec.Server.Distribution.Name]; !exists {
 			return fmt.Errorf("failed to validate distribution: %s. Distribution name not supported", instance.Spec.Server.Distribution.Name)
 		}
 	}
@@ -590,10 +1538,169 @@ func (r *LlamaStackDistributionReconciler) validateDistribution(instance *llamav
 	return nil
 }
 
+// validateQueue validates that Kueue is available when a queue is requested.
+func (r *LlamaStackDistributionReconciler) validateQueue(instance *llamav1alpha1.LlamaStackDistribution) error {
+	if instance.Spec.Server.Queue == nil {
+		return nil
+	}
+	if r.ClusterInfo == nil || !r.ClusterInfo.KueueAvailable {
+		return fmt.Errorf("failed to validate queue %q: Kueue is not installed in the cluster", instance.Spec.Server.Queue.Name)
+	}
+	return nil
+}
+
+// validateResources validates that the resource requests of the server container do not exceed its limits.
+// Extended resources such as GPUs cannot be overcommitted, so their requests must equal their limits.
+func validateResources(instance *llamav1alpha1.LlamaStackDistribution) error {
+	resources := instance.Spec.Server.ContainerSpec.Resources
+
+	names := make([]string, 0, len(resources.Requests))
+	for name := range resources.Requests {
+		names = append(names, string(name))
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		request := resources.Requests[corev1.ResourceName(name)]
+		limit, hasLimit := resources.Limits[corev1.ResourceName(name)]
+		if isExtendedResourceName(name) {
+			if !hasLimit {
+				return fmt.Errorf("failed to validate resources: %s request %s requires a limit of the same value", name, request.String())
+			}
+			if request.Cmp(limit) != 0 {
+				return fmt.Errorf("failed to validate resources: %s request %s must equal its limit %s", name, request.String(), limit.String())
+			}
+			continue
+		}
+		if hasLimit && request.Cmp(limit) > 0 {
+			return fmt.Errorf("failed to validate resources: %s request %s exceeds its limit %s", name, request.String(), limit.String())
+		}
+	}
+	return nil
+}
+
+// validateSidecars validates that the sidecar containers have unique names, different from the server container.
+func validateSidecars(instance *llamav1alpha1.LlamaStackDistribution) error {
+	if instance.Spec.Server.PodOverrides == nil {
+		return nil
+	}
+	names := []string{getContainerName(instance)}
+	for _, sidecar := range instance.Spec.Server.PodOverrides.Sidecars {
+		if slices.Contains(names, sidecar.Name) {
+			return fmt.Errorf("failed to validate sidecars: container name %q is already used", sidecar.Name)
+		}
+		names = append(names, sidecar.Name)
+	}
+	return nil
+}
+
+// validateUserConfigs validates that every entry of UserConfigs names a ConfigMap, and that the ConfigMaps mounted
+// at their own path set a mount path not used by another user config, since the pods cannot start otherwise.
+func validateUserConfigs(instance *llamav1alpha1.LlamaStackDistribution) error {
+	for i, userConfig := range instance.Spec.Server.UserConfigs {
+		if userConfig.ConfigMapName == "" {
+			return fmt.Errorf("failed to validate userConfigs: entry %d does not set configMapName", i)
+		}
+	}
+	if instance.RunUserConfig() == nil {
+		return nil
+	}
+	mountPaths := []string{path.Clean(getUserConfigMountPath(instance))}
+	for _, userConfig := range instance.MountedUserConfigs() {
+		if userConfig.MountPath == "" {
+			return fmt.Errorf("failed to validate userConfigs: ConfigMap %s does not set mountPath", userConfig.ConfigMapName)
+		}
+		if userConfig.ConfigMapKey != "" || len(userConfig.AdditionalConfigMaps) > 0 {
+			return fmt.Errorf("failed to validate userConfigs: ConfigMap %s is not the run configuration, "+
+				"configMapKey and additionalConfigMaps are not supported", userConfig.ConfigMapName)
+		}
+		mountPath := path.Clean(userConfig.MountPath)
+		if slices.Contains(mountPaths, mountPath) {
+			return fmt.Errorf("failed to validate userConfigs: mount path %s is already used", mountPath)
+		}
+		mountPaths = append(mountPaths, mountPath)
+	}
+	return nil
+}
+
+// validatePorts validates that the server port and the extra ports of the server container are in the valid range,
+// that the extra ports do not collide with the server port or with each other, and that their names are not used
+// by the operator for the Service ports. The server port is validated by the CRD as well, but not for the
+// distributions created before the validation was added.
+func validatePorts(instance *llamav1alpha1.LlamaStackDistribution) error {
+	serverPort := getContainerPort(instance)
+	if serverPort < 1 || serverPort > maxPort {
+		return fmt.Errorf("failed to validate ports: server port %d is out of range 1-%d", serverPort, maxPort)
+	}
+	names := slices.Clone(deploy.ReservedServicePortNames)
+	seen := map[string]bool{fmt.Sprintf("%d/%s", serverPort, corev1.ProtocolTCP): true}
+	for _, port := range deploy.GetExtraPorts(instance) {
+		if port.ContainerPort < 1 || port.ContainerPort > maxPort {
+			return fmt.Errorf("failed to validate ports: extra port %d is out of range 1-%d", port.ContainerPort, maxPort)
+		}
+		if slices.Contains(names, port.Name) {
+			return fmt.Errorf("failed to validate ports: port name %q is already used", port.Name)
+		}
+		key := fmt.Sprintf("%d/%s", port.ContainerPort, port.Protocol)
+		if seen[key] {
+			return fmt.Errorf("failed to validate ports: port %s is already declared", key)
+		}
+		names = append(names, port.Name)
+		seen[key] = true
+	}
+	return nil
+}
+
+// hasNetworkPolicyPort reports whether the ports of a NetworkPolicy rule already allow the given port.
+func hasNetworkPolicyPort(ports []networkingv1.NetworkPolicyPort, protocol corev1.Protocol, port int32) bool {
+	for _, existing := range ports {
+		if existing.Protocol != nil && *existing.Protocol == protocol && existing.Port != nil && existing.Port.IntVal == port {
+			return true
+		}
+	}
+	return false
+}
+
+// isExtendedResourceName reports whether the resource is an extended resource, e.g. nvidia.com/gpu.
+// Native resources have no domain or belong to the kubernetes.io domain.
+func isExtendedResourceName(name string) bool {
+	return strings.Contains(name, "/") && !strings.HasPrefix(name, "kubernetes.io/") && !strings.HasPrefix(name, "requests.")
+}
+
+// configurePodQueue labels the pod template for the requested Kueue queue and adds the admission gate if requested.
+func configurePodQueue(instance *llamav1alpha1.LlamaStackDistribution, template *corev1.PodTemplateSpec) {
+	queue := instance.Spec.Server.Queue
+	if queue == nil {
+		return
+	}
+
+	if template.Labels == nil {
+		template.Labels = make(map[string]string)
+	}
+	template.Labels[kueueQueueNameLabel] = queue.Name
+
+	if queue.SchedulingGate {
+		template.Spec.SchedulingGates = append(template.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: kueueAdmissionGate})
+	}
+}
+
+// isPodQueued reports whether the pod is held by a scheduling gate.
+func isPodQueued(pod *corev1.Pod) bool {
+	if len(pod.Spec.SchedulingGates) > 0 {
+		return true
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Reason == corev1.PodReasonSchedulingGated {
+			return true
+		}
+	}
+	return false
+}
+
 // resolveImage determines the container image to use based on the distribution configuration.
 // It returns the resolved image and any error encountered.
 func (r *LlamaStackDistributionReconciler) resolveImage(distribution llamav1alpha1.DistributionType) (string, error) {
-	distributionMap := r.ClusterInfo.DistributionImages
+	distributionMap := r.ClusterInfo.Distributions()
 	switch {
 	case distribution.Name != "":
 		if _, exists := distributionMap[distribution.Name]; !exists {
@@ -17,18 +17,54 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/deploy"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/featureflags"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/statusexport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 func TestBuildContainerSpec(t *testing.T) {
@@ -53,6 +89,7 @@ func TestBuildContainerSpec(t *testing.T) {
 				Image:          "test-image:latest",
 				Ports:          []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
 				ReadinessProbe: newDefaultReadinessProbe(llamav1alpha1.DefaultServerPort),
+				LivenessProbe:  newDefaultLivenessProbe(llamav1alpha1.DefaultServerPort),
 				VolumeMounts: []corev1.VolumeMount{{
 					Name:      "lls-storage",
 					MountPath: llamav1alpha1.DefaultMountPath,
@@ -92,6 +129,7 @@ func TestBuildContainerSpec(t *testing.T) {
 				Image:          "test-image:latest",
 				Ports:          []corev1.ContainerPort{{ContainerPort: 9000}},
 				ReadinessProbe: newDefaultReadinessProbe(9000),
+				LivenessProbe:  newDefaultLivenessProbe(9000),
 				Resources: corev1.ResourceRequirements{
 					Limits: corev1.ResourceList{
 						corev1.ResourceCPU:    resource.MustParse("1"),
@@ -129,6 +167,129 @@ func TestBuildContainerSpec(t *testing.T) {
 				Args:           []string{"--config", "/etc/config.yaml", "--debug"},
 				Ports:          []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
 				ReadinessProbe: newDefaultReadinessProbe(llamav1alpha1.DefaultServerPort),
+				LivenessProbe:  newDefaultLivenessProbe(llamav1alpha1.DefaultServerPort),
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+				},
+			},
+		},
+		{
+			name: "health check overrides",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{},
+						HealthCheck: &llamav1alpha1.HealthCheckSpec{
+							Path:           "/healthz",
+							Port:           9090,
+							TimeoutSeconds: 2,
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Ports: []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
+				ReadinessProbe: func() *corev1.Probe {
+					probe := newDefaultReadinessProbe(9090)
+					probe.HTTPGet.Path = "/healthz"
+					probe.TimeoutSeconds = 2
+					return probe
+				}(),
+				LivenessProbe: func() *corev1.Probe {
+					probe := newDefaultLivenessProbe(9090)
+					probe.HTTPGet.Path = "/healthz"
+					probe.TimeoutSeconds = 2
+					return probe
+				}(),
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+				},
+			},
+		},
+		{
+			name: "user supplied probes",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							Probes: &llamav1alpha1.ProbesSpec{
+								Liveness: &corev1.Probe{
+									ProbeHandler: corev1.ProbeHandler{
+										TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(8321)},
+									},
+									PeriodSeconds: 5,
+								},
+								Startup: &corev1.Probe{
+									ProbeHandler: corev1.ProbeHandler{
+										HTTPGet: &corev1.HTTPGetAction{Path: "/v1/health", Port: intstr.FromInt(8321)},
+									},
+									PeriodSeconds:    10,
+									FailureThreshold: 60,
+								},
+							},
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:           llamav1alpha1.DefaultContainerName,
+				Image:          "test-image:latest",
+				Ports:          []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
+				ReadinessProbe: newDefaultReadinessProbe(llamav1alpha1.DefaultServerPort),
+				LivenessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(8321)},
+					},
+					PeriodSeconds: 5,
+				},
+				StartupProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						HTTPGet: &corev1.HTTPGetAction{Path: "/v1/health", Port: intstr.FromInt(8321)},
+					},
+					PeriodSeconds:    10,
+					FailureThreshold: 60,
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "lls-storage",
+					MountPath: llamav1alpha1.DefaultMountPath,
+				}},
+				Env: []corev1.EnvVar{
+					{Name: "HF_HOME", Value: "/.llama"},
+				},
+			},
+		},
+		{
+			name: "probes disabled",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{
+							Probes: &llamav1alpha1.ProbesSpec{
+								Disable:   true,
+								Readiness: newDefaultReadinessProbe(llamav1alpha1.DefaultServerPort),
+								Startup:   newDefaultLivenessProbe(llamav1alpha1.DefaultServerPort),
+							},
+						},
+					},
+				},
+			},
+			image: "test-image:latest",
+			expectedResult: corev1.Container{
+				Name:  llamav1alpha1.DefaultContainerName,
+				Image: "test-image:latest",
+				Ports: []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
 				VolumeMounts: []corev1.VolumeMount{{
 					Name:      "lls-storage",
 					MountPath: llamav1alpha1.DefaultMountPath,
@@ -160,6 +321,7 @@ func TestBuildContainerSpec(t *testing.T) {
 				ImagePullPolicy: corev1.PullAlways,
 				Ports:           []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}},
 				ReadinessProbe:  newDefaultReadinessProbe(llamav1alpha1.DefaultServerPort),
+				LivenessProbe:   newDefaultLivenessProbe(llamav1alpha1.DefaultServerPort),
 				Command:         []string{"python", "-m", "llama_stack.distribution.server.server"},
 				Args:            []string{"--config", "/etc/llama-stack/run.yaml"},
 				Env: []corev1.EnvVar{
@@ -192,10 +354,273 @@ func TestBuildContainerSpec(t *testing.T) {
 			assert.Equal(t, tc.expectedResult.Command, result.Command)
 			assert.Equal(t, tc.expectedResult.Args, result.Args)
 			assert.Equal(t, tc.expectedResult.ReadinessProbe, result.ReadinessProbe)
+			assert.Equal(t, tc.expectedResult.LivenessProbe, result.LivenessProbe)
+			assert.Equal(t, tc.expectedResult.StartupProbe, result.StartupProbe)
+		})
+	}
+}
+
+func TestGetImagePullPolicy(t *testing.T) {
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testCases := []struct {
+		name     string
+		image    string
+		policy   corev1.PullPolicy
+		expected corev1.PullPolicy
+	}{
+		{name: "mutable tag", image: "docker.io/llamastack/distribution-ollama:latest", expected: corev1.PullAlways},
+		{name: "no tag", image: "docker.io/llamastack/distribution-ollama", expected: corev1.PullAlways},
+		{name: "registry with a port", image: "registry.local:5000/llamastack/distribution-ollama:0.2.0", expected: corev1.PullAlways},
+		{name: "digest", image: "docker.io/llamastack/distribution-ollama@" + digest, expected: corev1.PullIfNotPresent},
+		{name: "tag and digest", image: "docker.io/llamastack/distribution-ollama:0.2.0@" + digest, expected: corev1.PullIfNotPresent},
+		{name: "explicit policy for a tag", image: "docker.io/llamastack/distribution-ollama:latest", policy: corev1.PullNever, expected: corev1.PullNever},
+		{name: "explicit policy for a digest", image: "docker.io/llamastack/distribution-ollama@" + digest, policy: corev1.PullAlways, expected: corev1.PullAlways},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{ImagePullPolicy: tc.policy},
+					},
+				},
+			}
+
+			assert.Equal(t, tc.expected, getImagePullPolicy(instance, tc.image))
+			assert.Equal(t, tc.expected, buildContainerSpec(t.Context(), nil, instance, tc.image).ImagePullPolicy)
+		})
+	}
+}
+
+func TestBuildContainerSpecExtraPorts(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				ContainerSpec: llamav1alpha1.ContainerSpec{
+					ExtraPorts: []corev1.ContainerPort{
+						{Name: "grpc", ContainerPort: 50051},
+						{ContainerPort: 4317},
+						{ContainerPort: 8125, Protocol: corev1.ProtocolUDP},
+					},
+				},
+			},
+		},
+	}
+
+	container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+
+	// The server port stays first, so that it keeps serving the API and the health checks
+	assert.Equal(t, []corev1.ContainerPort{
+		{ContainerPort: llamav1alpha1.DefaultServerPort},
+		{Name: "grpc", ContainerPort: 50051, Protocol: corev1.ProtocolTCP},
+		{Name: "port-4317", ContainerPort: 4317, Protocol: corev1.ProtocolTCP},
+		{Name: "port-8125-udp", ContainerPort: 8125, Protocol: corev1.ProtocolUDP},
+	}, container.Ports)
+	assert.Equal(t, newDefaultReadinessProbe(llamav1alpha1.DefaultServerPort), container.ReadinessProbe)
+}
+
+func TestValidatePorts(t *testing.T) {
+	testCases := []struct {
+		name          string
+		port          int32
+		extraPorts    []corev1.ContainerPort
+		expectedError string
+	}{
+		{name: "no extra ports"},
+		{name: "distinct ports", extraPorts: []corev1.ContainerPort{{Name: "grpc", ContainerPort: 50051}, {ContainerPort: 4317}}},
+		{name: "same number with another protocol", extraPorts: []corev1.ContainerPort{
+			{ContainerPort: 8125}, {ContainerPort: 8125, Protocol: corev1.ProtocolUDP},
+		}},
+		{
+			name:          "default server port",
+			extraPorts:    []corev1.ContainerPort{{Name: "api", ContainerPort: llamav1alpha1.DefaultServerPort}},
+			expectedError: "port 8321/TCP is already declared",
+		},
+		{
+			name:          "custom server port",
+			port:          9000,
+			extraPorts:    []corev1.ContainerPort{{ContainerPort: 9000}},
+			expectedError: "port 9000/TCP is already declared",
+		},
+		{name: "lowest port", port: 1},
+		{name: "highest port", port: 65535, extraPorts: []corev1.ContainerPort{{ContainerPort: 65534}}},
+		{name: "server port above range", port: 65536, expectedError: "server port 65536 is out of range 1-65535"},
+		{name: "negative server port", port: -1, expectedError: "server port -1 is out of range 1-65535"},
+		{
+			name:          "extra port above range",
+			extraPorts:    []corev1.ContainerPort{{ContainerPort: 70000}},
+			expectedError: "extra port 70000 is out of range 1-65535",
+		},
+		{
+			name:          "extra port zero",
+			extraPorts:    []corev1.ContainerPort{{Name: "grpc"}},
+			expectedError: "extra port 0 is out of range 1-65535",
+		},
+		{
+			name:          "reserved name",
+			extraPorts:    []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9090}},
+			expectedError: `port name "metrics" is already used`,
+		},
+		{
+			name:          "duplicate names",
+			extraPorts:    []corev1.ContainerPort{{Name: "grpc", ContainerPort: 50051}, {Name: "grpc", ContainerPort: 50052}},
+			expectedError: `port name "grpc" is already used`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{Port: tc.port, ExtraPorts: tc.extraPorts},
+					},
+				},
+			}
+			err := validatePorts(instance)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPrivilegedPortWarning(t *testing.T) {
+	testCases := []struct {
+		name          string
+		port          int32
+		extraPorts    []corev1.ContainerPort
+		expectWarning bool
+	}{
+		{name: "default port"},
+		{name: "unprivileged port", port: 1024},
+		{name: "privileged server port", port: 80, expectWarning: true},
+		{name: "privileged extra port", extraPorts: []corev1.ContainerPort{{ContainerPort: 443}}, expectWarning: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{Port: tc.port, ExtraPorts: tc.extraPorts},
+					},
+				},
+			}
+			if tc.expectWarning {
+				require.NotEmpty(t, privilegedPortWarning(instance))
+			} else {
+				require.Empty(t, privilegedPortWarning(instance))
+			}
+		})
+	}
+}
+
+func TestEnvOnlyDistributionUsesDefaultPort(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				ContainerSpec: llamav1alpha1.ContainerSpec{
+					Env: []corev1.EnvVar{{Name: "INFERENCE_MODEL", Value: "llama3.2:1b"}},
+				},
+			},
+		},
+	}
+
+	// The env vars alone trigger the Service, which must target the port the container declares
+	require.True(t, instance.HasPorts())
+	container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+	require.Equal(t, []corev1.ContainerPort{{ContainerPort: llamav1alpha1.DefaultServerPort}}, container.Ports)
+	require.Equal(t, llamav1alpha1.DefaultServerPort, deploy.GetServicePort(instance))
+	require.Equal(t, deploy.GetServicePort(instance), deploy.GetHealthCheckPort(instance))
+	require.NoError(t, validatePorts(instance))
+}
+
+func TestConfigureContainerEnvironmentIsDeterministic(t *testing.T) {
+	userEnv := []corev1.EnvVar{
+		{Name: "OLLAMA_URL", Value: "http://ollama:11434"},
+		{Name: "INFERENCE_MODEL", Value: "llama3.2:1b"},
+	}
+	tlsConfig := &llamav1alpha1.TLSConfig{
+		CABundle: &llamav1alpha1.CABundleConfig{ConfigMapName: "ca-bundle"},
+	}
+
+	testCases := []struct {
+		name      string
+		tlsConfig *llamav1alpha1.TLSConfig
+		env       []corev1.EnvVar
+	}{
+		{
+			name:      "operator adds SSL_CERT_FILE",
+			tlsConfig: tlsConfig,
+			env:       userEnv,
+		},
+		{
+			name: "user sets SSL_CERT_FILE",
+			env:  append([]corev1.EnvVar{{Name: "SSL_CERT_FILE", Value: CABundleMountPath}}, userEnv...),
+		},
+		{
+			name:      "operator and user set SSL_CERT_FILE and HF_HOME",
+			tlsConfig: tlsConfig,
+			env: append([]corev1.EnvVar{
+				{Name: "HF_HOME", Value: llamav1alpha1.DefaultMountPath},
+				{Name: "SSL_CERT_FILE", Value: CABundleMountPath},
+			}, userEnv...),
+		},
+	}
+
+	expected := []corev1.EnvVar{
+		{Name: "HF_HOME", Value: llamav1alpha1.DefaultMountPath},
+		{Name: "SSL_CERT_FILE", Value: CABundleMountPath},
+		{Name: "OLLAMA_URL", Value: "http://ollama:11434"},
+		{Name: "INFERENCE_MODEL", Value: "llama3.2:1b"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{Env: tc.env},
+						TLSConfig:     tc.tlsConfig,
+					},
+				},
+			}
+			container := corev1.Container{}
+			configureContainerEnvironment(t.Context(), nil, instance, &container)
+			assert.Equal(t, expected, container.Env)
 		})
 	}
 }
 
+func TestConfigureContainerEnvironmentEnvFrom(t *testing.T) {
+	envFrom := []corev1.EnvFromSource{
+		{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "provider-api-keys"}}},
+		{Prefix: "RUN_", ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "run-settings"}}},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				ContainerSpec: llamav1alpha1.ContainerSpec{EnvFrom: envFrom},
+				TLSConfig: &llamav1alpha1.TLSConfig{
+					CABundle: &llamav1alpha1.CABundleConfig{ConfigMapName: "ca-bundle"},
+				},
+			},
+		},
+	}
+
+	container := buildContainerSpec(t.Context(), nil, instance, "test-image")
+
+	assert.Equal(t, envFrom, container.EnvFrom)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "HF_HOME", Value: llamav1alpha1.DefaultMountPath},
+		{Name: "SSL_CERT_FILE", Value: CABundleMountPath},
+	}, container.Env, "the operator managed env vars should still be set")
+}
+
 func TestConfigurePodStorage(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -204,6 +629,7 @@ func TestConfigurePodStorage(t *testing.T) {
 		expectedPVCVolume bool
 		expectedEmptyDir  bool
 		expectedOverrides bool
+		expectedNodes     bool
 	}{
 		{
 			name: "with PVC storage",
@@ -270,43 +696,479 @@ func TestConfigurePodStorage(t *testing.T) {
 			expectedEmptyDir:  true,
 			expectedOverrides: true,
 		},
+		{
+			name: "with log shipper sidecar",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						PodOverrides: &llamav1alpha1.PodOverrides{
+							Volumes: []corev1.Volume{{
+								Name:         "logs",
+								VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+							}},
+							VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/var/log/llama-stack"}},
+							Sidecars: []corev1.Container{{
+								Name:         "log-shipper",
+								Image:        "fluent/fluent-bit:3.0",
+								VolumeMounts: []corev1.VolumeMount{{Name: "logs", MountPath: "/logs", ReadOnly: true}},
+							}},
+						},
+					},
+				},
+			},
+			container:         corev1.Container{Name: "test-container"},
+			expectedPVCVolume: false,
+			expectedEmptyDir:  true,
+			expectedOverrides: true,
+		},
+		{
+			name: "with GPU node scheduling",
+			instance: &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						NodeSelector: map[string]string{"accelerator": "nvidia-tesla-a100"},
+						Tolerations: []corev1.Toleration{
+							{
+								Key:      "nvidia.com/gpu",
+								Operator: corev1.TolerationOpEqual,
+								Value:    "present",
+								Effect:   corev1.TaintEffectNoSchedule,
+							},
+						},
+						Affinity: &corev1.Affinity{
+							NodeAffinity: &corev1.NodeAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+									NodeSelectorTerms: []corev1.NodeSelectorTerm{
+										{
+											MatchExpressions: []corev1.NodeSelectorRequirement{
+												{Key: "nvidia.com/gpu.count", Operator: corev1.NodeSelectorOpGt, Values: []string{"0"}},
+											},
+										},
+									},
+								},
+							},
+						},
+						TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+							MaxSkew:           1,
+							TopologyKey:       corev1.LabelTopologyZone,
+							WhenUnsatisfiable: corev1.ScheduleAnyway,
+						}},
+						RuntimeClassName: ptr.To("nvidia"),
+						SchedulerName:    "gpu-scheduler",
+					},
+				},
+			},
+			container:         corev1.Container{Name: "test-container"},
+			expectedPVCVolume: false,
+			expectedEmptyDir:  true,
+			expectedNodes:     true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			result := configurePodStorage(t.Context(), nil, tc.instance, tc.container)
 
-			// Verify container was added.
-			assert.Len(t, result.Containers, 1)
+			// Verify the server container was added first, followed by the sidecars.
+			var sidecars []corev1.Container
+			if tc.instance.Spec.Server.PodOverrides != nil {
+				sidecars = tc.instance.Spec.Server.PodOverrides.Sidecars
+			}
+			require.Len(t, result.Containers, 1+len(sidecars))
+			assert.Equal(t, tc.container.Name, result.Containers[0].Name)
+			for i, sidecar := range sidecars {
+				assert.Equal(t, sidecar, result.Containers[1+i])
+			}
 
 			verifyStorageVolumes(t, result, tc.instance, tc.expectedPVCVolume, tc.expectedEmptyDir)
 
 			if tc.expectedOverrides {
 				verifyPodOverrides(t, result, tc.instance)
 			}
+
+			if tc.expectedNodes {
+				assert.Equal(t, map[string]string{"accelerator": "nvidia-tesla-a100"}, result.NodeSelector)
+				assert.Equal(t, []corev1.Toleration{{
+					Key:      "nvidia.com/gpu",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "present",
+					Effect:   corev1.TaintEffectNoSchedule,
+				}}, result.Tolerations)
+				assert.Equal(t, tc.instance.Spec.Server.Affinity, result.Affinity)
+				assert.Equal(t, tc.instance.Spec.Server.TopologySpreadConstraints, result.TopologySpreadConstraints)
+				assert.Equal(t, ptr.To("nvidia"), result.RuntimeClassName)
+				assert.Equal(t, "gpu-scheduler", result.SchedulerName)
+			} else {
+				assert.Empty(t, result.NodeSelector)
+				assert.Empty(t, result.Tolerations)
+				assert.Nil(t, result.Affinity)
+				assert.Empty(t, result.TopologySpreadConstraints)
+				assert.Nil(t, result.RuntimeClassName)
+				assert.Empty(t, result.SchedulerName, "the default scheduler should be used")
+			}
 		})
 	}
 }
 
-// verifyStorageVolumes validates that the correct storage volumes are configured.
-func verifyStorageVolumes(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1.LlamaStackDistribution,
-	expectPVC, expectEmptyDir bool) {
-	t.Helper()
-
-	if expectPVC {
-		pvcFound := false
-		for _, vol := range podSpec.Volumes {
-			if vol.Name == "lls-storage" && vol.PersistentVolumeClaim != nil {
-				pvcFound = true
-				assert.Equal(t, instance.Name+"-pvc", vol.PersistentVolumeClaim.ClaimName)
-				break
-			}
-		}
-		assert.True(t, pvcFound, "Expected PVC volume not found")
+func TestConfigurePodSchedulingAntiAffinity(t *testing.T) {
+	userAffinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: "nvidia.com/gpu.count", Operator: corev1.NodeSelectorOpGt, Values: []string{"0"}},
+					},
+				}},
+			},
+		},
 	}
 
-	if expectEmptyDir {
-		emptyDirFound := false
+	tests := []struct {
+		name             string
+		spec             llamav1alpha1.LlamaStackDistributionSpec
+		expectedAffinity *corev1.Affinity
+		expectSpread     bool
+	}{
+		{
+			name: "single replica",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{Replicas: 1},
+		},
+		{
+			name:         "multiple replicas",
+			spec:         llamav1alpha1.LlamaStackDistributionSpec{Replicas: 3},
+			expectSpread: true,
+		},
+		{
+			name: "autoscaling beyond one replica",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas:    1,
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{MaxReplicas: 4},
+			},
+			expectSpread: true,
+		},
+		{
+			name: "GPU node selector and tolerations keep the default",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 3,
+				Server: llamav1alpha1.ServerSpec{
+					NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+					Tolerations: []corev1.Toleration{{
+						Key:      "nvidia.com/gpu",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					}},
+				},
+			},
+			expectSpread: true,
+		},
+		{
+			name: "user affinity replaces the default",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 3,
+				Server:   llamav1alpha1.ServerSpec{Affinity: userAffinity},
+			},
+			expectedAffinity: userAffinity,
+		},
+		{
+			name: "topology spread constraints replace the default",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 3,
+				Server: llamav1alpha1.ServerSpec{TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       corev1.LabelTopologyZone,
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			podSpec := &corev1.PodSpec{}
+
+			configurePodScheduling(instance, podSpec, false)
+
+			assert.Equal(t, tt.spec.Server.NodeSelector, podSpec.NodeSelector)
+			assert.Equal(t, tt.spec.Server.Tolerations, podSpec.Tolerations)
+			if !tt.expectSpread {
+				assert.Equal(t, tt.expectedAffinity, podSpec.Affinity)
+				return
+			}
+			require.NotNil(t, podSpec.Affinity)
+			require.NotNil(t, podSpec.Affinity.PodAntiAffinity)
+			assert.Empty(t, podSpec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+				"the spread should not prevent scheduling on a single node")
+			terms := podSpec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+			require.Len(t, terms, 1)
+			assert.Equal(t, corev1.LabelHostname, terms[0].PodAffinityTerm.TopologyKey)
+			assert.Equal(t, map[string]string{"app.kubernetes.io/instance": "test-instance"},
+				terms[0].PodAffinityTerm.LabelSelector.MatchLabels)
+		})
+	}
+}
+
+func TestConfigurePodSchedulingOverrides(t *testing.T) {
+	serverTolerations := []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+	overrides := &llamav1alpha1.PodOverrides{
+		NodeSelector: map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB"},
+		Tolerations: []corev1.Toleration{{
+			Key:      "nvidia.com/gpu",
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		}},
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{Key: "nvidia.com/gpu.count", Operator: corev1.NodeSelectorOpGt, Values: []string{"0"}},
+						},
+					}},
+				},
+			},
+		},
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+		}},
+	}
+
+	t.Run("fields land in the pod spec", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 3,
+				Server:   llamav1alpha1.ServerSpec{PodOverrides: overrides},
+			},
+		}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+
+		assert.Equal(t, overrides.NodeSelector, podSpec.NodeSelector)
+		assert.Equal(t, overrides.Tolerations, podSpec.Tolerations)
+		assert.Equal(t, overrides.Affinity, podSpec.Affinity)
+		assert.Equal(t, overrides.TopologySpreadConstraints, podSpec.TopologySpreadConstraints)
+	})
+
+	t.Run("fields take precedence over the server spec", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+					Tolerations:  serverTolerations,
+					Affinity:     &corev1.Affinity{PodAffinity: &corev1.PodAffinity{}},
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+						MaxSkew:           2,
+						TopologyKey:       corev1.LabelHostname,
+						WhenUnsatisfiable: corev1.DoNotSchedule,
+					}},
+					PodOverrides: overrides,
+				},
+			},
+		}
+		podSpec := &corev1.PodSpec{}
+
+		configurePodScheduling(instance, podSpec, false)
+
+		assert.Equal(t, overrides.NodeSelector, podSpec.NodeSelector)
+		assert.Equal(t, overrides.Tolerations, podSpec.Tolerations)
+		assert.Equal(t, overrides.Affinity, podSpec.Affinity)
+		assert.Equal(t, overrides.TopologySpreadConstraints, podSpec.TopologySpreadConstraints)
+	})
+
+	t.Run("server spec applies to the fields left unset", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					Tolerations: serverTolerations,
+					PodOverrides: &llamav1alpha1.PodOverrides{
+						NodeSelector: overrides.NodeSelector,
+					},
+				},
+			},
+		}
+		podSpec := &corev1.PodSpec{}
+
+		configurePodScheduling(instance, podSpec, false)
+
+		assert.Equal(t, overrides.NodeSelector, podSpec.NodeSelector)
+		assert.Equal(t, serverTolerations, podSpec.Tolerations)
+	})
+
+	t.Run("fields are omitted when unset", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					PodOverrides: &llamav1alpha1.PodOverrides{ServiceAccountName: "custom-sa"},
+				},
+			},
+		}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+
+		assert.Nil(t, podSpec.NodeSelector)
+		assert.Nil(t, podSpec.Tolerations)
+		assert.Nil(t, podSpec.Affinity)
+		assert.Nil(t, podSpec.TopologySpreadConstraints)
+	})
+}
+
+func TestConfigurePodOverridesGPUScheduling(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Replicas: 2,
+			Server: llamav1alpha1.ServerSpec{
+				PodOverrides: &llamav1alpha1.PodOverrides{
+					NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+					Tolerations: []corev1.Toleration{{
+						Key:      "nvidia.com/gpu",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					}},
+				},
+			},
+		},
+	}
+
+	podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+
+	assert.Equal(t, map[string]string{"nvidia.com/gpu.present": "true"}, podSpec.NodeSelector)
+	assert.Equal(t, []corev1.Toleration{{
+		Key:      "nvidia.com/gpu",
+		Operator: corev1.TolerationOpExists,
+		Effect:   corev1.TaintEffectNoSchedule,
+	}}, podSpec.Tolerations)
+	assert.Equal(t, defaultPodAntiAffinity(instance), podSpec.Affinity,
+		"the GPU scheduling should keep the default spread across nodes")
+}
+
+func TestConfigurePodSchedulingDefaultTopologySpread(t *testing.T) {
+	userConstraints := []corev1.TopologySpreadConstraint{{
+		MaxSkew:           2,
+		TopologyKey:       corev1.LabelHostname,
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+	}}
+
+	tests := []struct {
+		name                  string
+		spec                  llamav1alpha1.LlamaStackDistributionSpec
+		defaultTopologySpread bool
+		expectedConstraints   []corev1.TopologySpreadConstraint
+		expectDefault         bool
+	}{
+		{
+			name: "feature flag disabled",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{Replicas: 3},
+		},
+		{
+			name:                  "single replica",
+			spec:                  llamav1alpha1.LlamaStackDistributionSpec{Replicas: 1},
+			defaultTopologySpread: true,
+		},
+		{
+			name:                  "multiple replicas",
+			spec:                  llamav1alpha1.LlamaStackDistributionSpec{Replicas: 3},
+			defaultTopologySpread: true,
+			expectDefault:         true,
+		},
+		{
+			name: "autoscaling beyond one replica",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas:    1,
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{MaxReplicas: 4},
+			},
+			defaultTopologySpread: true,
+			expectDefault:         true,
+		},
+		{
+			name: "user constraints replace the default",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 3,
+				Server:   llamav1alpha1.ServerSpec{TopologySpreadConstraints: userConstraints},
+			},
+			defaultTopologySpread: true,
+			expectedConstraints:   userConstraints,
+		},
+		{
+			name: "pod override constraints replace the default",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 3,
+				Server: llamav1alpha1.ServerSpec{
+					PodOverrides: &llamav1alpha1.PodOverrides{TopologySpreadConstraints: userConstraints},
+				},
+			},
+			defaultTopologySpread: true,
+			expectedConstraints:   userConstraints,
+		},
+		{
+			name: "pod overrides without constraints keep the default",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas: 3,
+				Server: llamav1alpha1.ServerSpec{
+					PodOverrides: &llamav1alpha1.PodOverrides{
+						NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+					},
+				},
+			},
+			defaultTopologySpread: true,
+			expectDefault:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			podSpec := &corev1.PodSpec{}
+
+			configurePodScheduling(instance, podSpec, tt.defaultTopologySpread)
+
+			if !tt.expectDefault {
+				assert.Equal(t, tt.expectedConstraints, podSpec.TopologySpreadConstraints)
+				return
+			}
+			require.Len(t, podSpec.TopologySpreadConstraints, 1)
+			constraint := podSpec.TopologySpreadConstraints[0]
+			assert.Equal(t, int32(1), constraint.MaxSkew)
+			assert.Equal(t, corev1.LabelTopologyZone, constraint.TopologyKey)
+			assert.Equal(t, corev1.ScheduleAnyway, constraint.WhenUnsatisfiable)
+			require.NotNil(t, constraint.LabelSelector)
+			assert.Equal(t, deploy.GetSelectorLabels(instance), constraint.LabelSelector.MatchLabels)
+			assert.NotNil(t, podSpec.Affinity, "the zone spread should keep the default spread across nodes")
+		})
+	}
+}
+
+// verifyStorageVolumes validates that the correct storage volumes are configured.
+func verifyStorageVolumes(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1.LlamaStackDistribution,
+	expectPVC, expectEmptyDir bool) {
+	t.Helper()
+
+	if expectPVC {
+		pvcFound := false
+		for _, vol := range podSpec.Volumes {
+			if vol.Name == "lls-storage" && vol.PersistentVolumeClaim != nil {
+				pvcFound = true
+				assert.Equal(t, instance.Name+"-pvc", vol.PersistentVolumeClaim.ClaimName)
+				break
+			}
+		}
+		assert.True(t, pvcFound, "Expected PVC volume not found")
+	}
+
+	if expectEmptyDir {
+		emptyDirFound := false
 		for _, vol := range podSpec.Volumes {
 			if vol.Name == "lls-storage" && vol.EmptyDir != nil {
 				emptyDirFound = true
@@ -356,7 +1218,8 @@ func verifyVolumes(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1
 func verifyVolumeMounts(t *testing.T, podSpec corev1.PodSpec, instance *llamav1alpha1.LlamaStackDistribution) {
 	t.Helper()
 	if len(instance.Spec.Server.PodOverrides.VolumeMounts) > 0 {
-		for _, container := range podSpec.Containers {
+		// The volume mounts of the overrides only apply to the server container
+		for _, container := range podSpec.Containers[:1] {
 			for _, mount := range instance.Spec.Server.PodOverrides.VolumeMounts {
 				found := false
 				for _, podMount := range container.VolumeMounts {
@@ -399,269 +1262,3993 @@ func setupTestClusterInfo(images map[string]string) *cluster.ClusterInfo {
 	}
 }
 
-func TestResolveImage(t *testing.T) {
-	// Setup test cluster info
-	clusterInfo := setupTestClusterInfo(map[string]string{
-		"ollama": "ollama-image:latest",
-	})
-
+func TestValidateStorageSize(t *testing.T) {
+	minSize := resource.MustParse("1Gi")
 	testCases := []struct {
-		name          string
-		instance      *llamav1alpha1.LlamaStackDistribution
-		expectedImage string
-		expectError   bool
+		name        string
+		storage     *llamav1alpha1.StorageSpec
+		minSize     resource.Quantity
+		expectError bool
 	}{
+		{name: "no storage", storage: nil, minSize: minSize},
+		{name: "default size", storage: &llamav1alpha1.StorageSpec{}, minSize: minSize},
+		{name: "size equal to the floor", storage: &llamav1alpha1.StorageSpec{Size: ptr.To(resource.MustParse("1Gi"))}, minSize: minSize},
 		{
-			name:          "resolve from name",
-			instance:      createLSD("ollama", ""),
-			expectedImage: clusterInfo.DistributionImages["ollama"],
-			expectError:   false,
-		},
-		{
-			name:          "resolve from image",
-			instance:      createLSD("", "test-image:latest"),
-			expectedImage: "test-image:latest",
-			expectError:   false,
+			name:        "size below the floor",
+			storage:     &llamav1alpha1.StorageSpec{Size: ptr.To(resource.MustParse("100Mi"))},
+			minSize:     minSize,
+			expectError: true,
 		},
 		{
-			name:          "invalid distribution name",
-			instance:      createLSD("invalid-name", ""),
-			expectedImage: "",
-			expectError:   true,
+			name:        "decimal size below a binary floor",
+			storage:     &llamav1alpha1.StorageSpec{Size: ptr.To(resource.MustParse("1G"))},
+			minSize:     minSize,
+			expectError: true,
 		},
+		{name: "check disabled", storage: &llamav1alpha1.StorageSpec{Size: ptr.To(resource.MustParse("1Mi"))}},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			r := &LlamaStackDistributionReconciler{ClusterInfo: clusterInfo}
-			image, err := r.resolveImage(tc.instance.Spec.Server.Distribution)
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{Storage: tc.storage},
+				},
+			}
+			err := validateStorageSize(instance, tc.minSize)
 			if tc.expectError {
 				require.Error(t, err)
-				assert.Empty(t, image)
-			} else {
-				require.NoError(t, err)
-				assert.Equal(t, tc.expectedImage, image)
+				return
 			}
+			require.NoError(t, err)
 		})
 	}
 }
 
-func TestDistributionValidation(t *testing.T) {
-	// Setup test cluster info
-	clusterInfo := setupTestClusterInfo(map[string]string{
-		"ollama": "lls/lls-ollama:1.0",
-	})
-
+func TestValidateResources(t *testing.T) {
 	testCases := []struct {
-		name        string
-		instance    *llamav1alpha1.LlamaStackDistribution
-		expectError bool
+		name          string
+		resources     corev1.ResourceRequirements
+		expectedError string
 	}{
+		{name: "no resources"},
 		{
-			name:        "valid distribution name",
-			instance:    createLSD("ollama", ""),
-			expectError: false,
+			name: "requests below limits",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			},
 		},
 		{
-			name:        "valid direct image",
-			instance:    createLSD("", "test-image:latest"),
-			expectError: false,
+			name: "requests without limits",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			},
 		},
 		{
-			name:        "invalid distribution name",
-			instance:    createLSD("invalid-name", ""),
-			expectError: true,
+			name: "cpu request exceeds limit",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+			expectedError: "cpu request 2 exceeds its limit 1",
+		},
+		{
+			name: "memory request exceeds limit",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			},
+			expectedError: "memory request 8Gi exceeds its limit 4Gi",
+		},
+		{
+			name: "GPU request equals limit",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				Limits:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			},
+		},
+		{
+			name: "GPU limit only",
+			resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+			},
+		},
+		{
+			name: "GPU request below limit",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				Limits:   corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+			},
+			expectedError: "nvidia.com/gpu request 1 must equal its limit 2",
+		},
+		{
+			name: "GPU request without limit",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			},
+			expectedError: "nvidia.com/gpu request 1 requires a limit of the same value",
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			r := &LlamaStackDistributionReconciler{ClusterInfo: clusterInfo}
-			err := r.validateDistribution(tc.instance)
-			if tc.expectError {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{Resources: tc.resources},
+					},
+				},
+			}
+			err := validateResources(instance)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
 			}
+			require.NoError(t, err)
 		})
 	}
 }
 
-func TestDistributionWithoutClusterInfo(t *testing.T) {
-	// Clear cluster info
-	instance := createLSD("ollama", "")
-	r := &LlamaStackDistributionReconciler{ClusterInfo: nil}
-	err := r.validateDistribution(instance)
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to initialize cluster info")
-}
-
-func TestPodOverridesWithServiceAccount(t *testing.T) {
-	// Create a test instance with ServiceAccount override
-	instance := &llamav1alpha1.LlamaStackDistribution{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-instance",
-			Namespace: "test-namespace",
+func TestValidateSidecars(t *testing.T) {
+	testCases := []struct {
+		name          string
+		containerName string
+		sidecars      []string
+		expectedError string
+	}{
+		{name: "no sidecars"},
+		{name: "unique names", sidecars: []string{"log-shipper", "model-downloader"}},
+		{
+			name:          "default server container name",
+			sidecars:      []string{llamav1alpha1.DefaultContainerName},
+			expectedError: fmt.Sprintf("container name %q is already used", llamav1alpha1.DefaultContainerName),
 		},
-		Spec: llamav1alpha1.LlamaStackDistributionSpec{
-			Server: llamav1alpha1.ServerSpec{
-				PodOverrides: &llamav1alpha1.PodOverrides{
-					ServiceAccountName: "custom-sa",
-				},
-			},
+		{
+			name:          "custom server container name",
+			containerName: "server",
+			sidecars:      []string{"server"},
+			expectedError: `container name "server" is already used`,
+		},
+		{
+			name:          "duplicate sidecar names",
+			sidecars:      []string{"log-shipper", "log-shipper"},
+			expectedError: `container name "log-shipper" is already used`,
 		},
 	}
 
-	// Create deployment
-	deployment := &appsv1.Deployment{
-		Spec: appsv1.DeploymentSpec{
-			Template: corev1.PodTemplateSpec{
-				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name: "test-container",
-						},
-					},
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			podOverrides := &llamav1alpha1.PodOverrides{}
+			for _, name := range tc.sidecars {
+				podOverrides.Sidecars = append(podOverrides.Sidecars, corev1.Container{Name: name, Image: "busybox"})
+			}
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{Name: tc.containerName},
+						PodOverrides:  podOverrides,
+					},
 				},
-			},
-		},
+			}
+			err := validateSidecars(instance)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
 	}
+}
 
-	// Apply pod overrides
-	configurePodOverrides(instance, &deployment.Spec.Template.Spec)
+func TestStorageSizeWarning(t *testing.T) {
+	testCases := []struct {
+		name          string
+		storage       *llamav1alpha1.StorageSpec
+		expectWarning bool
+	}{
+		{name: "no storage", storage: nil},
+		{name: "default size", storage: &llamav1alpha1.StorageSpec{}},
+		{name: "binary unit", storage: &llamav1alpha1.StorageSpec{Size: ptr.To(resource.MustParse("10Gi"))}},
+		{name: "decimal unit", storage: &llamav1alpha1.StorageSpec{Size: ptr.To(resource.MustParse("10G"))}, expectWarning: true},
+	}
 
-	// Verify ServiceAccount name
-	if deployment.Spec.Template.Spec.ServiceAccountName != "custom-sa" {
-		t.Errorf("expected ServiceAccountName to be 'custom-sa', got %s", deployment.Spec.Template.Spec.ServiceAccountName)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{Storage: tc.storage},
+				},
+			}
+			warning := storageSizeWarning(instance)
+			if tc.expectWarning {
+				assert.NotEmpty(t, warning)
+			} else {
+				assert.Empty(t, warning)
+			}
+		})
 	}
 }
 
-func TestPodOverridesWithoutServiceAccount(t *testing.T) {
-	// Create a test instance without ServiceAccount override
-	instance := &llamav1alpha1.LlamaStackDistribution{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-instance",
-			Namespace: "test-namespace",
+func TestStorageAccessModeWarning(t *testing.T) {
+	testCases := []struct {
+		name          string
+		replicas      int32
+		storage       *llamav1alpha1.StorageSpec
+		expectWarning bool
+	}{
+		{name: "no storage", replicas: 1, storage: nil},
+		{name: "default access modes", replicas: 1, storage: &llamav1alpha1.StorageSpec{}},
+		{
+			name:     "ReadWriteOnce with single replica",
+			replicas: 1,
+			storage:  &llamav1alpha1.StorageSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}},
 		},
-		Spec: llamav1alpha1.LlamaStackDistributionSpec{
-			Server: llamav1alpha1.ServerSpec{
-				PodOverrides: &llamav1alpha1.PodOverrides{},
-			},
+		{
+			name:          "ReadWriteMany with single replica",
+			replicas:      1,
+			storage:       &llamav1alpha1.StorageSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}},
+			expectWarning: true,
+		},
+		{
+			name:     "ReadWriteMany with multiple replicas",
+			replicas: 3,
+			storage:  &llamav1alpha1.StorageSpec{AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}},
 		},
 	}
 
-	// Create deployment
-	deployment := &appsv1.Deployment{
-		Spec: appsv1.DeploymentSpec{
-			Template: corev1.PodTemplateSpec{
-				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name: "test-container",
-						},
-					},
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Replicas: tc.replicas,
+					Server:   llamav1alpha1.ServerSpec{Storage: tc.storage},
 				},
-			},
+			}
+			warning := storageAccessModeWarning(instance)
+			if tc.expectWarning {
+				assert.NotEmpty(t, warning)
+			} else {
+				assert.Empty(t, warning)
+			}
+		})
+	}
+}
+
+func TestRuntimeClassWarning(t *testing.T) {
+	gpu := corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}
+	testCases := []struct {
+		name             string
+		runtimeClassName *string
+		resources        corev1.ResourceRequirements
+		expectWarning    bool
+	}{
+		{name: "no runtime class"},
+		{name: "runtime class without resources", runtimeClassName: ptr.To("nvidia"), expectWarning: true},
+		{
+			name:             "runtime class with CPU resources only",
+			runtimeClassName: ptr.To("nvidia"),
+			resources:        corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}},
+			expectWarning:    true,
+		},
+		{
+			name:             "runtime class with GPU limits",
+			runtimeClassName: ptr.To("nvidia"),
+			resources:        corev1.ResourceRequirements{Limits: gpu},
+		},
+		{
+			name:             "runtime class with AMD GPU requests",
+			runtimeClassName: ptr.To("amd"),
+			resources:        corev1.ResourceRequirements{Requests: corev1.ResourceList{"amd.com/gpu": resource.MustParse("1")}},
+		},
+		{
+			name:             "runtime class with Intel GPU limits",
+			runtimeClassName: ptr.To("intel"),
+			resources:        corev1.ResourceRequirements{Limits: corev1.ResourceList{"gpu.intel.com/i915": resource.MustParse("1")}},
 		},
 	}
 
-	// Apply pod overrides
-	configurePodOverrides(instance, &deployment.Spec.Template.Spec)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						RuntimeClassName: tc.runtimeClassName,
+						ContainerSpec:    llamav1alpha1.ContainerSpec{Resources: tc.resources},
+					},
+				},
+			}
+			warning := runtimeClassWarning(instance)
+			if tc.expectWarning {
+				assert.NotEmpty(t, warning)
+			} else {
+				assert.Empty(t, warning)
+			}
+		})
+	}
+}
 
-	// Verify ServiceAccount name is empty (default ServiceAccountName should be set when not explicitly provided)
-	if deployment.Spec.Template.Spec.ServiceAccountName != instance.Name+"-sa" {
-		t.Errorf("expected default ServiceAccountName when not explicitly provided, got %s", deployment.Spec.Template.Spec.ServiceAccountName)
+func TestBuildMaintenanceCronJob(t *testing.T) {
+	job := llamav1alpha1.MaintenanceJobSpec{
+		Name:     "prune-cache",
+		Schedule: "0 3 * * *",
+		Command:  []string{"/bin/sh", "-c"},
+		Args:     []string{"find $HF_HOME -atime +30 -delete"},
 	}
+
+	t.Run("persistent storage and server image", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					Storage: &llamav1alpha1.StorageSpec{MountPath: "/models"},
+				},
+			},
+		}
+
+		cronJob := buildMaintenanceCronJob(instance, job, "server-image:latest", DefaultUtilityImage)
+
+		assert.Equal(t, "test-instance-prune-cache", cronJob.Name)
+		assert.Equal(t, "default", cronJob.Namespace)
+		assert.Equal(t, "0 3 * * *", cronJob.Spec.Schedule)
+		assert.Equal(t, batchv1.ForbidConcurrent, cronJob.Spec.ConcurrencyPolicy)
+		assert.Equal(t, "prune-cache", cronJob.Labels[deploy.MaintenanceJobLabel])
+
+		podTemplate := cronJob.Spec.JobTemplate.Spec.Template
+		assert.NotContains(t, podTemplate.Labels, llamav1alpha1.DefaultLabelKey, "job pods must not be selected as server pods")
+		assert.Equal(t, corev1.RestartPolicyOnFailure, podTemplate.Spec.RestartPolicy)
+		assert.Equal(t, "test-instance-sa", podTemplate.Spec.ServiceAccountName)
+
+		require.Len(t, podTemplate.Spec.Containers, 1)
+		container := podTemplate.Spec.Containers[0]
+		assert.Equal(t, "server-image:latest", container.Image)
+		assert.Equal(t, job.Command, container.Command)
+		assert.Equal(t, job.Args, container.Args)
+		assert.Equal(t, []corev1.VolumeMount{{Name: "lls-storage", MountPath: "/models"}}, container.VolumeMounts)
+		assert.Equal(t, []corev1.EnvVar{{Name: "HF_HOME", Value: "/models"}}, container.Env)
+
+		require.Len(t, podTemplate.Spec.Volumes, 1)
+		require.NotNil(t, podTemplate.Spec.Volumes[0].PersistentVolumeClaim)
+		assert.Equal(t, "test-instance-pvc", podTemplate.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	})
+
+	t.Run("custom image", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		}
+		customJob := job
+		customJob.Image = "busybox:latest"
+		customJob.Suspend = true
+
+		cronJob := buildMaintenanceCronJob(instance, customJob, "server-image:latest", DefaultUtilityImage)
+
+		assert.Equal(t, "busybox:latest", cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image)
+		assert.Equal(t, ptr.To(true), cronJob.Spec.Suspend)
+		require.Len(t, cronJob.Spec.JobTemplate.Spec.Template.Spec.Volumes, 1)
+		assert.NotNil(t, cronJob.Spec.JobTemplate.Spec.Template.Spec.Volumes[0].EmptyDir)
+	})
 }
 
-func TestValidateConfigMapKeys(t *testing.T) {
-	tests := []struct {
-		name        string
-		keys        []string
-		expectError bool
-		errorMsg    string
+func TestGetMaintenanceJobStatus(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2025, 6, 1, 3, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(earlier.Add(24 * time.Hour))
+
+	testCases := []struct {
+		name     string
+		status   batchv1.CronJobStatus
+		expected llamav1alpha1.MaintenanceJobState
 	}{
+		{name: "never ran", status: batchv1.CronJobStatus{}, expected: ""},
 		{
-			name:        "valid keys",
-			keys:        []string{DefaultCABundleKey, "intermediate.pem", "root-ca.cert"},
-			expectError: false,
+			name:     "running",
+			status:   batchv1.CronJobStatus{Active: []corev1.ObjectReference{{Name: "job"}}, LastScheduleTime: &later},
+			expected: llamav1alpha1.MaintenanceJobStateRunning,
 		},
 		{
-			name:        "empty key",
-			keys:        []string{""},
-			expectError: true,
-			errorMsg:    "ConfigMap key cannot be empty",
+			name:     "succeeded",
+			status:   batchv1.CronJobStatus{LastScheduleTime: &later, LastSuccessfulTime: &later},
+			expected: llamav1alpha1.MaintenanceJobStateSucceeded,
 		},
 		{
-			name:        "command injection attempt",
-			keys:        []string{"valid-key; rm -rf /; echo malicious"},
-			expectError: true,
-			errorMsg:    "contains invalid characters",
+			name:     "failed after an earlier success",
+			status:   batchv1.CronJobStatus{LastScheduleTime: &later, LastSuccessfulTime: &earlier},
+			expected: llamav1alpha1.MaintenanceJobStateFailed,
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := getMaintenanceJobStatus("prune-cache", &batchv1.CronJob{Status: tc.status})
+			assert.Equal(t, "prune-cache", status.Name)
+			assert.Equal(t, tc.expected, status.LastJobStatus)
+			assert.Equal(t, tc.status.LastScheduleTime, status.LastScheduleTime)
+			assert.Equal(t, tc.status.LastSuccessfulTime, status.LastSuccessfulTime)
+		})
+	}
+}
+
+func TestResolveImage(t *testing.T) {
+	// Setup test cluster info
+	clusterInfo := setupTestClusterInfo(map[string]string{
+		"ollama": "ollama-image:latest",
+	})
+
+	testCases := []struct {
+		name          string
+		instance      *llamav1alpha1.LlamaStackDistribution
+		expectedImage string
+		expectError   bool
+	}{
 		{
-			name:        "path traversal attempt",
-			keys:        []string{"../../../etc/passwd"},
-			expectError: true,
-			errorMsg:    "contains invalid characters",
+			name:          "resolve from name",
+			instance:      createLSD("ollama", ""),
+			expectedImage: clusterInfo.DistributionImages["ollama"],
+			expectError:   false,
 		},
 		{
-			name:        "shell metacharacters",
-			keys:        []string{"key$(whoami)"},
-			expectError: true,
-			errorMsg:    "contains invalid characters",
+			name:          "resolve from image",
+			instance:      createLSD("", "test-image:latest"),
+			expectedImage: "test-image:latest",
+			expectError:   false,
 		},
 		{
-			name:        "pipe injection",
-			keys:        []string{"key | cat /etc/passwd"},
-			expectError: true,
-			errorMsg:    "contains invalid characters",
+			name:          "invalid distribution name",
+			instance:      createLSD("invalid-name", ""),
+			expectedImage: "",
+			expectError:   true,
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &LlamaStackDistributionReconciler{ClusterInfo: clusterInfo}
+			image, err := r.resolveImage(tc.instance.Spec.Server.Distribution)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Empty(t, image)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedImage, image)
+			}
+		})
+	}
+}
+
+func TestDistributionValidation(t *testing.T) {
+	// Setup test cluster info
+	clusterInfo := setupTestClusterInfo(map[string]string{
+		"ollama": "lls/lls-ollama:1.0",
+	})
+
+	testCases := []struct {
+		name        string
+		instance    *llamav1alpha1.LlamaStackDistribution
+		expectError bool
+	}{
 		{
-			name:        "too long key",
-			keys:        []string{strings.Repeat("a", 254)},
-			expectError: true,
-			errorMsg:    "too long",
+			name:        "valid distribution name",
+			instance:    createLSD("ollama", ""),
+			expectError: false,
 		},
 		{
-			name:        "valid alphanumeric with allowed chars",
-			keys:        []string{"ca_bundle-v1.2.crt"},
+			name:        "valid direct image",
+			instance:    createLSD("", "test-image:latest"),
 			expectError: false,
 		},
+		{
+			name:        "invalid distribution name",
+			instance:    createLSD("invalid-name", ""),
+			expectError: true,
+		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := validateConfigMapKeys(tt.keys)
-			if tt.expectError {
-				if err == nil {
-					t.Errorf("Expected error but got none")
-				} else if tt.errorMsg != "" && !strings.Contains(err.Error(), tt.errorMsg) {
-					t.Errorf("Expected error message to contain '%s', got '%s'", tt.errorMsg, err.Error())
-				}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &LlamaStackDistributionReconciler{ClusterInfo: clusterInfo}
+			err := r.validateDistribution(tc.instance)
+			if tc.expectError {
+				assert.Error(t, err)
 			} else {
-				if err != nil {
-					t.Errorf("Expected no error but got: %v", err)
-				}
+				assert.NoError(t, err)
 			}
 		})
 	}
 }
 
-// newDefaultReadinessProbe returns a Kubernetes HTTP readiness probe that checks
-// the "/v1/health" endpoint on the given port using default timing and
-// threshold settings.
-func newDefaultReadinessProbe(port int32) *corev1.Probe {
-	return &corev1.Probe{
-		ProbeHandler: corev1.ProbeHandler{
-			HTTPGet: &corev1.HTTPGetAction{
-				Path: "/v1/health",
-				Port: intstr.FromInt(int(port)),
+func TestDistributionSpecificEntrypoints(t *testing.T) {
+	r := &LlamaStackDistributionReconciler{
+		DistributionEntrypoints: map[string]DistributionEntrypoint{
+			"native": {
+				Command: []string{"/usr/local/bin/llama-stack-server"},
+				Args:    []string{"--config", "/etc/llama-stack/run.yaml"},
 			},
 		},
-		InitialDelaySeconds: readinessProbeInitialDelaySeconds,
-		PeriodSeconds:       readinessProbePeriodSeconds,
-		TimeoutSeconds:      readinessProbeTimeoutSeconds,
-		FailureThreshold:    readinessProbeFailureThreshold,
-		SuccessThreshold:    readinessProbeSuccessThreshold,
+	}
+	userConfig := &llamav1alpha1.UserConfigSpec{ConfigMapName: "test-config"}
+
+	testCases := []struct {
+		name            string
+		distribution    string
+		containerSpec   llamav1alpha1.ContainerSpec
+		expectedCommand []string
+		expectedArgs    []string
+	}{
+		{
+			name:            "distribution with a configured entrypoint",
+			distribution:    "native",
+			expectedCommand: []string{"/usr/local/bin/llama-stack-server"},
+			expectedArgs:    []string{"--config", "/etc/llama-stack/run.yaml"},
+		},
+		{
+			name:            "distribution without a configured entrypoint",
+			distribution:    "ollama",
+			expectedCommand: []string{"python", "-m", "llama_stack.distribution.server.server"},
+			expectedArgs:    []string{"--config", "/etc/llama-stack/run.yaml"},
+		},
+		{
+			name:         "user command and args take precedence",
+			distribution: "native",
+			containerSpec: llamav1alpha1.ContainerSpec{
+				Command: []string{"/bin/custom"},
+				Args:    []string{"--custom"},
+			},
+			expectedCommand: []string{"/bin/custom"},
+			expectedArgs:    []string{"--custom"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := createLSD(tc.distribution, "")
+			instance.Spec.Server.UserConfig = userConfig
+			instance.Spec.Server.ContainerSpec = tc.containerSpec
+
+			container := corev1.Container{}
+			configureContainerCommands(r, instance, &container)
+
+			assert.Equal(t, tc.expectedCommand, container.Command)
+			assert.Equal(t, tc.expectedArgs, container.Args)
+		})
+	}
+}
+
+func TestParseDistributionEntrypoints(t *testing.T) {
+	t.Run("valid entrypoints", func(t *testing.T) {
+		entrypoints, err := parseDistributionEntrypoints(map[string]string{
+			distributionEntrypointsKey: "native:\n  command: [\"/usr/local/bin/llama-stack-server\"]\n  args: [\"--config\", \"/etc/llama-stack/run.yaml\"]\n",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/usr/local/bin/llama-stack-server"}, entrypoints["native"].Command)
+		assert.Equal(t, []string{"--config", "/etc/llama-stack/run.yaml"}, entrypoints["native"].Args)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		entrypoints, err := parseDistributionEntrypoints(map[string]string{})
+		require.NoError(t, err)
+		assert.Empty(t, entrypoints)
+	})
+
+	t.Run("entrypoint without command or args", func(t *testing.T) {
+		_, err := parseDistributionEntrypoints(map[string]string{
+			distributionEntrypointsKey: "native: {}\n",
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestParseOwnerReferencePolicy(t *testing.T) {
+	t.Run("configured flags", func(t *testing.T) {
+		policy, err := parseOwnerReferencePolicy(map[string]string{
+			ownerReferencesKey: "blockOwnerDeletion: false\ncontroller: true\n",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(false), policy.BlockOwnerDeletion)
+		assert.Equal(t, ptr.To(true), policy.Controller)
+	})
+
+	t.Run("missing key keeps defaults", func(t *testing.T) {
+		policy, err := parseOwnerReferencePolicy(map[string]string{})
+		require.NoError(t, err)
+		assert.Nil(t, policy.BlockOwnerDeletion)
+		assert.Nil(t, policy.Controller)
+	})
+
+	t.Run("invalid YAML", func(t *testing.T) {
+		_, err := parseOwnerReferencePolicy(map[string]string{ownerReferencesKey: "controller: [true"})
+		require.Error(t, err)
+	})
+}
+
+func TestParseMinStorageSize(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expected    resource.Quantity
+		expectError bool
+	}{
+		{name: "missing key uses default", data: map[string]string{}, expected: DefaultMinStorageSize},
+		{name: "custom floor", data: map[string]string{minStorageSizeKey: "5Gi"}, expected: resource.MustParse("5Gi")},
+		{name: "zero disables the check", data: map[string]string{minStorageSizeKey: "0"}, expected: resource.MustParse("0")},
+		{name: "invalid quantity", data: map[string]string{minStorageSizeKey: "lots"}, expectError: true},
+		{name: "negative quantity", data: map[string]string{minStorageSizeKey: "-1Gi"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minSize, err := parseMinStorageSize(tc.data)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Zero(t, tc.expected.Cmp(minSize), "expected %s, got %s", tc.expected.String(), minSize.String())
+		})
+	}
+}
+
+func TestParseUtilityImage(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expected    string
+		expectError bool
+	}{
+		{name: "missing key uses default", data: map[string]string{}, expected: DefaultUtilityImage},
+		{name: "empty value uses default", data: map[string]string{utilityImageKey: " "}, expected: DefaultUtilityImage},
+		{name: "mirrored image", data: map[string]string{utilityImageKey: "mirror.example.com/ubi9/ubi-minimal:9.5\n"},
+			expected: "mirror.example.com/ubi9/ubi-minimal:9.5"},
+		{name: "whitespace in the image", data: map[string]string{utilityImageKey: "ubi-minimal 9.5"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			image, err := parseUtilityImage(tc.data)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, image)
+		})
+	}
+}
+
+func TestUtilityImageFromOperatorConfig(t *testing.T) {
+	t.Setenv("OPERATOR_NAMESPACE", "operator-system")
+	const mirroredImage = "mirror.example.com/ubi9/ubi-minimal:9.5"
+	operatorConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorConfigData, Namespace: "operator-system"},
+		Data:       map[string]string{utilityImageKey: mirroredImage},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(operatorConfig).Build()
+
+	// --- act ---
+	r, err := NewLlamaStackDistributionReconciler(t.Context(), cli, scheme.Scheme, nil)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.Equal(t, mirroredImage, r.utilityImage())
+
+	// --- assert: a reconciler built without the operator ConfigMap keeps the default ---
+	assert.Equal(t, DefaultUtilityImage, (&LlamaStackDistributionReconciler{}).utilityImage())
+}
+
+func TestInitContainerImage(t *testing.T) {
+	const mirroredImage = "mirror.example.com/ubi9/ubi-minimal:9.5"
+	const instanceImage = "registry.example.com/tools/ubi-minimal@sha256:0123"
+	testCases := []struct {
+		name         string
+		utilityImage string
+		specImage    string
+		expected     string
+	}{
+		{name: "default image", expected: DefaultUtilityImage},
+		{name: "image of the operator ConfigMap", utilityImage: mirroredImage, expected: mirroredImage},
+		{name: "image of the spec", specImage: instanceImage, expected: instanceImage},
+		{name: "spec takes precedence", utilityImage: mirroredImage, specImage: instanceImage, expected: instanceImage},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &LlamaStackDistributionReconciler{UtilityImage: tc.utilityImage}
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{InitContainerImage: tc.specImage},
+			}
+
+			assert.Equal(t, tc.expected, r.initContainerImage(instance))
+		})
+	}
+}
+
+func TestParseInitializingRequeueInterval(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expected    time.Duration
+		expectError bool
+	}{
+		{name: "missing key uses default", data: map[string]string{}, expected: DefaultInitializingRequeueInterval},
+		{name: "custom interval", data: map[string]string{initializingRequeueSecondsKey: " 120 "}, expected: 2 * time.Minute},
+		{name: "not a number", data: map[string]string{initializingRequeueSecondsKey: "2m"}, expectError: true},
+		{name: "zero", data: map[string]string{initializingRequeueSecondsKey: "0"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			interval, err := parseInitializingRequeueInterval(tc.data)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, interval)
+		})
+	}
+}
+
+func TestParseHealthCheckFailureThreshold(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expected    int
+		expectError bool
+	}{
+		{name: "missing key uses default", data: map[string]string{}, expected: DefaultHealthCheckFailureThreshold},
+		{name: "custom threshold", data: map[string]string{healthCheckFailureThresholdKey: " 5 "}, expected: 5},
+		{name: "not a number", data: map[string]string{healthCheckFailureThresholdKey: "five"}, expectError: true},
+		{name: "zero", data: map[string]string{healthCheckFailureThresholdKey: "0"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			threshold, err := parseHealthCheckFailureThreshold(tc.data)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, threshold)
+		})
+	}
+}
+
+func TestInitializingRequeueIntervalFromOperatorConfig(t *testing.T) {
+	t.Setenv("OPERATOR_NAMESPACE", "operator-system")
+	operatorConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorConfigData, Namespace: "operator-system"},
+		Data:       map[string]string{initializingRequeueSecondsKey: "90"},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(operatorConfig).Build()
+
+	// --- act ---
+	r, err := NewLlamaStackDistributionReconciler(t.Context(), cli, scheme.Scheme, nil)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, r.initializingRequeueInterval())
+
+	// --- assert: a reconciler built without the operator ConfigMap keeps the default ---
+	assert.Equal(t, DefaultInitializingRequeueInterval, (&LlamaStackDistributionReconciler{}).initializingRequeueInterval())
+}
+
+func TestParseFeatureFlags(t *testing.T) {
+	testCases := []struct {
+		name                        string
+		data                        map[string]string
+		expectNetworkPolicy         bool
+		expectQuotaPreflight        bool
+		expectRolloutSerialization  bool
+		expectSecurityDefaults      bool
+		expectDefaultTopologySpread bool
+		disableContentValidation    bool
+		expectError                 bool
+	}{
+		{name: "missing key uses defaults", data: map[string]string{}},
+		{
+			name:                "flags missing from the YAML keep their default",
+			data:                map[string]string{featureflags.FeatureFlagsKey: "enableNetworkPolicy:\n  enabled: true\n"},
+			expectNetworkPolicy: true,
+		},
+		{
+			name:                 "quota pre-flight enabled",
+			data:                 map[string]string{featureflags.FeatureFlagsKey: "enableQuotaPreflight:\n  enabled: true\n"},
+			expectQuotaPreflight: true,
+		},
+		{
+			name:                       "rollout serialization enabled",
+			data:                       map[string]string{featureflags.FeatureFlagsKey: "enableRolloutSerialization:\n  enabled: true\n"},
+			expectRolloutSerialization: true,
+		},
+		{
+			name:                   "security context defaults enabled",
+			data:                   map[string]string{featureflags.FeatureFlagsKey: "enableSecurityContextDefaults:\n  enabled: true\n"},
+			expectSecurityDefaults: true,
+		},
+		{
+			name:                        "default topology spread enabled",
+			data:                        map[string]string{featureflags.FeatureFlagsKey: "enableDefaultTopologySpread:\n  enabled: true\n"},
+			expectDefaultTopologySpread: true,
+		},
+		{
+			name:                     "ConfigMap content validation disabled",
+			data:                     map[string]string{featureflags.FeatureFlagsKey: "validateConfigMapContent:\n  enabled: false\n"},
+			disableContentValidation: true,
+		},
+		{name: "invalid YAML", data: map[string]string{featureflags.FeatureFlagsKey: "enableNetworkPolicy: ["}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags, err := parseFeatureFlags(tc.data)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectNetworkPolicy, flags.EnableNetworkPolicy.Enabled)
+			assert.Equal(t, tc.expectQuotaPreflight, flags.EnableQuotaPreflight.Enabled)
+			assert.Equal(t, tc.expectRolloutSerialization, flags.EnableRolloutSerialization.Enabled)
+			assert.Equal(t, tc.expectSecurityDefaults, flags.EnableSecurityContextDefaults.Enabled)
+			assert.Equal(t, tc.expectDefaultTopologySpread, flags.EnableDefaultTopologySpread.Enabled)
+			assert.Equal(t, !tc.disableContentValidation, flags.ValidateConfigMapContent.EnabledOr(false))
+		})
+	}
+}
+
+func TestParseNetworkPolicyEnforcement(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        map[string]string
+		expected    *bool
+		expectError bool
+	}{
+		{name: "missing key detects automatically", data: map[string]string{}, expected: nil},
+		{name: "auto", data: map[string]string{networkPolicyEnforcementKey: "auto"}, expected: nil},
+		{name: "enforced", data: map[string]string{networkPolicyEnforcementKey: "enforced\n"}, expected: ptr.To(true)},
+		{name: "unverified", data: map[string]string{networkPolicyEnforcementKey: "unverified"}, expected: ptr.To(false)},
+		{name: "invalid value", data: map[string]string{networkPolicyEnforcementKey: "yes"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			enforcement, err := parseNetworkPolicyEnforcement(tc.data)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, enforcement)
+		})
+	}
+}
+
+func TestParseStatusExportConfig(t *testing.T) {
+	t.Run("configured sink", func(t *testing.T) {
+		config, err := parseStatusExportConfig(map[string]string{
+			statusexport.ConfigKey: "url: https://sink.example.com/events\ntimeout: 2s\n",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, config)
+		assert.Equal(t, "https://sink.example.com/events", config.URL)
+		assert.Equal(t, 2*time.Second, config.Timeout)
+	})
+
+	t.Run("missing key disables export", func(t *testing.T) {
+		config, err := parseStatusExportConfig(map[string]string{})
+		require.NoError(t, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("invalid YAML", func(t *testing.T) {
+		_, err := parseStatusExportConfig(map[string]string{statusexport.ConfigKey: "url: [unterminated"})
+		require.Error(t, err)
+	})
+}
+
+func TestDistributionWithoutClusterInfo(t *testing.T) {
+	// Clear cluster info
+	instance := createLSD("ollama", "")
+	r := &LlamaStackDistributionReconciler{ClusterInfo: nil}
+	err := r.validateDistribution(instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to initialize cluster info")
+}
+
+func TestPodOverridesWithServiceAccount(t *testing.T) {
+	// Create a test instance with ServiceAccount override
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-instance",
+			Namespace: "test-namespace",
+		},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				PodOverrides: &llamav1alpha1.PodOverrides{
+					ServiceAccountName: "custom-sa",
+				},
+			},
+		},
+	}
+
+	// Create deployment
+	deployment := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "test-container",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Apply pod overrides
+	configurePodOverrides(instance, &deployment.Spec.Template.Spec)
+
+	// Verify ServiceAccount name
+	if deployment.Spec.Template.Spec.ServiceAccountName != "custom-sa" {
+		t.Errorf("expected ServiceAccountName to be 'custom-sa', got %s", deployment.Spec.Template.Spec.ServiceAccountName)
+	}
+}
+
+func TestPodOverridesWithoutServiceAccount(t *testing.T) {
+	// Create a test instance without ServiceAccount override
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-instance",
+			Namespace: "test-namespace",
+		},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				PodOverrides: &llamav1alpha1.PodOverrides{},
+			},
+		},
+	}
+
+	// Create deployment
+	deployment := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "test-container",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Apply pod overrides
+	configurePodOverrides(instance, &deployment.Spec.Template.Spec)
+
+	// Verify ServiceAccount name is empty (default ServiceAccountName should be set when not explicitly provided)
+	if deployment.Spec.Template.Spec.ServiceAccountName != instance.Name+"-sa" {
+		t.Errorf("expected default ServiceAccountName when not explicitly provided, got %s", deployment.Spec.Template.Spec.ServiceAccountName)
+	}
+}
+
+func TestConfigurePersistentStorage(t *testing.T) {
+	newInstance := func(storage *llamav1alpha1.StorageSpec) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{Storage: storage},
+			},
+		}
+	}
+
+	t.Run("fsGroup by default", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(&llamav1alpha1.StorageSpec{}), corev1.Container{Name: "test-container"})
+
+		assert.Empty(t, podSpec.InitContainers)
+		require.NotNil(t, podSpec.SecurityContext)
+		assert.Equal(t, ptr.To(int64(1001)), podSpec.SecurityContext.FSGroup)
+		assert.Equal(t, ptr.To(corev1.FSGroupChangeOnRootMismatch), podSpec.SecurityContext.FSGroupChangePolicy)
+	})
+
+	t.Run("configured fsGroup", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(&llamav1alpha1.StorageSpec{
+			FSGroup: ptr.To(int64(1000680000)),
+		}), corev1.Container{Name: "test-container"})
+
+		require.NotNil(t, podSpec.SecurityContext)
+		assert.Equal(t, ptr.To(int64(1000680000)), podSpec.SecurityContext.FSGroup)
+		assert.Equal(t, ptr.To(corev1.FSGroupChangeOnRootMismatch), podSpec.SecurityContext.FSGroupChangePolicy)
+	})
+
+	t.Run("explicit pod security context replaces the fsGroup", func(t *testing.T) {
+		instance := newInstance(&llamav1alpha1.StorageSpec{})
+		podSecurityContext := &corev1.PodSecurityContext{FSGroup: ptr.To(int64(1000680000))}
+		instance.Spec.Server.PodOverrides = &llamav1alpha1.PodOverrides{PodSecurityContext: podSecurityContext}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+
+		assert.Empty(t, podSpec.InitContainers)
+		assert.Equal(t, podSecurityContext, podSpec.SecurityContext)
+	})
+
+	t.Run("init container when opted in", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(&llamav1alpha1.StorageSpec{
+			MountPath:                "/data",
+			PermissionsInitContainer: true,
+		}), corev1.Container{Name: "test-container"})
+
+		assert.Nil(t, podSpec.SecurityContext)
+		require.Len(t, podSpec.InitContainers, 1)
+		initContainer := podSpec.InitContainers[0]
+		assert.Equal(t, "update-pvc-permissions", initContainer.Name)
+		assert.Equal(t, ptr.To(int64(0)), initContainer.SecurityContext.RunAsUser)
+		assert.Contains(t, initContainer.Command[2], "chown 1001:0 /data")
+		assert.Equal(t, []corev1.VolumeMount{{Name: "lls-storage", MountPath: "/data"}}, initContainer.VolumeMounts)
+		assert.Equal(t, DefaultUtilityImage, initContainer.Image)
+		assert.Equal(t, resource.MustParse("10m"), initContainer.Resources.Requests[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("32Mi"), initContainer.Resources.Requests[corev1.ResourceMemory])
+		assert.Equal(t, resource.MustParse("100m"), initContainer.Resources.Limits[corev1.ResourceCPU])
+		assert.Equal(t, resource.MustParse("64Mi"), initContainer.Resources.Limits[corev1.ResourceMemory])
+	})
+
+	t.Run("init container resources from the pod overrides", func(t *testing.T) {
+		instance := newInstance(&llamav1alpha1.StorageSpec{PermissionsInitContainer: true})
+		resources := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+		}
+		instance.Spec.Server.PodOverrides = &llamav1alpha1.PodOverrides{InitContainerResources: &resources}
+		instance.Spec.Server.MaintenanceJobs = []llamav1alpha1.MaintenanceJobSpec{{Name: "prune", Schedule: "0 3 * * *"}}
+
+		podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+		cronJob := buildMaintenanceCronJob(instance, instance.Spec.Server.MaintenanceJobs[0], "server-image:latest", DefaultUtilityImage)
+
+		require.Len(t, podSpec.InitContainers, 1)
+		assert.Equal(t, resources, podSpec.InitContainers[0].Resources)
+		maintenanceInitContainers := cronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers
+		require.Len(t, maintenanceInitContainers, 1)
+		assert.Equal(t, resources, maintenanceInitContainers[0].Resources)
+	})
+
+	t.Run("init containers use the utility image of the operator config", func(t *testing.T) {
+		const mirroredImage = "mirror.example.com/ubi9/ubi-minimal@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+		r := NewReconciler(fake.NewClientBuilder().Build(), scheme.Scheme)
+		r.UtilityImage = mirroredImage
+		instance := newInstance(&llamav1alpha1.StorageSpec{PermissionsInitContainer: true})
+		instance.Spec.Server.MaintenanceJobs = []llamav1alpha1.MaintenanceJobSpec{{Name: "prune", Schedule: "0 3 * * *"}}
+
+		podSpec := configurePodStorage(t.Context(), r, instance, corev1.Container{Name: "test-container"})
+		cronJob := buildMaintenanceCronJob(instance, instance.Spec.Server.MaintenanceJobs[0], "server-image:latest", r.utilityImage())
+
+		require.Len(t, podSpec.InitContainers, 1)
+		assert.Equal(t, mirroredImage, podSpec.InitContainers[0].Image)
+		maintenanceInitContainers := cronJob.Spec.JobTemplate.Spec.Template.Spec.InitContainers
+		require.Len(t, maintenanceInitContainers, 1)
+		assert.Equal(t, mirroredImage, maintenanceInitContainers[0].Image)
+	})
+
+	t.Run("no fsGroup without persistent storage", func(t *testing.T) {
+		podSpec := configurePodStorage(t.Context(), nil, newInstance(nil), corev1.Container{Name: "test-container"})
+
+		assert.Empty(t, podSpec.InitContainers)
+		assert.Nil(t, podSpec.SecurityContext)
+	})
+}
+
+func TestIsStorageChangeAcknowledged(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		from, to string
+		expected bool
+	}{
+		{"switch to PVC acknowledged", "emptyDir->pvc", storageBackendEmptyDir, storageBackendPVC, true},
+		{"switch to emptyDir acknowledged", "pvc->emptyDir", storageBackendPVC, storageBackendEmptyDir, true},
+		{"opposite switch not acknowledged", "emptyDir->pvc", storageBackendPVC, storageBackendEmptyDir, false},
+		{"boolean value not acknowledged", "true", storageBackendEmptyDir, storageBackendPVC, false},
+		{"missing annotation", "", storageBackendEmptyDir, storageBackendPVC, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{}
+			if tt.value != "" {
+				instance.Annotations = map[string]string{llamav1alpha1.AcknowledgeStorageChangeAnnotation: tt.value}
+			}
+
+			assert.Equal(t, tt.expected, isStorageChangeAcknowledged(instance, tt.from, tt.to))
+		})
+	}
+}
+
+func TestBuildIngressRules(t *testing.T) {
+	tcpPort := func(port int32) networkingv1.NetworkPolicyPort {
+		return networkingv1.NetworkPolicyPort{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(port))}
+	}
+	operatorNamespaceOf := func(rule networkingv1.NetworkPolicyIngressRule) string {
+		return rule.From[0].NamespaceSelector.MatchLabels["kubernetes.io/metadata.name"]
+	}
+
+	t.Run("server port", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{}
+
+		rules := buildIngressRules(instance, "operator-ns")
+
+		require.Len(t, rules, 2)
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{tcpPort(llamav1alpha1.DefaultServerPort)}, rules[0].Ports)
+		assert.Equal(t, "operator-ns", operatorNamespaceOf(rules[1]))
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{tcpPort(llamav1alpha1.DefaultServerPort)}, rules[1].Ports)
+	})
+
+	t.Run("health port reachable from the operator namespace only", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{}
+		instance.Spec.Server.HealthCheck = &llamav1alpha1.HealthCheckSpec{Port: 8081}
+		instance.Spec.Server.ContainerSpec.ExtraPorts = []corev1.ContainerPort{
+			{Name: "metrics", ContainerPort: 8125, Protocol: corev1.ProtocolUDP},
+		}
+
+		rules := buildIngressRules(instance, "operator-ns")
+
+		require.Len(t, rules, 2)
+		udpPort := networkingv1.NetworkPolicyPort{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(8125))}
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{tcpPort(llamav1alpha1.DefaultServerPort), udpPort}, rules[0].Ports)
+		assert.Equal(t, "operator-ns", operatorNamespaceOf(rules[1]))
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{tcpPort(llamav1alpha1.DefaultServerPort), udpPort, tcpPort(8081)}, rules[1].Ports)
+	})
+}
+
+func TestSecurityContext(t *testing.T) {
+	restricted := &corev1.SecurityContext{
+		RunAsNonRoot:             ptr.To(true),
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+	explicitPod := &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1001)), FSGroup: ptr.To(int64(1001))}
+	explicitContainer := &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)}
+
+	testCases := []struct {
+		name              string
+		defaults          bool
+		podContext        *corev1.PodSecurityContext
+		containerContext  *corev1.SecurityContext
+		expectedPod       *corev1.PodSecurityContext
+		expectedContainer *corev1.SecurityContext
+	}{
+		{
+			name: "no security context",
+		},
+		{
+			name:              "explicit security contexts",
+			podContext:        explicitPod,
+			containerContext:  explicitContainer,
+			expectedPod:       explicitPod,
+			expectedContainer: explicitContainer,
+		},
+		{
+			name:              "defaults without explicit security context",
+			defaults:          true,
+			expectedContainer: restricted,
+		},
+		{
+			name:              "defaults with an explicit pod security context",
+			defaults:          true,
+			podContext:        explicitPod,
+			expectedPod:       explicitPod,
+			expectedContainer: restricted,
+		},
+		{
+			name:              "explicit container security context takes precedence over the defaults",
+			defaults:          true,
+			containerContext:  explicitContainer,
+			expectedContainer: explicitContainer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{ContainerSecurityContext: tc.containerContext},
+						PodOverrides:  &llamav1alpha1.PodOverrides{PodSecurityContext: tc.podContext},
+					},
+				},
+			}
+			r := NewReconciler(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), scheme.Scheme,
+				WithFeatureFlags(featureflags.FeatureFlags{
+					EnableSecurityContextDefaults: featureflags.FeatureFlag{Enabled: tc.defaults},
+				}))
+
+			// --- act ---
+			podSpec := configurePodStorage(t.Context(), r, instance, corev1.Container{Name: "test-container"})
+
+			// --- assert ---
+			assert.Equal(t, tc.expectedPod, podSpec.SecurityContext)
+			require.Len(t, podSpec.Containers, 1)
+			assert.Equal(t, tc.expectedContainer, podSpec.Containers[0].SecurityContext)
+		})
+	}
+}
+
+func TestReadOnlyRootFilesystem(t *testing.T) {
+	readOnly := &corev1.SecurityContext{
+		RunAsNonRoot:             ptr.To(true),
+		AllowPrivilegeEscalation: ptr.To(false),
+		ReadOnlyRootFilesystem:   ptr.To(true),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+	}
+	podContext := &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1001)), FSGroup: ptr.To(int64(1001))}
+	newInstance := func(storage *llamav1alpha1.StorageSpec) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					ContainerSpec: llamav1alpha1.ContainerSpec{ContainerSecurityContext: readOnly},
+					PodOverrides:  &llamav1alpha1.PodOverrides{PodSecurityContext: podContext},
+					Storage:       storage,
+				},
+			},
+		}
+	}
+	r := NewReconciler(fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), scheme.Scheme)
+	buildPodSpec := func(t *testing.T, instance *llamav1alpha1.LlamaStackDistribution) corev1.PodSpec {
+		t.Helper()
+		container := buildContainerSpec(t.Context(), r, instance, "llamastack:latest")
+		return configurePodStorage(t.Context(), r, instance, container)
+	}
+	assertWritableMount := func(t *testing.T, podSpec corev1.PodSpec, volumeName, mountPath string) {
+		t.Helper()
+		require.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{Name: volumeName, MountPath: mountPath})
+		idx := slices.IndexFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == volumeName })
+		require.NotEqual(t, -1, idx, "volume %s should exist", volumeName)
+		if claim := podSpec.Volumes[idx].PersistentVolumeClaim; claim != nil {
+			assert.False(t, claim.ReadOnly, "volume %s should be writable", volumeName)
+		}
+	}
+
+	t.Run("emptyDir storage", func(t *testing.T) {
+		podSpec := buildPodSpec(t, newInstance(nil))
+
+		assert.Equal(t, readOnly, podSpec.Containers[0].SecurityContext)
+		assert.Equal(t, podContext, podSpec.SecurityContext)
+		assertWritableMount(t, podSpec, "lls-storage", llamav1alpha1.DefaultMountPath)
+		assertWritableMount(t, podSpec, "lls-tmp", "/tmp")
+	})
+
+	t.Run("PVC storage with the permissions init container", func(t *testing.T) {
+		podSpec := buildPodSpec(t, newInstance(&llamav1alpha1.StorageSpec{MountPath: "/data", PermissionsInitContainer: true}))
+
+		assert.Equal(t, readOnly, podSpec.Containers[0].SecurityContext)
+		assertWritableMount(t, podSpec, "lls-storage", "/data")
+		assertWritableMount(t, podSpec, "lls-tmp", "/tmp")
+		require.Len(t, podSpec.InitContainers, 1)
+		assert.Equal(t, ptr.To(int64(0)), podSpec.InitContainers[0].SecurityContext.RunAsUser,
+			"the init container should keep running as root")
+		assert.Nil(t, podSpec.InitContainers[0].SecurityContext.ReadOnlyRootFilesystem)
+	})
+
+	t.Run("tmp volume of the spec is kept", func(t *testing.T) {
+		instance := newInstance(nil)
+		instance.Spec.Server.PodOverrides.Volumes = []corev1.Volume{{
+			Name:         "scratch",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}},
+		}}
+		instance.Spec.Server.PodOverrides.VolumeMounts = []corev1.VolumeMount{{Name: "scratch", MountPath: "/tmp/"}}
+
+		podSpec := buildPodSpec(t, instance)
+
+		assert.False(t, slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == "lls-tmp" }))
+	})
+
+	t.Run("writable root filesystem", func(t *testing.T) {
+		instance := newInstance(nil)
+		instance.Spec.Server.ContainerSpec.ContainerSecurityContext = &corev1.SecurityContext{RunAsNonRoot: ptr.To(true)}
+
+		podSpec := buildPodSpec(t, instance)
+
+		assert.False(t, slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == "lls-tmp" }))
+	})
+}
+
+func TestReconcileServiceAccount(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				PodOverrides: &llamav1alpha1.PodOverrides{
+					ServiceAccountAnnotations: map[string]string{"iam.gke.io/gcp-service-account": "llama@project.iam.gserviceaccount.com"},
+				},
+			},
+		},
+	}
+	testScheme := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+	cli := fake.NewClientBuilder().WithScheme(testScheme).Build()
+	r := NewReconciler(cli, testScheme)
+	key := types.NamespacedName{Name: "test-instance-sa", Namespace: "default"}
+
+	// --- act ---
+	require.NoError(t, r.reconcileServiceAccount(t.Context(), instance))
+
+	// --- assert ---
+	sa := &corev1.ServiceAccount{}
+	require.NoError(t, cli.Get(t.Context(), key, sa))
+	assert.Equal(t, "llama@project.iam.gserviceaccount.com", sa.Annotations["iam.gke.io/gcp-service-account"])
+	assert.NotContains(t, sa.Annotations, "openshift.io/scc", "only the permissions init container needs the anyuid SCC")
+	assert.Equal(t, "test-instance", sa.Labels["app.kubernetes.io/instance"])
+	require.Len(t, sa.OwnerReferences, 1)
+	assert.Equal(t, instance.UID, sa.OwnerReferences[0].UID)
+	assert.True(t, ptr.Deref(sa.OwnerReferences[0].Controller, false))
+
+	// --- act: nothing changed ---
+	resourceVersion := sa.ResourceVersion
+	require.NoError(t, r.reconcileServiceAccount(t.Context(), instance))
+
+	// --- assert ---
+	require.NoError(t, cli.Get(t.Context(), key, sa))
+	assert.Equal(t, resourceVersion, sa.ResourceVersion, "an unchanged ServiceAccount should not be updated")
+
+	// --- act: the annotation changes after the cluster added an image pull Secret ---
+	sa.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "test-instance-sa-dockercfg"}}
+	require.NoError(t, cli.Update(t.Context(), sa))
+	instance.Spec.Server.PodOverrides.ServiceAccountAnnotations["iam.gke.io/gcp-service-account"] = "other@project.iam.gserviceaccount.com"
+	require.NoError(t, r.reconcileServiceAccount(t.Context(), instance))
+
+	// --- assert ---
+	require.NoError(t, cli.Get(t.Context(), key, sa))
+	assert.Equal(t, "other@project.iam.gserviceaccount.com", sa.Annotations["iam.gke.io/gcp-service-account"])
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "test-instance-sa-dockercfg"}}, sa.ImagePullSecrets)
+
+	// --- act: a ServiceAccount with the same name owned by someone else ---
+	other := instance.DeepCopy()
+	other.UID = "other-uid"
+	err := r.reconcileServiceAccount(t.Context(), other)
+
+	// --- assert ---
+	require.ErrorIs(t, err, deploy.ErrNotOwned)
+}
+
+func TestBuildServiceAccountSCCAnnotation(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"}}
+	instance.Spec.Server.Storage = &llamav1alpha1.StorageSpec{}
+	assert.NotContains(t, buildServiceAccount(instance).Annotations, "openshift.io/scc",
+		"the fsGroup makes the volume writable without running as root")
+
+	instance.Spec.Server.Storage.PermissionsInitContainer = true
+	assert.Equal(t, "anyuid", buildServiceAccount(instance).Annotations["openshift.io/scc"])
+}
+
+func TestGetImagePullSecrets(t *testing.T) {
+	serverSecrets := []corev1.LocalObjectReference{{Name: "registry-credentials"}}
+	overrideSecrets := []corev1.LocalObjectReference{{Name: "internal-registry-credentials"}}
+	testCases := []struct {
+		name      string
+		server    []corev1.LocalObjectReference
+		overrides *llamav1alpha1.PodOverrides
+		expected  []corev1.LocalObjectReference
+	}{
+		{name: "unset"},
+		{name: "server spec", server: serverSecrets, expected: serverSecrets},
+		{name: "pod overrides", overrides: &llamav1alpha1.PodOverrides{ImagePullSecrets: overrideSecrets}, expected: overrideSecrets},
+		{name: "pod overrides take precedence", server: serverSecrets,
+			overrides: &llamav1alpha1.PodOverrides{ImagePullSecrets: overrideSecrets}, expected: overrideSecrets},
+		{name: "pod overrides without Secrets", server: serverSecrets,
+			overrides: &llamav1alpha1.PodOverrides{ServiceAccountName: "custom-sa"}, expected: serverSecrets},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{ImagePullSecrets: tc.server, PodOverrides: tc.overrides},
+				},
+			}
+
+			assert.Equal(t, tc.expected, getImagePullSecrets(instance))
+			podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+			assert.Equal(t, tc.expected, podSpec.ImagePullSecrets)
+		})
+	}
+}
+
+func TestImagePullSecrets(t *testing.T) {
+	pullSecrets := []corev1.LocalObjectReference{{Name: "registry-credentials"}, {Name: "mirror-credentials"}}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{ImagePullSecrets: pullSecrets},
+		},
+	}
+
+	// --- act ---
+	podSpec := configurePodStorage(t.Context(), nil, instance, corev1.Container{Name: "test-container"})
+
+	// --- assert: the Secrets are used verbatim ---
+	assert.Equal(t, pullSecrets, podSpec.ImagePullSecrets)
+
+	// --- act: only one of the Secrets exists ---
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte("{}")},
+	}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, scheme.Scheme)
+	r.Recorder = recorder
+	err := r.checkImagePullSecrets(t.Context(), instance)
+
+	// --- assert: the missing Secret is reported without failing ---
+	require.NoError(t, err)
+	require.Len(t, recorder.Events, 1)
+	event := <-recorder.Events
+	assert.Contains(t, event, EventReasonImagePullSecretNotFound)
+	assert.Contains(t, event, "default/mirror-credentials")
+}
+
+func TestConfigMapDataHash(t *testing.T) {
+	newConfigMap := func(data map[string]string, binaryData map[string][]byte) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-config", Namespace: "default", ResourceVersion: "1"},
+			Data:       data,
+			BinaryData: binaryData,
+		}
+	}
+
+	t.Run("identical content regardless of insertion order", func(t *testing.T) {
+		first := map[string]string{}
+		first["run.yaml"] = "version: '2'"
+		first["extra.yaml"] = "foo: bar"
+		second := map[string]string{}
+		second["extra.yaml"] = "foo: bar"
+		second["run.yaml"] = "version: '2'"
+
+		assert.Equal(t, configMapDataHash(newConfigMap(first, nil)), configMapDataHash(newConfigMap(second, nil)))
+	})
+
+	t.Run("metadata changes keep the hash", func(t *testing.T) {
+		configMap := newConfigMap(map[string]string{"run.yaml": "version: '2'"}, nil)
+		before := configMapDataHash(configMap)
+
+		configMap.ResourceVersion = "2"
+		configMap.Labels = map[string]string{"team": "llama"}
+		configMap.Annotations = map[string]string{"note": "updated"}
+
+		assert.Equal(t, before, configMapDataHash(configMap))
+	})
+
+	t.Run("data mutations change the hash", func(t *testing.T) {
+		base := configMapDataHash(newConfigMap(map[string]string{"run.yaml": "version: '2'"}, nil))
+
+		mutations := map[string]*corev1.ConfigMap{
+			"changed value":      newConfigMap(map[string]string{"run.yaml": "version: '3'"}, nil),
+			"renamed key":        newConfigMap(map[string]string{"config.yaml": "version: '2'"}, nil),
+			"added key":          newConfigMap(map[string]string{"run.yaml": "version: '2'", "extra.yaml": ""}, nil),
+			"moved to binary":    newConfigMap(nil, map[string][]byte{"run.yaml": []byte("version: '2'")}),
+			"added binary data":  newConfigMap(map[string]string{"run.yaml": "version: '2'"}, map[string][]byte{"cert": {0x01}}),
+			"shifted key/value":  newConfigMap(map[string]string{"run.yamlv": "ersion: '2'"}, nil),
+			"emptied data value": newConfigMap(map[string]string{"run.yaml": ""}, nil),
+		}
+		for name, configMap := range mutations {
+			assert.NotEqual(t, base, configMapDataHash(configMap), name)
+		}
+	})
+
+	t.Run("nil data maps", func(t *testing.T) {
+		empty := configMapDataHash(newConfigMap(nil, nil))
+		assert.NotEmpty(t, empty)
+		assert.Equal(t, empty, configMapDataHash(newConfigMap(map[string]string{}, map[string][]byte{})))
+	})
+}
+
+func TestGetCABundleConfigMapHash(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle", Namespace: "default"},
+		Data:       map[string]string{DefaultCABundleKey: "-----BEGIN CERTIFICATE-----\nfirst\n-----END CERTIFICATE-----\n"},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				TLSConfig: &llamav1alpha1.TLSConfig{
+					CABundle: &llamav1alpha1.CABundleConfig{ConfigMapName: "ca-bundle"},
+				},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()
+	r := NewReconciler(cli, scheme.Scheme)
+
+	before, err := r.getCABundleConfigMapHash(t.Context(), instance)
+	require.NoError(t, err)
+	require.NotEmpty(t, before)
+
+	// --- act: only the metadata of the ConfigMap changes ---
+	configMap.Labels = map[string]string{"team": "llama"}
+	require.NoError(t, cli.Update(t.Context(), configMap))
+	afterLabels, err := r.getCABundleConfigMapHash(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.Equal(t, before, afterLabels, "a metadata update should not roll out the pods")
+
+	// --- act: the certificates change ---
+	configMap.Data[DefaultCABundleKey] = "-----BEGIN CERTIFICATE-----\nsecond\n-----END CERTIFICATE-----\n"
+	require.NoError(t, cli.Update(t.Context(), configMap))
+	afterData, err := r.getCABundleConfigMapHash(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.NotEqual(t, before, afterData, "a data update should roll out the pods")
+}
+
+func TestReconcileUserSecret(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{SecretRef: &llamav1alpha1.SecretRefSpec{SecretName: "credentials"}},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, scheme.Scheme)
+	r.Recorder = recorder
+
+	// --- act: the Secret does not exist ---
+	err := r.reconcileUserSecret(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorContains(t, err, "failed to find referenced Secret default/credentials")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonSecretNotFound)
+
+	// --- act: the Secret is created ---
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+		Data:       map[string][]byte{"API_KEY": []byte("old")},
+	}
+	require.NoError(t, cli.Create(t.Context(), secret))
+
+	// --- assert ---
+	require.NoError(t, r.reconcileUserSecret(t.Context(), instance))
+	hash, err := r.getUserSecretHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Equal(t, secretDataHash(secret), hash)
+
+	// --- act: only the metadata of the Secret changes ---
+	secret.Labels = map[string]string{"rotated": "false"}
+	require.NoError(t, cli.Update(t.Context(), secret))
+
+	// --- assert ---
+	unchanged, err := r.getUserSecretHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Equal(t, hash, unchanged)
+
+	// --- act: the credentials are rotated ---
+	secret.Data["API_KEY"] = []byte("new")
+	require.NoError(t, cli.Update(t.Context(), secret))
+
+	// --- assert ---
+	rotated, err := r.getUserSecretHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, rotated)
+
+	// --- assert: no Secret is read without a secretRef ---
+	instance.Spec.Server.SecretRef = nil
+	require.NoError(t, r.reconcileUserSecret(t.Context(), instance))
+	hash, err = r.getUserSecretHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+}
+
+func TestValidateUserConfigData(t *testing.T) {
+	const validRunYAML = "version: '2'\nimage_name: ollama\nproviders:\n  inference:\n  - provider_id: ollama\n"
+
+	tests := []struct {
+		name          string
+		data          map[string]string
+		key           string
+		containerPort int32
+		errorContains string
+	}{
+		{
+			name: "valid run configuration",
+			data: map[string]string{"run.yaml": validRunYAML},
+			key:  "run.yaml",
+		},
+		{
+			name: "valid run configuration under a custom key",
+			data: map[string]string{"config.yaml": validRunYAML},
+			key:  "config.yaml",
+		},
+		{
+			name:          "missing key",
+			data:          map[string]string{"run-prod.yaml": validRunYAML, "run-dev.yaml": validRunYAML},
+			key:           "run.yaml",
+			errorContains: "failed to find key 'run.yaml' in user ConfigMap, available keys: [run-dev.yaml, run-prod.yaml]",
+		},
+		{
+			name:          "malformed YAML",
+			data:          map[string]string{"run.yaml": "providers: [unterminated"},
+			key:           "run.yaml",
+			errorContains: "failed to parse 'run.yaml'",
+		},
+		{
+			name:          "empty configuration",
+			data:          map[string]string{"run.yaml": ""},
+			key:           "run.yaml",
+			errorContains: "configuration is empty",
+		},
+		{
+			name:          "missing version",
+			data:          map[string]string{"run.yaml": "providers:\n  inference: []\n"},
+			key:           "run.yaml",
+			errorContains: "missing top-level field 'version'",
+		},
+		{
+			name:          "missing providers",
+			data:          map[string]string{"run.yaml": "version: '2'\nimage_name: ollama\n"},
+			key:           "run.yaml",
+			errorContains: "missing top-level field 'providers'",
+		},
+		{
+			name:          "providers is not a mapping",
+			data:          map[string]string{"run.yaml": "version: '2'\nproviders:\n- ollama\n"},
+			key:           "run.yaml",
+			errorContains: "'providers' must map APIs to their providers",
+		},
+		{
+			name:          "server port matching the container port",
+			data:          map[string]string{"run.yaml": validRunYAML + "server:\n  port: 8080\n"},
+			key:           "run.yaml",
+			containerPort: 8080,
+		},
+		{
+			name:          "server port substituted from the environment",
+			data:          map[string]string{"run.yaml": validRunYAML + "server:\n  port: ${env.LLAMA_STACK_PORT:=8080}\n"},
+			key:           "run.yaml",
+			containerPort: 8080,
+		},
+		{
+			name:          "missing server block with a custom container port",
+			data:          map[string]string{"run.yaml": validRunYAML},
+			key:           "run.yaml",
+			containerPort: 8080,
+		},
+		{
+			name:          "missing server port with a custom container port",
+			data:          map[string]string{"run.yaml": validRunYAML + "server:\n  auth: null\n"},
+			key:           "run.yaml",
+			containerPort: 8080,
+		},
+		{
+			name:          "server port not matching the container port",
+			data:          map[string]string{"run.yaml": validRunYAML + "server:\n  port: 8080\n"},
+			key:           "run.yaml",
+			errorContains: "the server listens on port 8080 but the container port is 8321",
+		},
+		{
+			name:          "server port is not a number",
+			data:          map[string]string{"run.yaml": validRunYAML + "server:\n  port: http\n"},
+			key:           "run.yaml",
+			errorContains: "'server.port' must be a number, got 'http'",
+		},
+		{
+			name:          "server port out of range",
+			data:          map[string]string{"run.yaml": validRunYAML + "server:\n  port: 70000\n"},
+			key:           "run.yaml",
+			errorContains: "'server.port' 70000 is not a valid port",
+		},
+		{
+			name:          "server is not a mapping",
+			data:          map[string]string{"run.yaml": validRunYAML + "server: 8321\n"},
+			key:           "run.yaml",
+			errorContains: "'server' must be a mapping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			containerPort := tt.containerPort
+			if containerPort == 0 {
+				containerPort = llamav1alpha1.DefaultServerPort
+			}
+			err := validateUserConfigData(tt.data, tt.key, containerPort)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
+
+func TestReconcileUserConfigMapKey(t *testing.T) {
+	const validRunYAML = "version: '2'\nproviders:\n  inference: []\n"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "configs", Namespace: "default"},
+		Data:       map[string]string{"run-dev.yaml": validRunYAML, "run-prod.yaml": validRunYAML},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "configs", ConfigMapKey: "run-dev.yaml"},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()
+	r := NewReconciler(cli, scheme.Scheme)
+
+	// --- act: the selected key exists ---
+	require.NoError(t, r.reconcileUserConfigMap(t.Context(), instance))
+	instance.Status.References = []llamav1alpha1.ExternalReference{{
+		Kind:             "ConfigMap",
+		Namespace:        "default",
+		Name:             "configs",
+		Purpose:          llamav1alpha1.ReferencePurposeUserConfig,
+		LastObservedHash: configMapDataHash(configMap),
+	}}
+
+	// --- assert ---
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeUserConfigReady))
+
+	// --- act: the spec selects a key missing from the unchanged ConfigMap ---
+	instance.Spec.Server.UserConfig.ConfigMapKey = "run-staging.yaml"
+	err := r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorContains(t, err, "failed to find key 'run-staging.yaml' in user ConfigMap, available keys: [run-dev.yaml, run-prod.yaml]")
+	condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReasonUserConfigInvalid, condition.Reason)
+}
+
+func TestReconcileUserConfigMapInvalidContent(t *testing.T) {
+	const runYAML = "version: '2'\nproviders:\n  inference: []\n"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-config", Namespace: "default"},
+		Data:       map[string]string{"run.yaml": runYAML + "server:\n  port: 9000\n"},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				ContainerSpec: llamav1alpha1.ContainerSpec{Port: 8080},
+				UserConfig:    &llamav1alpha1.UserConfigSpec{ConfigMapName: "run-config"},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, scheme.Scheme)
+	r.Recorder = recorder
+
+	// --- act: the run configuration listens on another port than the container port ---
+	err := r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorContains(t, err, "the server listens on port 9000 but the container port is 8080")
+	condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReasonUserConfigInvalid, condition.Reason)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonUserConfigInvalid)
+
+	// --- act: the ConfigMap is still invalid on the next reconciliation ---
+	require.Error(t, r.reconcileUserConfigMap(t.Context(), instance))
+
+	// --- assert ---
+	assert.Empty(t, recorder.Events, "the event should only be emitted when the validation error changes")
+
+	// --- act: the ConfigMap is fixed ---
+	configMap.Data["run.yaml"] = runYAML + "server:\n  port: 8080\n"
+	require.NoError(t, cli.Update(t.Context(), configMap))
+	err = r.reconcileUserConfigMap(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeUserConfigReady))
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonUserConfigValidated)
+}
+
+func TestReconcileUserConfigMapMalformedEntry(t *testing.T) {
+	const runYAML = "version: '2'\nproviders:\n  inference: []\n"
+	testCases := []struct {
+		name              string
+		data              map[string]string
+		disableValidation bool
+		expectedError     string
+	}{
+		{
+			name: "valid entries",
+			data: map[string]string{
+				"run.yaml":       runYAML,
+				"providers.yml":  "inference:\n  - provider_id: ollama\n",
+				"models.json":    `{"models": []}`,
+				"entrypoint.sh":  "#!/bin/sh\nexec llama stack run: /etc/llama-stack/run.yaml\n",
+				"ca-bundle.crt":  "-----BEGIN CERTIFICATE-----\n",
+				"notes-no-ext":   "key: [",
+				"empty-file.yml": "",
+			},
+		},
+		{
+			name:          "malformed run configuration",
+			data:          map[string]string{"run.yaml": "version: '2'\nproviders: [\n"},
+			expectedError: "failed to parse 'run.yaml'",
+		},
+		{
+			name:          "malformed additional YAML entry",
+			data:          map[string]string{"run.yaml": runYAML, "providers.yaml": "inference:\n\t- remote::ollama\n"},
+			expectedError: "failed to parse 'providers.yaml'",
+		},
+		{
+			name:          "malformed additional JSON entry",
+			data:          map[string]string{"run.yaml": runYAML, "models.json": `{"models": [}`},
+			expectedError: "failed to parse 'models.json'",
+		},
+		{
+			name:              "validation disabled by the feature flag",
+			data:              map[string]string{"run.yaml": runYAML, "providers.yaml": "inference: ["},
+			disableValidation: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "run-config", Namespace: "default"},
+				Data:       tc.data,
+			}
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "run-config"},
+					},
+				},
+			}
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()
+			r := NewReconciler(cli, scheme.Scheme)
+			r.ValidateConfigMapContent = !tc.disableValidation
+
+			// --- act ---
+			err := r.reconcileUserConfigMap(t.Context(), instance)
+
+			// --- assert ---
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeUserConfigReady))
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedError)
+			condition := GetCondition(&instance.Status, ConditionTypeUserConfigReady)
+			require.NotNil(t, condition)
+			assert.Equal(t, metav1.ConditionFalse, condition.Status)
+			assert.Contains(t, condition.Message, tc.expectedError)
+		})
+	}
+}
+
+func TestReconcileUserConfigMapServerPort(t *testing.T) {
+	const runYAML = "version: '2'\nproviders:\n  inference: []\nserver:\n  port: 9000\n"
+
+	tests := []struct {
+		name          string
+		runYAML       string
+		containerPort int32
+		expectedPort  int32
+		errorContains string
+	}{
+		{
+			name:         "unset container port inherits the port of the run configuration",
+			expectedPort: 9000,
+		},
+		{
+			name:          "matching container port",
+			containerPort: 9000,
+			expectedPort:  9000,
+		},
+		{
+			name:          "mismatching container port fails",
+			containerPort: 8321,
+			expectedPort:  8321,
+			errorContains: "the server listens on port 9000 but the container port is 8321",
+		},
+		{
+			name:          "no server block keeps a custom container port",
+			runYAML:       "version: '2'\nproviders:\n  inference: []\n",
+			containerPort: 9000,
+			expectedPort:  9000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := runYAML
+			if tt.runYAML != "" {
+				data = tt.runYAML
+			}
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "run-config", Namespace: "default"},
+				Data:       map[string]string{"run.yaml": data},
+			}
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						ContainerSpec: llamav1alpha1.ContainerSpec{Port: tt.containerPort},
+						UserConfig:    &llamav1alpha1.UserConfigSpec{ConfigMapName: "run-config"},
+					},
+				},
+			}
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()
+			r := NewReconciler(cli, scheme.Scheme)
+
+			// --- act ---
+			err := r.reconcileUserConfigMap(t.Context(), instance)
+
+			// --- assert ---
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				assert.False(t, IsConditionTrue(&instance.Status, ConditionTypeUserConfigReady))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedPort, getContainerPort(instance))
+			assert.Equal(t, tt.expectedPort, deploy.GetServicePort(instance))
+			assert.Equal(t, fmt.Sprintf("test-instance-service.default.svc.cluster.local:%d", tt.expectedPort),
+				r.getServerURL(instance, "/v1/health").Host)
+		})
+	}
+}
+
+func TestConfigureUserConfig(t *testing.T) {
+	newInstance := func(userConfig *llamav1alpha1.UserConfigSpec) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{UserConfig: userConfig},
+			},
+		}
+	}
+
+	t.Run("default key mounts the whole ConfigMap", func(t *testing.T) {
+		podSpec := &corev1.PodSpec{}
+		configureUserConfig(newInstance(&llamav1alpha1.UserConfigSpec{ConfigMapName: "test-config"}), podSpec)
+
+		require.Len(t, podSpec.Volumes, 1)
+		assert.Equal(t, "test-config", podSpec.Volumes[0].ConfigMap.Name)
+		assert.Empty(t, podSpec.Volumes[0].ConfigMap.Items)
+	})
+
+	t.Run("custom key is projected as run.yaml", func(t *testing.T) {
+		podSpec := &corev1.PodSpec{}
+		configureUserConfig(newInstance(&llamav1alpha1.UserConfigSpec{
+			ConfigMapName: "test-config",
+			ConfigMapKey:  "config.yaml",
+		}), podSpec)
+
+		require.Len(t, podSpec.Volumes, 1)
+		assert.Equal(t, []corev1.KeyToPath{{Key: "config.yaml", Path: "run.yaml"}}, podSpec.Volumes[0].ConfigMap.Items)
+	})
+
+	t.Run("additional ConfigMaps are projected next to the run configuration", func(t *testing.T) {
+		podSpec := &corev1.PodSpec{}
+		configureUserConfig(newInstance(&llamav1alpha1.UserConfigSpec{
+			ConfigMapName:        "test-config",
+			ConfigMapKey:         "config.yaml",
+			AdditionalConfigMaps: []string{"provider-files", "prompts", "provider-files"},
+		}), podSpec)
+
+		require.Len(t, podSpec.Volumes, 1)
+		assert.Equal(t, "user-config", podSpec.Volumes[0].Name)
+		require.NotNil(t, podSpec.Volumes[0].Projected)
+		assert.Equal(t, []corev1.VolumeProjection{
+			{ConfigMap: &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "test-config"},
+				Items:                []corev1.KeyToPath{{Key: "config.yaml", Path: "run.yaml"}},
+			}},
+			{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "provider-files"}}},
+			{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "prompts"}}},
+		}, podSpec.Volumes[0].Projected.Sources)
+	})
+
+	t.Run("no user config", func(t *testing.T) {
+		podSpec := &corev1.PodSpec{}
+		configureUserConfig(newInstance(nil), podSpec)
+
+		assert.Empty(t, podSpec.Volumes)
+	})
+}
+
+func TestReconcileAdditionalConfigMaps(t *testing.T) {
+	const runYAML = "version: '2'\nproviders:\n  inference: []\n"
+	newConfigMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}, Data: data}
+	}
+	newInstance := func(userConfig *llamav1alpha1.UserConfigSpec) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{UserConfig: userConfig},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		userConfig  *llamav1alpha1.UserConfigSpec
+		expectedErr string
+	}{
+		{
+			name: "unique keys",
+			userConfig: &llamav1alpha1.UserConfigSpec{
+				ConfigMapName:        "run-config",
+				AdditionalConfigMaps: []string{"provider-files", "prompts"},
+			},
+		},
+		{
+			name: "missing ConfigMap",
+			userConfig: &llamav1alpha1.UserConfigSpec{
+				ConfigMapName:        "run-config",
+				AdditionalConfigMaps: []string{"provider-files", "missing"},
+			},
+			expectedErr: "failed to find additional ConfigMap default/missing",
+		},
+		{
+			name: "key of the user ConfigMap",
+			userConfig: &llamav1alpha1.UserConfigSpec{
+				ConfigMapName:        "run-config",
+				AdditionalConfigMaps: []string{"overrides"},
+			},
+			expectedErr: "failed to mount additional ConfigMap default/overrides: key 'notes.txt' is also provided by ConfigMap run-config",
+		},
+		{
+			name: "only run.yaml is mounted from a custom key",
+			userConfig: &llamav1alpha1.UserConfigSpec{
+				ConfigMapName:        "run-config",
+				ConfigMapKey:         "custom.yaml",
+				AdditionalConfigMaps: []string{"overrides"},
+			},
+		},
+		{
+			name: "key of another additional ConfigMap",
+			userConfig: &llamav1alpha1.UserConfigSpec{
+				ConfigMapName:        "run-config",
+				AdditionalConfigMaps: []string{"provider-files", "provider-files-copy"},
+			},
+			expectedErr: "failed to mount additional ConfigMap default/provider-files-copy: key 'providers.yaml' is also provided by ConfigMap provider-files",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userConfigMap := newConfigMap("run-config", map[string]string{"run.yaml": runYAML, "custom.yaml": runYAML, "notes.txt": "notes"})
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				userConfigMap,
+				newConfigMap("provider-files", map[string]string{"providers.yaml": "providers"}),
+				newConfigMap("provider-files-copy", map[string]string{"providers.yaml": "providers"}),
+				newConfigMap("prompts", map[string]string{"system.txt": "prompt"}),
+				newConfigMap("overrides", map[string]string{"notes.txt": "other notes"}),
+			).Build()
+			r := NewReconciler(cli, scheme.Scheme)
+
+			// --- act ---
+			err := r.reconcileAdditionalConfigMaps(t.Context(), newInstance(tc.userConfig), userConfigMap)
+
+			// --- assert ---
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestGetAdditionalConfigMapsHash(t *testing.T) {
+	prompts := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "prompts", Namespace: "default"},
+		Data:       map[string]string{"system.txt": "prompt"},
+	}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				UserConfig: &llamav1alpha1.UserConfigSpec{ConfigMapName: "run-config", AdditionalConfigMaps: []string{"prompts"}},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(prompts).Build()
+	r := NewReconciler(cli, scheme.Scheme)
+
+	hash, err := r.getAdditionalConfigMapsHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	// --- act: an additional ConfigMap changes ---
+	prompts.Data["system.txt"] = "new prompt"
+	require.NoError(t, cli.Update(t.Context(), prompts))
+	changed, err := r.getAdditionalConfigMapsHash(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+
+	// --- act: no additional ConfigMaps ---
+	instance.Spec.Server.UserConfig.AdditionalConfigMaps = nil
+	none, err := r.getAdditionalConfigMapsHash(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+// newUserConfigsInstance returns an instance in the default namespace with the given user config ConfigMap,
+// if any, and userConfigs.
+func newUserConfigsInstance(userConfig string, userConfigs ...llamav1alpha1.UserConfigSpec) *llamav1alpha1.LlamaStackDistribution {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{UserConfigs: userConfigs},
+		},
+	}
+	if userConfig != "" {
+		instance.Spec.Server.UserConfig = &llamav1alpha1.UserConfigSpec{ConfigMapName: userConfig}
+	}
+	return instance
+}
+
+func TestConfigureUserConfigs(t *testing.T) {
+	r := &LlamaStackDistributionReconciler{}
+	models := llamav1alpha1.UserConfigSpec{ConfigMapName: "models", MountPath: "/etc/models"}
+	prompts := llamav1alpha1.UserConfigSpec{ConfigMapName: "prompts", MountPath: "/etc/prompts"}
+
+	testCases := []struct {
+		name            string
+		instance        *llamav1alpha1.LlamaStackDistribution
+		expectedVolumes map[string]string
+		expectedMounts  map[string]string
+		expectedArgs    []string
+	}{
+		{
+			name:            "userConfigs mounted next to userConfig",
+			instance:        newUserConfigsInstance("run-config", models, prompts),
+			expectedVolumes: map[string]string{"user-config": "run-config", "user-config-1": "models", "user-config-2": "prompts"},
+			expectedMounts:  map[string]string{"user-config": "/etc/llama-stack/", "user-config-1": "/etc/models", "user-config-2": "/etc/prompts"},
+			expectedArgs:    []string{"--config", "/etc/llama-stack/run.yaml"},
+		},
+		{
+			name:            "first entry holds the run configuration",
+			instance:        newUserConfigsInstance("", llamav1alpha1.UserConfigSpec{ConfigMapName: "run-config", MountPath: "/config"}, models),
+			expectedVolumes: map[string]string{"user-config": "run-config", "user-config-1": "models"},
+			expectedMounts:  map[string]string{"user-config": "/config", "user-config-1": "/etc/models"},
+			expectedArgs:    []string{"--config", "/config/run.yaml"},
+		},
+		{
+			name:            "first entry at the default path",
+			instance:        newUserConfigsInstance("", llamav1alpha1.UserConfigSpec{ConfigMapName: "run-config"}),
+			expectedVolumes: map[string]string{"user-config": "run-config"},
+			expectedMounts:  map[string]string{"user-config": "/etc/llama-stack/"},
+			expectedArgs:    []string{"--config", "/etc/llama-stack/run.yaml"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			podSpec := &corev1.PodSpec{}
+			container := &corev1.Container{}
+
+			// --- act ---
+			configureUserConfig(tc.instance, podSpec)
+			addUserConfigVolumeMount(tc.instance, container)
+			configureContainerCommands(r, tc.instance, container)
+
+			// --- assert ---
+			volumes := make(map[string]string)
+			for _, volume := range podSpec.Volumes {
+				require.NotNil(t, volume.ConfigMap, volume.Name)
+				volumes[volume.Name] = volume.ConfigMap.Name
+			}
+			assert.Equal(t, tc.expectedVolumes, volumes)
+			mounts := make(map[string]string)
+			for _, mount := range container.VolumeMounts {
+				assert.True(t, mount.ReadOnly, mount.Name)
+				mounts[mount.Name] = mount.MountPath
+			}
+			assert.Equal(t, tc.expectedMounts, mounts)
+			assert.Equal(t, tc.expectedArgs, container.Args)
+		})
+	}
+}
+
+func TestValidateUserConfigs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userConfig    string
+		userConfigs   []llamav1alpha1.UserConfigSpec
+		expectedError string
+	}{
+		{name: "no userConfigs", userConfig: "run-config"},
+		{
+			name:       "distinct mount paths",
+			userConfig: "run-config",
+			userConfigs: []llamav1alpha1.UserConfigSpec{
+				{ConfigMapName: "models", MountPath: "/etc/models"},
+				{ConfigMapName: "prompts", MountPath: "/etc/prompts"},
+			},
+		},
+		{
+			name: "run configuration entry without mount path",
+			userConfigs: []llamav1alpha1.UserConfigSpec{
+				{ConfigMapName: "run-config"},
+				{ConfigMapName: "models", MountPath: "/etc/models"},
+			},
+		},
+		{
+			name:          "entry without ConfigMap name",
+			userConfig:    "run-config",
+			userConfigs:   []llamav1alpha1.UserConfigSpec{{MountPath: "/etc/models"}},
+			expectedError: "failed to validate userConfigs: entry 0 does not set configMapName",
+		},
+		{
+			name:          "mounted entry without mount path",
+			userConfig:    "run-config",
+			userConfigs:   []llamav1alpha1.UserConfigSpec{{ConfigMapName: "models"}},
+			expectedError: "failed to validate userConfigs: ConfigMap models does not set mountPath",
+		},
+		{
+			name:          "mount path of the run configuration",
+			userConfig:    "run-config",
+			userConfigs:   []llamav1alpha1.UserConfigSpec{{ConfigMapName: "models", MountPath: "/etc/llama-stack"}},
+			expectedError: "failed to validate userConfigs: mount path /etc/llama-stack is already used",
+		},
+		{
+			name:       "duplicate mount paths",
+			userConfig: "run-config",
+			userConfigs: []llamav1alpha1.UserConfigSpec{
+				{ConfigMapName: "models", MountPath: "/etc/models"},
+				{ConfigMapName: "prompts", MountPath: "/etc/models/"},
+			},
+			expectedError: "failed to validate userConfigs: mount path /etc/models is already used",
+		},
+		{
+			name:       "additional ConfigMaps of a mounted entry",
+			userConfig: "run-config",
+			userConfigs: []llamav1alpha1.UserConfigSpec{
+				{ConfigMapName: "models", MountPath: "/etc/models", AdditionalConfigMaps: []string{"prompts"}},
+			},
+			expectedError: "failed to validate userConfigs: ConfigMap models is not the run configuration, " +
+				"configMapKey and additionalConfigMaps are not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateUserConfigs(newUserConfigsInstance(tc.userConfig, tc.userConfigs...))
+
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestReconcileMountedUserConfigs(t *testing.T) {
+	newConfigMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}, Data: data}
+	}
+
+	testCases := []struct {
+		name        string
+		userConfigs []llamav1alpha1.UserConfigSpec
+		expectedErr string
+	}{
+		{
+			name:        "existing ConfigMap",
+			userConfigs: []llamav1alpha1.UserConfigSpec{{ConfigMapName: "models", MountPath: "/etc/models"}},
+		},
+		{
+			name:        "missing ConfigMap",
+			userConfigs: []llamav1alpha1.UserConfigSpec{{ConfigMapName: "missing", MountPath: "/etc/missing"}},
+			expectedErr: "failed to find user ConfigMap default/missing",
+		},
+		{
+			name:        "malformed YAML entry",
+			userConfigs: []llamav1alpha1.UserConfigSpec{{ConfigMapName: "malformed", MountPath: "/etc/malformed"}},
+			expectedErr: "failed to validate ConfigMap default/malformed: failed to parse 'models.yaml': " +
+				"yaml: found character that cannot start any token",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+				newConfigMap("models", map[string]string{"models.yaml": "models: []\n"}),
+				newConfigMap("malformed", map[string]string{"models.yaml": "\tmodels: []\n"}),
+			).Build()
+			r := NewReconciler(cli, scheme.Scheme)
+			r.ValidateConfigMapContent = true
+			instance := newUserConfigsInstance("run-config", tc.userConfigs...)
+
+			// --- act ---
+			err := r.reconcileMountedUserConfigs(t.Context(), instance)
+
+			// --- assert ---
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestGetUserConfigsHash(t *testing.T) {
+	models := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "default"},
+		Data:       map[string]string{"models.yaml": "models: []"},
+	}
+	prompts := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "prompts", Namespace: "default"},
+		Data:       map[string]string{"system.txt": "prompt"},
+	}
+	modelsConfig := llamav1alpha1.UserConfigSpec{ConfigMapName: "models", MountPath: "/etc/models"}
+	promptsConfig := llamav1alpha1.UserConfigSpec{ConfigMapName: "prompts", MountPath: "/etc/prompts"}
+	instance := newUserConfigsInstance("", modelsConfig, promptsConfig)
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(models, prompts).Build()
+	r := NewReconciler(cli, scheme.Scheme)
+
+	hash, err := r.getUserConfigsHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	// --- act: the entries are reordered ---
+	instance.Spec.Server.UserConfigs = []llamav1alpha1.UserConfigSpec{promptsConfig, modelsConfig}
+	reordered, err := r.getUserConfigsHash(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.Equal(t, hash, reordered)
+
+	// --- act: a ConfigMap changes ---
+	prompts.Data["system.txt"] = "new prompt"
+	require.NoError(t, cli.Update(t.Context(), prompts))
+	changed, err := r.getUserConfigsHash(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changed)
+
+	// --- act: no userConfigs ---
+	instance.Spec.Server.UserConfigs = nil
+	none, err := r.getUserConfigsHash(t.Context(), instance)
+
+	// --- assert ---
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestConcatenateCABundle(t *testing.T) {
+	const certA = "-----BEGIN CERTIFICATE-----\nQUFB\n-----END CERTIFICATE-----"
+	const certB = "-----BEGIN CERTIFICATE-----\nQkJC\n-----END CERTIFICATE-----"
+
+	t.Run("declared order with normalized newlines", func(t *testing.T) {
+		bundle, missing := concatenateCABundle(map[string]string{
+			"a.crt": certA + "\r\n\n",
+			"b.crt": certB,
+			"empty": "\n",
+		}, []string{"b.crt", "empty", "a.crt"})
+
+		assert.Equal(t, certB+"\n"+certA+"\n", bundle)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("trailing newlines do not change the bundle", func(t *testing.T) {
+		keys := []string{"a.crt", "b.crt"}
+		bundle, _ := concatenateCABundle(map[string]string{"a.crt": certA, "b.crt": certB}, keys)
+		withNewlines, _ := concatenateCABundle(map[string]string{"a.crt": certA + "\n", "b.crt": certB + "\n\n"}, keys)
+
+		assert.Equal(t, bundle, withNewlines)
+	})
+
+	t.Run("missing keys", func(t *testing.T) {
+		bundle, missing := concatenateCABundle(map[string]string{"a.crt": certA},
+			[]string{"missing.crt", "a.crt", "other.crt"})
+
+		assert.Equal(t, certA+"\n", bundle)
+		assert.Equal(t, []string{"missing.crt", "other.crt"}, missing)
+	})
+}
+
+func TestCreateCABundleVolume(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+
+	single := createCABundleVolume(instance, &llamav1alpha1.CABundleConfig{ConfigMapName: "custom-ca-bundle"})
+	require.NotNil(t, single.ConfigMap)
+	assert.Equal(t, "custom-ca-bundle", single.ConfigMap.Name)
+
+	multiple := createCABundleVolume(instance, &llamav1alpha1.CABundleConfig{
+		ConfigMapName: "custom-ca-bundle",
+		ConfigMapKeys: []string{"a.crt", "b.crt"},
+	})
+	require.NotNil(t, multiple.ConfigMap)
+	assert.Equal(t, "test-instance-ca-bundle", multiple.ConfigMap.Name)
+}
+
+func TestConfigurePodStorageCABundle(t *testing.T) {
+	testCases := []struct {
+		name              string
+		keys              []string
+		expectedConfigMap string
+	}{
+		// A single key is mounted straight from the ConfigMap of the user
+		{name: "single key", expectedConfigMap: "custom-ca-bundle"},
+		// Multiple keys are concatenated by the operator into a derived ConfigMap, see reconcileCABundle
+		{name: "multiple keys", keys: []string{"a.crt", "b.crt"}, expectedConfigMap: "test-instance-ca-bundle"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						TLSConfig: &llamav1alpha1.TLSConfig{
+							CABundle: &llamav1alpha1.CABundleConfig{ConfigMapName: "custom-ca-bundle", ConfigMapKeys: tc.keys},
+						},
+					},
+				},
+			}
+			container := buildContainerSpec(t.Context(), nil, instance, "test-image:latest")
+
+			podSpec := configurePodStorage(t.Context(), nil, instance, container)
+
+			// The CA bundle volume holds the ConfigMap, no init container is needed to assemble the bundle
+			require.Contains(t, podSpec.Volumes, corev1.Volume{
+				Name: CABundleVolumeName,
+				VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: tc.expectedConfigMap},
+				}},
+			})
+			require.Empty(t, podSpec.InitContainers)
+
+			// The server container reads the bundle from the mounted file
+			server := podSpec.Containers[0]
+			require.Contains(t, server.VolumeMounts, corev1.VolumeMount{
+				Name:      CABundleVolumeName,
+				MountPath: CABundleMountPath,
+				SubPath:   DefaultCABundleKey,
+				ReadOnly:  true,
+			})
+			require.Contains(t, server.Env, corev1.EnvVar{Name: "SSL_CERT_FILE", Value: CABundleMountPath})
+		})
+	}
+}
+
+func TestValidateConfigMapKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		keys        []string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid keys",
+			keys:        []string{DefaultCABundleKey, "intermediate.pem", "root-ca.cert"},
+			expectError: false,
+		},
+		{
+			name:        "empty key",
+			keys:        []string{""},
+			expectError: true,
+			errorMsg:    "ConfigMap key cannot be empty",
+		},
+		{
+			name:        "command injection attempt",
+			keys:        []string{"valid-key; rm -rf /; echo malicious"},
+			expectError: true,
+			errorMsg:    "contains invalid characters",
+		},
+		{
+			name:        "path traversal attempt",
+			keys:        []string{"../../../etc/passwd"},
+			expectError: true,
+			errorMsg:    "contains invalid characters",
+		},
+		{
+			name:        "shell metacharacters",
+			keys:        []string{"key$(whoami)"},
+			expectError: true,
+			errorMsg:    "contains invalid characters",
+		},
+		{
+			name:        "pipe injection",
+			keys:        []string{"key | cat /etc/passwd"},
+			expectError: true,
+			errorMsg:    "contains invalid characters",
+		},
+		{
+			name:        "too long key",
+			keys:        []string{strings.Repeat("a", 254)},
+			expectError: true,
+			errorMsg:    "too long",
+		},
+		{
+			name:        "valid alphanumeric with allowed chars",
+			keys:        []string{"ca_bundle-v1.2.crt"},
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConfigMapKeys(tt.keys)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				} else if tt.errorMsg != "" && !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("Expected error message to contain '%s', got '%s'", tt.errorMsg, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// newDefaultReadinessProbe returns a Kubernetes HTTP readiness probe that checks
+// the "/v1/health" endpoint on the given port using default timing and
+// threshold settings.
+func newDefaultReadinessProbe(port int32) *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: "/v1/health",
+				Port: intstr.FromInt(int(port)),
+			},
+		},
+		InitialDelaySeconds: readinessProbeInitialDelaySeconds,
+		PeriodSeconds:       readinessProbePeriodSeconds,
+		TimeoutSeconds:      llamav1alpha1.DefaultHealthCheckTimeoutSeconds,
+		FailureThreshold:    readinessProbeFailureThreshold,
+		SuccessThreshold:    readinessProbeSuccessThreshold,
+	}
+}
+
+func newDefaultLivenessProbe(port int32) *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: "/v1/health",
+				Port: intstr.FromInt(int(port)),
+			},
+		},
+		InitialDelaySeconds: livenessProbeInitialDelaySeconds,
+		PeriodSeconds:       livenessProbePeriodSeconds,
+		TimeoutSeconds:      llamav1alpha1.DefaultHealthCheckTimeoutSeconds,
+		FailureThreshold:    livenessProbeFailureThreshold,
+		SuccessThreshold:    1,
+	}
+}
+
+func TestBuildIngress(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					Ingress: &llamav1alpha1.IngressSpec{Enabled: true},
+				},
+			},
+		}
+
+		ingress := buildIngress(instance)
+
+		assert.Equal(t, "test-instance-ingress", ingress.Name)
+		assert.Equal(t, "default", ingress.Namespace)
+		assert.Nil(t, ingress.Spec.IngressClassName)
+		assert.Empty(t, ingress.Spec.TLS)
+		require.Len(t, ingress.Spec.Rules, 1)
+		assert.Empty(t, ingress.Spec.Rules[0].Host)
+		require.Len(t, ingress.Spec.Rules[0].HTTP.Paths, 1)
+		path := ingress.Spec.Rules[0].HTTP.Paths[0]
+		assert.Equal(t, "/", path.Path)
+		assert.Equal(t, ptr.To(networkingv1.PathTypePrefix), path.PathType)
+		assert.Equal(t, "test-instance-service", path.Backend.Service.Name)
+		assert.Equal(t, llamav1alpha1.DefaultServerPort, path.Backend.Service.Port.Number)
+	})
+
+	t.Run("host, TLS and annotations", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					ContainerSpec: llamav1alpha1.ContainerSpec{Port: 9000},
+					Ingress: &llamav1alpha1.IngressSpec{
+						Enabled:          true,
+						Host:             "llama.example.com",
+						Path:             "/api",
+						IngressClassName: ptr.To("nginx"),
+						TLSSecretName:    "llama-tls",
+						Annotations:      map[string]string{"nginx.ingress.kubernetes.io/proxy-read-timeout": "600"},
+					},
+				},
+			},
+		}
+
+		ingress := buildIngress(instance)
+
+		assert.Equal(t, ptr.To("nginx"), ingress.Spec.IngressClassName)
+		assert.Equal(t, "600", ingress.Annotations["nginx.ingress.kubernetes.io/proxy-read-timeout"])
+		assert.Equal(t, []networkingv1.IngressTLS{{Hosts: []string{"llama.example.com"}, SecretName: "llama-tls"}}, ingress.Spec.TLS)
+		require.Len(t, ingress.Spec.Rules, 1)
+		assert.Equal(t, "llama.example.com", ingress.Spec.Rules[0].Host)
+		path := ingress.Spec.Rules[0].HTTP.Paths[0]
+		assert.Equal(t, "/api", path.Path)
+		assert.Equal(t, int32(9000), path.Backend.Service.Port.Number)
+	})
+}
+
+func TestGetIngressURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     llamav1alpha1.IngressSpec
+		status   networkingv1.IngressStatus
+		expected string
+	}{
+		{
+			name:     "host from spec with TLS",
+			spec:     llamav1alpha1.IngressSpec{Enabled: true, Host: "llama.example.com", Path: "/api", TLSSecretName: "llama-tls"},
+			expected: "https://llama.example.com/api",
+		},
+		{
+			name: "address published by the ingress controller",
+			spec: llamav1alpha1.IngressSpec{Enabled: true},
+			status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+				Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}},
+			}},
+			expected: "http://10.0.0.1",
+		},
+		{
+			name: "no host and not admitted",
+			spec: llamav1alpha1.IngressSpec{Enabled: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{Ingress: &tc.spec},
+				},
+			}
+			ingress := &networkingv1.Ingress{Status: tc.status}
+
+			assert.Equal(t, tc.expected, getIngressURL(instance, ingress))
+		})
+	}
+}
+
+func TestBuildServiceMonitor(t *testing.T) {
+	testCases := []struct {
+		name         string
+		metrics      llamav1alpha1.MetricsSpec
+		healthCheck  *llamav1alpha1.HealthCheckSpec
+		expectedPort string
+		expectedPath string
+	}{
+		{
+			name:         "metrics on the server port",
+			metrics:      llamav1alpha1.MetricsSpec{Enabled: true},
+			expectedPort: "http",
+			expectedPath: "/metrics",
+		},
+		{
+			name:         "metrics on the health port",
+			metrics:      llamav1alpha1.MetricsSpec{Enabled: true, Port: 8080},
+			healthCheck:  &llamav1alpha1.HealthCheckSpec{Port: 8080},
+			expectedPort: "health",
+			expectedPath: "/metrics",
+		},
+		{
+			name:         "metrics on a port of their own",
+			metrics:      llamav1alpha1.MetricsSpec{Enabled: true, Port: 9090, Path: "/v1/metrics"},
+			expectedPort: "metrics",
+			expectedPath: "/v1/metrics",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						Monitoring:  &llamav1alpha1.MonitoringSpec{Metrics: &tc.metrics},
+						HealthCheck: tc.healthCheck,
+					},
+				},
+			}
+
+			serviceMonitor := buildServiceMonitor(instance)
+
+			assert.Equal(t, deploy.ServiceMonitorGVK, serviceMonitor.GroupVersionKind())
+			assert.Equal(t, "test-instance-service-monitor", serviceMonitor.GetName())
+			assert.Equal(t, "default", serviceMonitor.GetNamespace())
+			selector, _, _ := unstructured.NestedStringMap(serviceMonitor.Object, "spec", "selector", "matchLabels")
+			assert.Equal(t, "test-instance", selector["app.kubernetes.io/instance"])
+			endpoints, _, _ := unstructured.NestedSlice(serviceMonitor.Object, "spec", "endpoints")
+			require.Len(t, endpoints, 1)
+			assert.Equal(t, map[string]any{"port": tc.expectedPort, "path": tc.expectedPath}, endpoints[0])
+		})
+	}
+}
+
+func TestReconcileServiceMonitor(t *testing.T) {
+	monitoringGroupVersion := schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
+	withServiceMonitor := meta.NewDefaultRESTMapper([]schema.GroupVersion{monitoringGroupVersion})
+	withServiceMonitor.Add(deploy.ServiceMonitorGVK, meta.RESTScopeNamespace)
+	testScheme := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	testCases := []struct {
+		name          string
+		restMapper    meta.RESTMapper
+		enabled       bool
+		expectCreated bool
+	}{
+		{name: "CRD installed and metrics enabled", restMapper: withServiceMonitor, enabled: true, expectCreated: true},
+		{name: "CRD installed and metrics disabled", restMapper: withServiceMonitor},
+		{name: "CRD missing", restMapper: meta.NewDefaultRESTMapper(nil), enabled: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						Monitoring: &llamav1alpha1.MonitoringSpec{Metrics: &llamav1alpha1.MetricsSpec{Enabled: tc.enabled}},
+					},
+				},
+			}
+			cli := fake.NewClientBuilder().WithScheme(testScheme).WithRESTMapper(tc.restMapper).Build()
+			available, err := cluster.IsServiceMonitorAvailable(cli)
+			require.NoError(t, err)
+			r := NewReconciler(cli, testScheme)
+			r.ClusterInfo = &cluster.ClusterInfo{ServiceMonitorAvailable: available}
+
+			// --- act ---
+			require.NoError(t, r.reconcileServiceMonitor(t.Context(), instance))
+
+			// --- assert ---
+			serviceMonitor := deploy.NewServiceMonitor()
+			err = cli.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: "test-instance-service-monitor"}, serviceMonitor)
+			if tc.expectCreated {
+				require.NoError(t, err)
+				require.Len(t, serviceMonitor.GetOwnerReferences(), 1)
+				assert.Equal(t, instance.UID, serviceMonitor.GetOwnerReferences()[0].UID)
+			} else {
+				assert.True(t, k8serrors.IsNotFound(err), "no ServiceMonitor should be created, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBuildRoute(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					Ingress: &llamav1alpha1.IngressSpec{Enabled: true},
+				},
+			},
+		}
+
+		route := buildRoute(instance)
+
+		assert.Equal(t, deploy.RouteGVK, route.GroupVersionKind())
+		assert.Equal(t, "test-instance", route.GetName())
+		assert.Equal(t, "default", route.GetNamespace())
+		serviceName, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+		assert.Equal(t, "test-instance-service", serviceName)
+		targetPort, _, _ := unstructured.NestedString(route.Object, "spec", "port", "targetPort")
+		assert.Equal(t, "http", targetPort)
+		_, found, _ := unstructured.NestedString(route.Object, "spec", "host")
+		assert.False(t, found)
+		_, found, _ = unstructured.NestedMap(route.Object, "spec", "tls")
+		assert.False(t, found)
+	})
+
+	t.Run("host, path and edge TLS", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					Ingress: &llamav1alpha1.IngressSpec{
+						Enabled:     true,
+						Host:        "llama.apps.example.com",
+						Path:        "/api",
+						EdgeTLS:     true,
+						Annotations: map[string]string{"haproxy.router.openshift.io/timeout": "600s"},
+					},
+				},
+			},
+		}
+
+		route := buildRoute(instance)
+
+		assert.Equal(t, "600s", route.GetAnnotations()["haproxy.router.openshift.io/timeout"])
+		host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+		assert.Equal(t, "llama.apps.example.com", host)
+		path, _, _ := unstructured.NestedString(route.Object, "spec", "path")
+		assert.Equal(t, "/api", path)
+		tls, _, _ := unstructured.NestedStringMap(route.Object, "spec", "tls")
+		assert.Equal(t, map[string]string{"termination": "edge", "insecureEdgeTerminationPolicy": "Redirect"}, tls)
+	})
+
+	t.Run("route spec", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{
+					Route: &llamav1alpha1.RouteSpec{
+						Enabled:     true,
+						Host:        "llama.apps.example.com",
+						Path:        "/v1",
+						EdgeTLS:     true,
+						Annotations: map[string]string{"haproxy.router.openshift.io/timeout": "600s"},
+					},
+				},
+			},
+		}
+
+		route := buildRoute(instance)
+
+		assert.Equal(t, "600s", route.GetAnnotations()["haproxy.router.openshift.io/timeout"])
+		host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+		assert.Equal(t, "llama.apps.example.com", host)
+		path, _, _ := unstructured.NestedString(route.Object, "spec", "path")
+		assert.Equal(t, "/v1", path)
+		tls, _, _ := unstructured.NestedStringMap(route.Object, "spec", "tls")
+		assert.Equal(t, map[string]string{"termination": "edge", "insecureEdgeTerminationPolicy": "Redirect"}, tls)
+		assert.Equal(t, "https://llama.apps.example.com/v1", getRouteURL(instance, "llama.apps.example.com"))
+	})
+}
+
+func TestReconcileRoute(t *testing.T) {
+	routeGroupVersion := schema.GroupVersion{Group: deploy.RouteGVK.Group, Version: deploy.RouteGVK.Version}
+	withRoute := meta.NewDefaultRESTMapper([]schema.GroupVersion{routeGroupVersion})
+	withRoute.Add(deploy.RouteGVK, meta.RESTScopeNamespace)
+	testScheme := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	testCases := []struct {
+		name          string
+		restMapper    meta.RESTMapper
+		ingress       *llamav1alpha1.IngressSpec
+		expectCreated bool
+		expectedError string
+	}{
+		{name: "Route API served", restMapper: withRoute, expectCreated: true},
+		{name: "Route API missing", restMapper: meta.NewDefaultRESTMapper(nil),
+			expectedError: "the cluster does not serve the OpenShift route.openshift.io/v1 API"},
+		{name: "Ingress enabled as well", restMapper: withRoute, ingress: &llamav1alpha1.IngressSpec{Enabled: true},
+			expectedError: "spec.server.route and spec.server.ingress cannot both be enabled"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						Route:   &llamav1alpha1.RouteSpec{Enabled: true, Host: "llama.apps.example.com"},
+						Ingress: tc.ingress,
+					},
+				},
+			}
+			cli := fake.NewClientBuilder().WithScheme(testScheme).WithRESTMapper(tc.restMapper).Build()
+			available, err := cluster.IsRouteAvailable(cli)
+			require.NoError(t, err)
+			r := NewReconciler(cli, testScheme)
+			r.ClusterInfo = &cluster.ClusterInfo{RouteAvailable: available}
+
+			// --- act ---
+			err = r.reconcileIngress(t.Context(), instance)
+
+			// --- assert ---
+			route := deploy.NewRoute()
+			getErr := cli.Get(t.Context(), types.NamespacedName{Namespace: "default", Name: deploy.GetRouteName(instance)}, route)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				condition := GetCondition(&instance.Status, ConditionTypeRouteReady)
+				require.NotNil(t, condition)
+				assert.Equal(t, metav1.ConditionFalse, condition.Status)
+				assert.Equal(t, ReasonRouteFailed, condition.Reason)
+				assert.Contains(t, condition.Message, tc.expectedError)
+				assert.Error(t, getErr, "no Route should be created")
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, getErr)
+			host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+			assert.Equal(t, "llama.apps.example.com", host)
+			require.Len(t, route.GetOwnerReferences(), 1)
+			assert.Equal(t, instance.UID, route.GetOwnerReferences()[0].UID)
+			assert.Nil(t, GetCondition(&instance.Status, ConditionTypeIngressReady))
+		})
+	}
+}
+
+func TestDetermineKindsToExclude(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ingress         *llamav1alpha1.IngressSpec
+		excludesIngress bool
+	}{
+		{name: "Ingress not configured", excludesIngress: true},
+		{name: "Ingress disabled", ingress: &llamav1alpha1.IngressSpec{}, excludesIngress: true},
+		{name: "Ingress enabled", ingress: &llamav1alpha1.IngressSpec{Enabled: true}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{Ingress: tc.ingress},
+				},
+			}
+			r := &LlamaStackDistributionReconciler{}
+
+			kinds := r.determineKindsToExclude(instance)
+
+			assert.Equal(t, tc.excludesIngress, slices.Contains(kinds, "Ingress"))
+			assert.Contains(t, kinds, "ServiceAccount")
+		})
+	}
+}
+
+func TestGetRouteHost(t *testing.T) {
+	routeWithIngress := func(status string) *unstructured.Unstructured {
+		route := deploy.NewRoute()
+		route.Object["status"] = map[string]any{
+			"ingress": []any{
+				map[string]any{
+					"host":       "test-instance-default.apps.example.com",
+					"conditions": []any{map[string]any{"type": "Admitted", "status": status}},
+				},
+			},
+		}
+		return route
+	}
+
+	assert.Equal(t, "test-instance-default.apps.example.com", getRouteHost(routeWithIngress("True")))
+	assert.Empty(t, getRouteHost(routeWithIngress("False")))
+	assert.Empty(t, getRouteHost(deploy.NewRoute()))
+
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{Ingress: &llamav1alpha1.IngressSpec{Enabled: true, EdgeTLS: true}},
+		},
+	}
+	assert.Equal(t, "https://llama.apps.example.com", getRouteURL(instance, "llama.apps.example.com"))
+	assert.Empty(t, getRouteURL(instance, ""))
+}
+
+func TestReplicaRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        llamav1alpha1.LlamaStackDistributionSpec
+		expectedMin int32
+		expectedMax int32
+	}{
+		{
+			name:        "fixed replicas",
+			spec:        llamav1alpha1.LlamaStackDistributionSpec{Replicas: 3},
+			expectedMin: 3,
+			expectedMax: 3,
+		},
+		{
+			name: "autoscaling with default minimum",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas:    3,
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{MaxReplicas: 5},
+			},
+			expectedMin: 1,
+			expectedMax: 5,
+		},
+		{
+			name: "autoscaling with minimum",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{MinReplicas: ptr.To(int32(2)), MaxReplicas: 4},
+			},
+			expectedMin: 2,
+			expectedMax: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minReplicas, maxReplicas := replicaRange(&llamav1alpha1.LlamaStackDistribution{Spec: tt.spec})
+			assert.Equal(t, tt.expectedMin, minReplicas)
+			assert.Equal(t, tt.expectedMax, maxReplicas)
+		})
+	}
+}
+
+func TestBuildHorizontalPodAutoscaler(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{MaxReplicas: 3},
+			},
+		}
+
+		hpa := buildHorizontalPodAutoscaler(instance)
+
+		assert.Equal(t, "test-instance-hpa", hpa.Name)
+		assert.Equal(t, "default", hpa.Namespace)
+		assert.Equal(t, autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "test-instance"},
+			hpa.Spec.ScaleTargetRef)
+		assert.Equal(t, ptr.To(int32(1)), hpa.Spec.MinReplicas)
+		assert.Equal(t, int32(3), hpa.Spec.MaxReplicas)
+		assert.Empty(t, hpa.Spec.Metrics, "HorizontalPodAutoscaler should apply its default CPU target")
+	})
+
+	t.Run("CPU and memory targets", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{
+					MinReplicas:                       ptr.To(int32(2)),
+					MaxReplicas:                       6,
+					TargetCPUUtilizationPercentage:    ptr.To(int32(70)),
+					TargetMemoryUtilizationPercentage: ptr.To(int32(85)),
+				},
+			},
+		}
+
+		hpa := buildHorizontalPodAutoscaler(instance)
+
+		assert.Equal(t, ptr.To(int32(2)), hpa.Spec.MinReplicas)
+		assert.Equal(t, int32(6), hpa.Spec.MaxReplicas)
+		require.Len(t, hpa.Spec.Metrics, 2)
+		assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+		assert.Equal(t, ptr.To(int32(70)), hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+		assert.Equal(t, corev1.ResourceMemory, hpa.Spec.Metrics[1].Resource.Name)
+		assert.Equal(t, ptr.To(int32(85)), hpa.Spec.Metrics[1].Resource.Target.AverageUtilization)
+	})
+
+	t.Run("custom metrics follow the utilization targets", func(t *testing.T) {
+		requestsMetric := autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "llama_stack_requests_per_second"},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: ptr.To(resource.MustParse("10")),
+				},
+			},
+		}
+		instance := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{
+					MaxReplicas:                    4,
+					TargetCPUUtilizationPercentage: ptr.To(int32(70)),
+					Metrics:                        []autoscalingv2.MetricSpec{requestsMetric},
+				},
+			},
+		}
+
+		hpa := buildHorizontalPodAutoscaler(instance)
+
+		require.Len(t, hpa.Spec.Metrics, 2)
+		assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+		assert.Equal(t, requestsMetric, hpa.Spec.Metrics[1])
+		assert.NotSame(t, instance.Spec.Autoscaling.Metrics[0].Pods, hpa.Spec.Metrics[1].Pods,
+			"metrics should be copied from the spec")
+	})
+}
+
+func TestGetDisruptionBudget(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     llamav1alpha1.LlamaStackDistributionSpec
+		expected *llamav1alpha1.PodDisruptionBudgetSpec
+	}{
+		{
+			name: "single replica gets no default budget",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{Replicas: 1},
+		},
+		{
+			name:     "multiple replicas keep one pod available",
+			spec:     llamav1alpha1.LlamaStackDistributionSpec{Replicas: 3},
+			expected: &llamav1alpha1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(1))},
+		},
+		{
+			name: "autoscaling down to one replica gets no default budget",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas:    3,
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{MaxReplicas: 5},
+			},
+		},
+		{
+			name: "autoscaling above one replica keeps one pod available",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas:    1,
+				Autoscaling: &llamav1alpha1.AutoscalingSpec{MinReplicas: ptr.To(int32(2)), MaxReplicas: 5},
+			},
+			expected: &llamav1alpha1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(1))},
+		},
+		{
+			name: "explicit budget is used even for a single replica",
+			spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Replicas:         1,
+				DisruptionBudget: &llamav1alpha1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("50%"))},
+			},
+			expected: &llamav1alpha1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("50%"))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getDisruptionBudget(&llamav1alpha1.LlamaStackDistribution{Spec: tt.spec}))
+		})
+	}
+}
+
+func TestBuildPodDisruptionBudget(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+
+	pdb := buildPodDisruptionBudget(instance, &llamav1alpha1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt32(1))})
+
+	assert.Equal(t, "test-instance-pdb", pdb.Name)
+	assert.Equal(t, "default", pdb.Namespace)
+	assert.Equal(t, &metav1.LabelSelector{MatchLabels: deploy.GetSelectorLabels(instance)}, pdb.Spec.Selector,
+		"PodDisruptionBudget should select the pods of the Deployment")
+	assert.Nil(t, pdb.Spec.MinAvailable)
+	assert.Equal(t, ptr.To(intstr.FromInt32(1)), pdb.Spec.MaxUnavailable)
+}
+
+func TestDeploymentStorageBackend(t *testing.T) {
+	deployment := func(volumes ...corev1.Volume) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Volumes: volumes}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		expected   string
+	}{
+		{
+			name: "emptyDir",
+			deployment: deployment(corev1.Volume{
+				Name:         storageVolumeName,
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}),
+			expected: storageBackendEmptyDir,
+		},
+		{
+			name: "PVC",
+			deployment: deployment(
+				corev1.Volume{Name: "user-config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+				corev1.Volume{
+					Name: storageVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "test-pvc"},
+					},
+				},
+			),
+			expected: storageBackendPVC,
+		},
+		{
+			name:       "no data volume",
+			deployment: deployment(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, deploymentStorageBackend(tt.deployment))
+		})
+	}
+}
+
+// roundTripFunc serves the requests of an http.Client with a function.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestResponse returns a response with the given status code and body.
+func newTestResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+// withFastServerRequestBackoff shortens the backoff between the retries of the requests to the server.
+func withFastServerRequestBackoff(t *testing.T) {
+	t.Helper()
+	backoff := serverRequestBackoff
+	serverRequestBackoff = wait.Backoff{Steps: backoff.Steps, Duration: time.Millisecond, Factor: backoff.Factor}
+	t.Cleanup(func() { serverRequestBackoff = backoff })
+}
+
+func TestServerRequestRetry(t *testing.T) {
+	withFastServerRequestBackoff(t)
+	const providersBody = `{"data": [{"api": "inference", "provider_id": "ollama", "provider_type": "remote::ollama", ` +
+		`"config": {}, "health": {"status": "OK"}}]}`
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+
+	testCases := []struct {
+		name             string
+		failures         []func() (*http.Response, error)
+		expectProviders  bool
+		expectedAttempts int
+	}{
+		{
+			name: "server fails twice then succeeds",
+			failures: []func() (*http.Response, error){
+				func() (*http.Response, error) { return nil, errors.New("connection refused") },
+				func() (*http.Response, error) { return newTestResponse(http.StatusServiceUnavailable, ""), nil },
+			},
+			expectProviders:  true,
+			expectedAttempts: 3,
+		},
+		{
+			name: "server keeps failing",
+			failures: []func() (*http.Response, error){
+				func() (*http.Response, error) { return newTestResponse(http.StatusBadGateway, ""), nil },
+				func() (*http.Response, error) { return newTestResponse(http.StatusBadGateway, ""), nil },
+				func() (*http.Response, error) { return newTestResponse(http.StatusBadGateway, ""), nil },
+			},
+			expectedAttempts: 3,
+		},
+		{
+			name: "client errors are not retried",
+			failures: []func() (*http.Response, error){
+				func() (*http.Response, error) { return newTestResponse(http.StatusNotFound, ""), nil },
+			},
+			expectedAttempts: 1,
+		},
+		{
+			name: "timeouts are not retried",
+			failures: []func() (*http.Response, error){
+				func() (*http.Response, error) { return nil, context.DeadlineExceeded },
+			},
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				attempts++
+				if attempts <= len(tc.failures) {
+					return tc.failures[attempts-1]()
+				}
+				return newTestResponse(http.StatusOK, providersBody), nil
+			})}
+			r := NewReconciler(nil, scheme.Scheme, WithHTTPClient(httpClient))
+
+			providers, err := r.getProviderInfo(t.Context(), instance)
+
+			assert.Equal(t, tc.expectedAttempts, attempts)
+			if !tc.expectProviders {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, providers, 1)
+			assert.Equal(t, "ollama", providers[0].ProviderID)
+		})
+	}
+}
+
+func TestPerformHealthChecksKeepsProvidersUntilRepeatedFailures(t *testing.T) {
+	withFastServerRequestBackoff(t)
+	providers := []llamav1alpha1.ProviderInfo{{ProviderID: "ollama", Health: llamav1alpha1.ProviderHealthStatus{Status: "OK"}}}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+	SetStatusProviders(&instance.Status.DistributionConfig, providers, DefaultMaxStatusProviders, time.Now())
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(http.StatusServiceUnavailable, ""), nil
+	})}
+	r := NewReconciler(nil, scheme.Scheme, WithHTTPClient(httpClient))
+	t.Cleanup(func() { forgetInstanceMetrics(types.NamespacedName{Namespace: "default", Name: "test-instance"}) })
+
+	// --- act: the server fails below the failure threshold ---
+	for range endpointBreakerFailureThreshold - 1 {
+		r.performHealthChecks(t.Context(), instance)
+	}
+
+	// --- assert: the last known providers are kept ---
+	assert.Equal(t, providers, instance.Status.DistributionConfig.Providers)
+	assert.True(t, instance.Status.DistributionConfig.ProvidersStale)
+
+	// --- act: the server fails once more ---
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert: the providers are cleared ---
+	assert.Empty(t, instance.Status.DistributionConfig.Providers)
+	assert.Nil(t, instance.Status.DistributionConfig.ProvidersLastUpdated)
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeUnreachable))
+}
+
+func TestPerformHealthChecksSkipsEndpointsOfUnhealthyServer(t *testing.T) {
+	withFastServerRequestBackoff(t)
+	var queried []string
+	healthy := false
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		queried = append(queried, req.URL.Path)
+		if !healthy {
+			return newTestResponse(http.StatusServiceUnavailable, ""), nil
+		}
+		return newTestResponse(http.StatusOK, `{"data": []}`), nil
+	})}
+	r := NewReconciler(nil, scheme.Scheme, WithHTTPClient(httpClient))
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+	SetStatusProviders(&instance.Status.DistributionConfig, nil, DefaultMaxStatusProviders, time.Now())
+	t.Cleanup(func() { forgetInstanceMetrics(types.NamespacedName{Namespace: "default", Name: "test-instance"}) })
+
+	// --- act: the health check fails ---
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert: only the health endpoint is queried ---
+	assert.Equal(t, []string{"/v1/health"}, queried)
+	assert.True(t, instance.Status.DistributionConfig.ProvidersStale)
+
+	// --- act: the server recovers ---
+	queried = nil
+	healthy = true
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert ---
+	assert.Equal(t, []string{"/v1/health", "/v1/providers", "/v1/version", "/v1/models"}, queried)
+	assert.False(t, instance.Status.DistributionConfig.ProvidersStale)
+}
+
+func TestPerformHealthChecksRecordsTransitions(t *testing.T) {
+	withFastServerRequestBackoff(t)
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+	healthy := false
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if !healthy {
+			return newTestResponse(http.StatusServiceUnavailable, ""), nil
+		}
+		return newTestResponse(http.StatusOK, `{"data": []}`), nil
+	})}
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(nil, scheme.Scheme, WithHTTPClient(httpClient))
+	r.Recorder = recorder
+	t.Cleanup(func() { forgetInstanceMetrics(types.NamespacedName{Namespace: "default", Name: "test-instance"}) })
+
+	// --- act: the server keeps failing below the failure threshold ---
+	for range endpointBreakerFailureThreshold - 1 {
+		r.performHealthChecks(t.Context(), instance)
+	}
+
+	// --- assert: only the first failure is recorded ---
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Warning "+EventReasonHealthCheckFailed+" ")
+
+	// --- act: the server recovers, then stays healthy ---
+	healthy = true
+	r.performHealthChecks(t.Context(), instance)
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert: only the recovery is recorded ---
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Normal "+EventReasonHealthCheckRecovered+" ")
+}
+
+func TestGetModelsInfo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected []llamav1alpha1.ModelInfo
+	}{
+		{
+			name: "fields at the top level",
+			body: `{"data": [{"identifier": "llama3.2:1b", "provider_id": "ollama", "provider_resource_id": "llama3.2:1b",
+				"model_type": "llm", "type": "model"}]}`,
+			expected: []llamav1alpha1.ModelInfo{{ModelID: "llama3.2:1b", ProviderID: "ollama", ModelType: "llm"}},
+		},
+		{
+			name: "OpenAI-compatible models",
+			body: `{"data": [{"id": "sentence-transformers/all-MiniLM-L6-v2", "object": "model", "owned_by": "llama_stack",
+				"custom_metadata": {"provider_id": "sentence-transformers", "model_type": "embedding"}}]}`,
+			expected: []llamav1alpha1.ModelInfo{{
+				ModelID: "sentence-transformers/all-MiniLM-L6-v2", ProviderID: "sentence-transformers", ModelType: "embedding",
+			}},
+		},
+		{name: "no models", body: `{"data": []}`, expected: []llamav1alpha1.ModelInfo{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/v1/models", req.URL.Path)
+				return newTestResponse(http.StatusOK, tc.body), nil
+			})}
+			r := NewReconciler(nil, scheme.Scheme, WithHTTPClient(httpClient))
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			}
+
+			models, err := r.getModelsInfo(t.Context(), instance)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, models)
+		})
+	}
+}
+
+func TestPerformHealthChecksModels(t *testing.T) {
+	withFastServerRequestBackoff(t)
+	modelsAvailable := true
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v1/models" {
+			return newTestResponse(http.StatusOK, `{"data": []}`), nil
+		}
+		if !modelsAvailable {
+			return newTestResponse(http.StatusNotFound, ""), nil
+		}
+		return newTestResponse(http.StatusOK, `{"data": [{"identifier": "llama3.2:1b", "provider_id": "ollama", "model_type": "llm"}]}`), nil
+	})}
+	r := NewReconciler(nil, scheme.Scheme, WithHTTPClient(httpClient))
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+	t.Cleanup(func() { forgetInstanceMetrics(types.NamespacedName{Namespace: "default", Name: "test-instance"}) })
+	expected := []llamav1alpha1.ModelInfo{{ModelID: "llama3.2:1b", ProviderID: "ollama", ModelType: "llm"}}
+
+	// --- act ---
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert ---
+	assert.Equal(t, expected, instance.Status.DistributionConfig.Models)
+	assert.Equal(t, int32(1), instance.Status.DistributionConfig.TotalModels)
+
+	// --- act: the models cannot be fetched ---
+	modelsAvailable = false
+	r.performHealthChecks(t.Context(), instance)
+
+	// --- assert: the last known models are kept ---
+	assert.Equal(t, expected, instance.Status.DistributionConfig.Models)
+}
+
+func TestPerformHealthChecksFailureThreshold(t *testing.T) {
+	withFastServerRequestBackoff(t)
+	healthy := true
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if !healthy {
+			return newTestResponse(http.StatusServiceUnavailable, ""), nil
+		}
+		return newTestResponse(http.StatusOK, `{"data": []}`), nil
+	})}
+	r := NewReconciler(nil, scheme.Scheme, WithHTTPClient(httpClient))
+	r.HealthCheckFailureThreshold = 2
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+	}
+	t.Cleanup(func() { forgetInstanceMetrics(types.NamespacedName{Namespace: "default", Name: "test-instance"}) })
+	// updateStatus moves a ready deployment to the Ready phase before the health checks
+	checkHealth := func() {
+		t.Helper()
+		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseReady
+		r.performHealthChecks(t.Context(), instance)
+	}
+
+	// --- act: the server is healthy ---
+	checkHealth()
+
+	// --- assert ---
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, instance.Status.Phase)
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeHealthCheck))
+	assert.Nil(t, instance.Status.HealthCheck)
+
+	// --- act: the server fails below the threshold ---
+	healthy = false
+	checkHealth()
+
+	// --- assert: the server is still considered healthy ---
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, instance.Status.Phase)
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeHealthCheck))
+	require.NotNil(t, instance.Status.HealthCheck)
+	assert.Equal(t, int32(1), instance.Status.HealthCheck.ConsecutiveFailures)
+
+	// --- act: the server reaches the threshold ---
+	checkHealth()
+
+	// --- assert ---
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseFailed, instance.Status.Phase)
+	assert.True(t, IsConditionFalse(&instance.Status, ConditionTypeHealthCheck))
+	assert.Equal(t, int32(2), instance.Status.HealthCheck.ConsecutiveFailures)
+
+	// --- act: the server keeps failing ---
+	checkHealth()
+
+	// --- assert ---
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseFailed, instance.Status.Phase)
+	assert.Equal(t, int32(3), instance.Status.HealthCheck.ConsecutiveFailures)
+
+	// --- act: the server recovers ---
+	healthy = true
+	checkHealth()
+
+	// --- assert: the first success restores it ---
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, instance.Status.Phase)
+	assert.True(t, IsConditionTrue(&instance.Status, ConditionTypeHealthCheck))
+	assert.Nil(t, instance.Status.HealthCheck)
+
+	// --- act: a single failure after the recovery ---
+	healthy = false
+	checkHealth()
+
+	// --- assert: the count starts over ---
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, instance.Status.Phase)
+	assert.Equal(t, int32(1), instance.Status.HealthCheck.ConsecutiveFailures)
+}
+
+func TestReconcilePaused(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-instance",
+			Namespace:   "default",
+			Annotations: map[string]string{llamav1alpha1.ReconcilePausedAnnotation: "true"},
+		},
+		Status: llamav1alpha1.LlamaStackDistributionStatus{Phase: llamav1alpha1.LlamaStackDistributionPhaseReady},
+	}
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+	cli := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(instance).WithStatusSubresource(instance).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, testScheme)
+	r.Recorder = recorder
+	t.Cleanup(func() { forgetInstanceMetrics(key) })
+
+	// --- act ---
+	result, err := r.Reconcile(t.Context(), reconcile.Request{NamespacedName: key})
+
+	// --- assert: the instance is paused without reconciling its resources ---
+	require.NoError(t, err)
+	assert.True(t, result.IsZero(), "a paused instance should not be requeued")
+	paused := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, cli.Get(t.Context(), key, paused))
+	assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhasePaused, paused.Status.Phase)
+	assert.Nil(t, GetCondition(&paused.Status, ConditionTypeDeploymentReady), "the resources should not be reconciled")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Normal "+EventReasonReconcilePaused+" ")
+
+	// --- act: the status update triggers another reconciliation ---
+	_, err = r.Reconcile(t.Context(), reconcile.Request{NamespacedName: key})
+
+	// --- assert: the status is not updated again ---
+	require.NoError(t, err)
+	again := &llamav1alpha1.LlamaStackDistribution{}
+	require.NoError(t, cli.Get(t.Context(), key, again))
+	assert.Equal(t, paused.ResourceVersion, again.ResourceVersion)
+	assert.Empty(t, recorder.Events, "the event should only be emitted when the reconciliation is paused")
+
+	// --- act: the annotation is set to "false" ---
+	again.Annotations[llamav1alpha1.ReconcilePausedAnnotation] = "false"
+
+	// --- assert ---
+	assert.False(t, isReconcilePaused(again), "only \"true\" should pause the reconciliation")
+}
+
+// newTestServingCert returns a self-signed serving certificate for the host and its private key, PEM encoded.
+func newTestServingCert(t *testing.T, host string) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: host},
+		DNSNames:              []string{host},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestServerCertDeployment(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				TLSConfig: &llamav1alpha1.TLSConfig{ServerCert: &llamav1alpha1.ServerCertConfig{SecretName: "serving-cert"}},
+			},
+		},
+	}
+
+	container := buildContainerSpec(t.Context(), nil, instance, "test-image")
+	podSpec := configurePodStorage(t.Context(), nil, instance, container)
+
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name:         ServerCertVolumeName,
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "serving-cert"}},
+	})
+	assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{
+		Name:      ServerCertVolumeName,
+		MountPath: ServerCertMountPath,
+		ReadOnly:  true,
+	})
+	assert.Equal(t, []string{
+		"--tls-certfile", "/etc/llama-stack-tls/tls.crt",
+		"--tls-keyfile", "/etc/llama-stack-tls/tls.key",
+	}, container.Args)
+	assert.Equal(t, corev1.URISchemeHTTPS, container.ReadinessProbe.HTTPGet.Scheme)
+	assert.Equal(t, corev1.URISchemeHTTPS, container.LivenessProbe.HTTPGet.Scheme)
+	r := NewReconciler(nil, scheme.Scheme)
+	assert.Equal(t, "https://test-instance-service.default.svc.cluster.local:8321/v1/health",
+		r.getHealthCheckURL(instance).String())
+
+	// --- act: user-specified args are used verbatim ---
+	instance.Spec.Server.ContainerSpec.Args = []string{"--port", "8321"}
+	container = buildContainerSpec(t.Context(), nil, instance, "test-image")
+
+	// --- assert ---
+	assert.Equal(t, []string{"--port", "8321"}, container.Args)
+}
+
+func TestReconcileServerCertSecret(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				TLSConfig: &llamav1alpha1.TLSConfig{ServerCert: &llamav1alpha1.ServerCertConfig{SecretName: "serving-cert"}},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, scheme.Scheme)
+	r.Recorder = recorder
+
+	// --- act: the Secret does not exist ---
+	err := r.reconcileServerCertSecret(t.Context(), instance)
+
+	// --- assert ---
+	require.ErrorContains(t, err, "failed to find referenced Secret default/serving-cert")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonSecretNotFound)
+
+	// --- act: the Secret has no key ---
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: "default"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("certificate")},
+	}
+	require.NoError(t, cli.Create(t.Context(), secret))
+
+	// --- assert ---
+	require.ErrorContains(t, r.reconcileServerCertSecret(t.Context(), instance), "failed to find key 'tls.key'")
+
+	// --- act: the Secret is complete ---
+	secret.Data[corev1.TLSPrivateKeyKey] = []byte("key")
+	require.NoError(t, cli.Update(t.Context(), secret))
+
+	// --- assert ---
+	require.NoError(t, r.reconcileServerCertSecret(t.Context(), instance))
+	hash, err := r.getServerCertSecretHash(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Equal(t, secretDataHash(secret), hash)
+}
+
+func TestCheckHealthOverHTTPS(t *testing.T) {
+	const host = "test-instance-service.default.svc.cluster.local"
+	certPEM, keyPEM := newTestServingCert(t, host)
+	otherCertPEM, _ := newTestServingCert(t, host)
+
+	serverCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != llamav1alpha1.DefaultHealthCheckPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCert}, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	// The Service host name of the instance resolves to the mock server
+	httpClient := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	}}
+
+	testCases := []struct {
+		name               string
+		data               map[string][]byte
+		insecureSkipVerify bool
+		errorContains      string
+	}{
+		{
+			name: "self-signed certificate",
+			data: map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+		},
+		{
+			name: "certificate issued by the CA of the Secret",
+			data: map[string][]byte{corev1.TLSCertKey: otherCertPEM, serverCertCAKey: certPEM},
+		},
+		{
+			name:          "untrusted certificate",
+			data:          map[string][]byte{corev1.TLSCertKey: otherCertPEM},
+			errorContains: "certificate",
+		},
+		{
+			name:               "verification disabled",
+			data:               map[string][]byte{corev1.TLSCertKey: otherCertPEM},
+			insecureSkipVerify: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{
+						TLSConfig: &llamav1alpha1.TLSConfig{ServerCert: &llamav1alpha1.ServerCertConfig{
+							SecretName:         "serving-cert",
+							InsecureSkipVerify: tc.insecureSkipVerify,
+						}},
+					},
+				},
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: "default"},
+				Type:       corev1.SecretTypeTLS,
+				Data:       tc.data,
+			}
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+			r := NewReconciler(cli, scheme.Scheme, WithHTTPClient(httpClient))
+
+			// --- act ---
+			err := r.checkHealth(t.Context(), instance)
+
+			// --- assert ---
+			if tc.errorContains != "" {
+				require.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestServerHTTPClientReuse(t *testing.T) {
+	const host = "test-instance-service.default.svc.cluster.local"
+	certPEM, keyPEM := newTestServingCert(t, host)
+	rotatedCertPEM, rotatedKeyPEM := newTestServingCert(t, host)
+
+	serverCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	var connections atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCert}, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	// The Service host name of the instance resolves to the mock server
+	httpClient := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+	}}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				TLSConfig: &llamav1alpha1.TLSConfig{ServerCert: &llamav1alpha1.ServerCertConfig{SecretName: "serving-cert"}},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: "default"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	r := NewReconciler(cli, scheme.Scheme, WithHTTPClient(httpClient))
+
+	// --- act: the server is queried repeatedly ---
+	first, err := r.serverHTTPClient(t.Context(), instance)
+	require.NoError(t, err)
+	for range 3 {
+		require.NoError(t, r.checkHealth(t.Context(), instance))
+	}
+
+	// --- assert: the client and its connection are reused ---
+	second, err := r.serverHTTPClient(t.Context(), instance)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, int32(1), connections.Load())
+
+	// --- act: the certificate is rotated ---
+	secret.Data = map[string][]byte{corev1.TLSCertKey: rotatedCertPEM, corev1.TLSPrivateKeyKey: rotatedKeyPEM}
+	require.NoError(t, cli.Update(t.Context(), secret))
+	rotated, err := r.serverHTTPClient(t.Context(), instance)
+
+	// --- assert: the client trusting the new CA replaces the cached one ---
+	require.NoError(t, err)
+	assert.NotSame(t, first, rotated)
+
+	// --- act: the server no longer serves a certificate ---
+	instance.Spec.Server.TLSConfig = nil
+	plain, err := r.serverHTTPClient(t.Context(), instance)
+
+	// --- assert: the injected client is used as is ---
+	require.NoError(t, err)
+	assert.Same(t, httpClient, plain)
+}
+
+func TestUpdateDeploymentStatusReplicaPolicy(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	testCases := []struct {
+		name        string
+		policy      llamav1alpha1.ReplicaManagementPolicy
+		expectReady bool
+		expectPhase llamav1alpha1.DistributionPhase
+	}{
+		{name: "managed replicas are compared to the spec", policy: llamav1alpha1.ReplicaManagementPolicyManaged,
+			expectPhase: llamav1alpha1.LlamaStackDistributionPhaseInitializing},
+		{name: "external replicas are compared to the Deployment", policy: llamav1alpha1.ReplicaManagementPolicyExternal,
+			expectReady: true, expectPhase: llamav1alpha1.LlamaStackDistributionPhaseReady},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec:       llamav1alpha1.LlamaStackDistributionSpec{Replicas: 2, ReplicaManagementPolicy: tc.policy},
+			}
+			// The Deployment was scaled up to five replicas by another controller
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(5))},
+				Status:     appsv1.DeploymentStatus{ReadyReplicas: 5},
+			}
+			cli := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(deployment).Build()
+			r := NewReconciler(cli, testScheme)
+
+			// --- act ---
+			ready, err := r.updateDeploymentStatus(t.Context(), instance)
+
+			// --- assert ---
+			require.NoError(t, err)
+			require.Equal(t, tc.expectReady, ready)
+			require.Equal(t, tc.expectPhase, instance.Status.Phase)
+			require.Equal(t, int32(5), instance.Status.AvailableReplicas)
+		})
+	}
+}
+
+func TestBuildEgressRules(t *testing.T) {
+	dnsPort := intstr.FromInt32(53)
+	ollamaPort := intstr.FromInt32(11434)
+	httpsPort := intstr.FromInt32(443)
+	dnsRule := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: ptr.To(corev1.ProtocolUDP), Port: &dnsPort},
+			{Protocol: ptr.To(corev1.ProtocolTCP), Port: &dnsPort},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		spec     *llamav1alpha1.NetworkPolicySpec
+		expected []networkingv1.NetworkPolicyEgressRule
+	}{
+		{name: "no network policy spec"},
+		{name: "no egress rules", spec: &llamav1alpha1.NetworkPolicySpec{}},
+		{
+			name: "pods of another namespace",
+			spec: &llamav1alpha1.NetworkPolicySpec{EgressRules: []llamav1alpha1.EgressRuleSpec{
+				{Namespace: "ollama", PodSelector: map[string]string{"app": "ollama"}, Port: 11434},
+			}},
+			expected: []networkingv1.NetworkPolicyEgressRule{dnsRule, {
+				To: []networkingv1.NetworkPolicyPeer{{
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "ollama"}},
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ollama"}},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: &ollamaPort}},
+			}},
+		},
+		{
+			name: "any destination on a port",
+			spec: &llamav1alpha1.NetworkPolicySpec{EgressRules: []llamav1alpha1.EgressRuleSpec{
+				{Port: 443, Protocol: corev1.ProtocolTCP},
+			}},
+			expected: []networkingv1.NetworkPolicyEgressRule{dnsRule, {
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: &httpsPort}},
+			}},
+		},
+		{
+			name: "all ports of pods in the same namespace",
+			spec: &llamav1alpha1.NetworkPolicySpec{EgressRules: []llamav1alpha1.EgressRuleSpec{
+				{PodSelector: map[string]string{"app": "vllm"}},
+			}},
+			expected: []networkingv1.NetworkPolicyEgressRule{dnsRule, {
+				To: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "vllm"}},
+				}},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				Spec: llamav1alpha1.LlamaStackDistributionSpec{
+					Server: llamav1alpha1.ServerSpec{NetworkPolicy: tc.spec},
+				},
+			}
+			require.Equal(t, tc.expected, buildEgressRules(instance))
+		})
 	}
 }
@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// templateHashAnnotation records on the Deployment the hash of the pod template it runs,
+// so that the operator knows which template a rollout status refers to.
+const templateHashAnnotation = "llamastack.io/template-hash"
+
+// progressDeadlineExceededReason is the reason of the Progressing condition of a Deployment whose rollout stalled.
+const progressDeadlineExceededReason = "ProgressDeadlineExceeded"
+
+// podTemplateHash returns a hash identifying a pod template.
+func podTemplateHash(template *corev1.PodTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pod template: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// isRolloutComplete reports whether all replicas of the Deployment run its current pod template and are available.
+// A Deployment scaled to zero never completes a rollout, since its pod template was not proven to start.
+func isRolloutComplete(deployment *appsv1.Deployment) bool {
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas == 0 {
+		return false
+	}
+	replicas := *deployment.Spec.Replicas
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas &&
+		deployment.Status.Replicas == replicas
+}
+
+// rolloutStalledMessage returns the message of the Progressing condition if the rollout of the current
+// pod template of the Deployment exceeded its progress deadline.
+func rolloutStalledMessage(deployment *appsv1.Deployment) (string, bool) {
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return "", false
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing &&
+			condition.Status == corev1.ConditionFalse &&
+			condition.Reason == progressDeadlineExceededReason {
+			return condition.Message, true
+		}
+	}
+	return "", false
+}
+
+// applyRolloutPolicy applies the rollout configuration of the instance to the Deployment before it is applied.
+// With auto-rollback enabled, the last pod template that completed a rollout is recorded in the status, and
+// a stalled rollout of a new pod template is reverted to it. The known-good pod template is kept until the
+// spec renders a pod template different from the one that was rolled back.
+func (r *LlamaStackDistributionReconciler) applyRolloutPolicy(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	deployment *appsv1.Deployment) error {
+	spec := instance.Spec.Server.Rollout
+	if spec != nil && spec.ProgressDeadlineSeconds != nil {
+		deployment.Spec.ProgressDeadlineSeconds = spec.ProgressDeadlineSeconds
+	}
+
+	if spec == nil || !spec.AutoRollback {
+		instance.Status.Rollout = nil
+		RemoveCondition(&instance.Status, ConditionTypeRollbackTriggered)
+		return nil
+	}
+
+	desiredHash, err := podTemplateHash(&deployment.Spec.Template)
+	if err != nil {
+		return err
+	}
+
+	if instance.Status.Rollout == nil {
+		instance.Status.Rollout = &llamav1alpha1.RolloutStatus{}
+	}
+	status := instance.Status.Rollout
+
+	// A new pod template in the spec supersedes the one that was rolled back
+	if status.FailedTemplateHash != "" && status.FailedTemplateHash != desiredHash {
+		status.FailedTemplateHash = ""
+		RemoveCondition(&instance.Status, ConditionTypeRollbackTriggered)
+	}
+
+	if err := r.trackRollout(ctx, instance, deployment, desiredHash); err != nil {
+		return err
+	}
+
+	if status.FailedTemplateHash == desiredHash && status.LastGoodTemplate != nil {
+		template := corev1.PodTemplateSpec{}
+		if err := json.Unmarshal(status.LastGoodTemplate.Raw, &template); err != nil {
+			return fmt.Errorf("failed to decode last known-good pod template: %w", err)
+		}
+		deployment.Spec.Template = template
+		desiredHash = status.LastGoodTemplateHash
+	}
+
+	if deployment.Annotations == nil {
+		deployment.Annotations = make(map[string]string)
+	}
+	deployment.Annotations[templateHashAnnotation] = desiredHash
+	return nil
+}
+
+// trackRollout inspects the rollout of the existing Deployment. It records the pod template of a completed
+// rollout as known-good, and marks the desired pod template as failed if its rollout stalled.
+func (r *LlamaStackDistributionReconciler) trackRollout(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	deployment *appsv1.Deployment, desiredHash string) error {
+	logger := log.FromContext(ctx)
+
+	existing := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(deployment), existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch deployment for rollout tracking: %w", err)
+	}
+
+	status := instance.Status.Rollout
+	deployedHash := existing.Annotations[templateHashAnnotation]
+	if deployedHash == "" {
+		return nil
+	}
+
+	if isRolloutComplete(existing) {
+		if deployedHash != status.LastGoodTemplateHash {
+			raw, err := json.Marshal(existing.Spec.Template)
+			if err != nil {
+				return fmt.Errorf("failed to marshal known-good pod template: %w", err)
+			}
+			status.LastGoodTemplateHash = deployedHash
+			status.LastGoodTemplate = &apiextensionsv1.JSON{Raw: raw}
+			logger.Info("Recorded known-good pod template", "hash", deployedHash)
+		}
+		return nil
+	}
+
+	// Only the rollout of the desired pod template is reverted, never the known-good one
+	if status.LastGoodTemplate == nil || status.FailedTemplateHash != "" ||
+		deployedHash != desiredHash || deployedHash == status.LastGoodTemplateHash {
+		return nil
+	}
+
+	stalledMessage, stalled := rolloutStalledMessage(existing)
+	if !stalled {
+		return nil
+	}
+
+	status.FailedTemplateHash = desiredHash
+	message := fmt.Sprintf("Rolled back to the last known-good pod template after the rollout stalled: %s", stalledMessage)
+	SetRollbackTriggeredCondition(&instance.Status, message)
+	r.recordEvent(instance, corev1.EventTypeWarning, EventReasonRollbackTriggered, message)
+	logger.Info("Rolling back stalled rollout", "failedHash", desiredHash, "lastGoodHash", status.LastGoodTemplateHash)
+	return nil
+}
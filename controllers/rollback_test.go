@@ -0,0 +1,120 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestIsRolloutComplete(t *testing.T) {
+	deployment := func(replicas int32, status appsv1.DeploymentStatus) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+			Status:     status,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		expected   bool
+	}{
+		{
+			name:       "all replicas updated and available",
+			deployment: deployment(2, appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}),
+			expected:   true,
+		},
+		{
+			name:       "status of a previous generation",
+			deployment: deployment(2, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}),
+		},
+		{
+			name:       "old replicas still running",
+			deployment: deployment(2, appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 2, AvailableReplicas: 2}),
+		},
+		{
+			name:       "updated replicas not available",
+			deployment: deployment(2, appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1}),
+		},
+		{
+			name:       "scaled to zero",
+			deployment: deployment(0, appsv1.DeploymentStatus{ObservedGeneration: 2}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isRolloutComplete(tt.deployment))
+		})
+	}
+}
+
+func TestRolloutStalledMessage(t *testing.T) {
+	deployment := func(observedGeneration int64, conditions ...appsv1.DeploymentCondition) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status:     appsv1.DeploymentStatus{ObservedGeneration: observedGeneration, Conditions: conditions},
+		}
+	}
+	stalled := appsv1.DeploymentCondition{
+		Type:    appsv1.DeploymentProgressing,
+		Status:  corev1.ConditionFalse,
+		Reason:  progressDeadlineExceededReason,
+		Message: "ReplicaSet has timed out progressing.",
+	}
+	progressing := appsv1.DeploymentCondition{
+		Type:   appsv1.DeploymentProgressing,
+		Status: corev1.ConditionTrue,
+		Reason: "ReplicaSetUpdated",
+	}
+
+	message, ok := rolloutStalledMessage(deployment(2, stalled))
+	require.True(t, ok, "rollout past its progress deadline should be stalled")
+	assert.Equal(t, stalled.Message, message)
+
+	_, ok = rolloutStalledMessage(deployment(2, progressing))
+	assert.False(t, ok, "progressing rollout should not be stalled")
+
+	_, ok = rolloutStalledMessage(deployment(1, stalled))
+	assert.False(t, ok, "stalled rollout of a previous generation should be ignored")
+}
+
+func TestPodTemplateHash(t *testing.T) {
+	template := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "llama-stack", Image: "llama:1"}}},
+	}
+	hash, err := podTemplateHash(template)
+	require.NoError(t, err)
+
+	same, err := podTemplateHash(template.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, hash, same, "equal pod templates should have the same hash")
+
+	changed := template.DeepCopy()
+	changed.Spec.Containers[0].Image = "llama:2"
+	other, err := podTemplateHash(changed)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "different pod templates should have different hashes")
+}
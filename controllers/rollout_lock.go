@@ -0,0 +1,225 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// rolloutLock is held by the instance of a namespace rolling out a new pod template.
+type rolloutLock struct {
+	instance string
+	// templateHash is the pod template the holder applied, so that a rollout the cache does not show yet still
+	// counts as in flight.
+	templateHash string
+}
+
+// rolloutLocks allows at most one instance per namespace to roll out a new pod template at a time.
+// State is kept in memory and is lost on operator restart, the rollouts in flight are then found from the
+// Deployments of the namespace. Nil locks are always granted.
+type rolloutLocks struct {
+	mu      sync.Mutex
+	holders map[string]rolloutLock
+}
+
+// newRolloutLocks creates the rollout locks, with every namespace unlocked.
+func newRolloutLocks() *rolloutLocks {
+	return &rolloutLocks{holders: make(map[string]rolloutLock)}
+}
+
+// Holder returns the lock of the namespace and whether it is held.
+func (l *rolloutLocks) Holder(namespace string) (rolloutLock, bool) {
+	if l == nil {
+		return rolloutLock{}, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock, held := l.holders[namespace]
+	return lock, held
+}
+
+// TryAcquire grants the lock of the namespace to the instance for the given pod template unless another
+// instance holds it.
+func (l *rolloutLocks) TryAcquire(key types.NamespacedName, templateHash string) bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, held := l.holders[key.Namespace]; held && lock.instance != key.Name {
+		return false
+	}
+	l.holders[key.Namespace] = rolloutLock{instance: key.Name, templateHash: templateHash}
+	return true
+}
+
+// Release frees the lock of the namespace if the instance holds it.
+func (l *rolloutLocks) Release(key types.NamespacedName) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, held := l.holders[key.Namespace]; held && lock.instance == key.Name {
+		delete(l.holders, key.Namespace)
+	}
+}
+
+// isRolloutInProgress reports whether the Deployment is still replacing its pods with those of its current pod
+// template. A stalled rollout is not in progress anymore, so that it does not hold back the other instances.
+func isRolloutInProgress(deployment *appsv1.Deployment) bool {
+	if _, stalled := rolloutStalledMessage(deployment); stalled {
+		return false
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ObservedGeneration < deployment.Generation ||
+		deployment.Status.UpdatedReplicas < replicas ||
+		deployment.Status.Replicas > deployment.Status.UpdatedReplicas ||
+		deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas
+}
+
+// serializeRollout holds back a new pod template of the Deployment while another instance of the namespace rolls
+// out, so that the image pulls and pod starts of the instances sharing a namespace do not overlap. The running pod
+// template is applied instead and the RolloutQueued condition is set until the lock of the namespace is free.
+// The rest of the Deployment, such as the replicas, is still applied, and a Deployment being created is never
+// held back.
+func (r *LlamaStackDistributionReconciler) serializeRollout(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	deployment *appsv1.Deployment) error {
+	key := client.ObjectKeyFromObject(instance)
+	if !r.EnableRolloutSerialization {
+		r.rolloutLocks.Release(key)
+		RemoveCondition(&instance.Status, ConditionTypeRolloutQueued)
+		return nil
+	}
+
+	desiredHash := deployment.Annotations[templateHashAnnotation]
+	if desiredHash == "" {
+		hash, err := podTemplateHash(&deployment.Spec.Template)
+		if err != nil {
+			return err
+		}
+		if deployment.Annotations == nil {
+			deployment.Annotations = make(map[string]string)
+		}
+		deployment.Annotations[templateHashAnnotation] = hash
+		desiredHash = hash
+	}
+
+	existing := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(deployment), existing); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to fetch deployment for rollout serialization: %w", err)
+		}
+		RemoveCondition(&instance.Status, ConditionTypeRolloutQueued)
+		return nil
+	}
+
+	// A Deployment applied before the hash was recorded is assumed to run the desired pod template
+	deployedHash := existing.Annotations[templateHashAnnotation]
+	if deployedHash == "" || deployedHash == desiredHash {
+		if !isRolloutInProgress(existing) {
+			r.rolloutLocks.Release(key)
+		}
+		RemoveCondition(&instance.Status, ConditionTypeRolloutQueued)
+		return nil
+	}
+
+	holder, err := r.acquireRolloutLock(ctx, instance, desiredHash)
+	if err != nil {
+		return err
+	}
+	if holder == "" {
+		RemoveCondition(&instance.Status, ConditionTypeRolloutQueued)
+		return nil
+	}
+
+	deployment.Spec.Template = *existing.Spec.Template.DeepCopy()
+	deployment.Annotations[templateHashAnnotation] = deployedHash
+
+	alreadyQueued := IsConditionTrue(&instance.Status, ConditionTypeRolloutQueued)
+	SetRolloutQueuedCondition(&instance.Status, holder)
+	if !alreadyQueued {
+		condition := GetCondition(&instance.Status, ConditionTypeRolloutQueued)
+		r.recordEvent(instance, corev1.EventTypeNormal, EventReasonRolloutQueued, condition.Message)
+		log.FromContext(ctx).Info("Queued rollout of the new pod template", "holder", holder)
+	}
+	return nil
+}
+
+// acquireRolloutLock takes the rollout lock of the namespace for the pod template of the instance. It returns the
+// name of the instance rolling out in the namespace if the lock is not free, or an empty name once it is acquired.
+func (r *LlamaStackDistributionReconciler) acquireRolloutLock(ctx context.Context, instance *llamav1alpha1.LlamaStackDistribution,
+	templateHash string) (string, error) {
+	if lock, held := r.rolloutLocks.Holder(instance.Namespace); held && lock.instance != instance.Name {
+		holderKey := types.NamespacedName{Namespace: instance.Namespace, Name: lock.instance}
+		existing := &appsv1.Deployment{}
+		if err := r.Get(ctx, holderKey, existing); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return "", fmt.Errorf("failed to fetch deployment of rollout lock holder %s: %w", lock.instance, err)
+			}
+			r.rolloutLocks.Release(holderKey)
+		} else if existing.Annotations[templateHashAnnotation] != lock.templateHash || isRolloutInProgress(existing) {
+			return lock.instance, nil
+		} else {
+			r.rolloutLocks.Release(holderKey)
+		}
+	}
+
+	// The lock is lost on operator restart, so look for a rollout in flight among the other instances
+	instances := &llamav1alpha1.LlamaStackDistributionList{}
+	if err := r.List(ctx, instances, client.InNamespace(instance.Namespace)); err != nil {
+		return "", fmt.Errorf("failed to list LlamaStackDistributions for rollout serialization: %w", err)
+	}
+	for i := range instances.Items {
+		other := &instances.Items[i]
+		if other.Name == instance.Name {
+			continue
+		}
+		existing := &appsv1.Deployment{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(other), existing); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return "", fmt.Errorf("failed to fetch deployment of %s for rollout serialization: %w", other.Name, err)
+		}
+		if isRolloutInProgress(existing) {
+			return other.Name, nil
+		}
+	}
+
+	if !r.rolloutLocks.TryAcquire(client.ObjectKeyFromObject(instance), templateHash) {
+		lock, _ := r.rolloutLocks.Holder(instance.Namespace)
+		return lock.instance, nil
+	}
+	return "", nil
+}
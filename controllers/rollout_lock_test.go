@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/featureflags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIsRolloutInProgress(t *testing.T) {
+	testCases := []struct {
+		name       string
+		generation int64
+		status     appsv1.DeploymentStatus
+		expected   bool
+	}{
+		{
+			name:       "rollout complete",
+			generation: 2,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+		},
+		{
+			name:       "new generation not observed yet",
+			generation: 3,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+			expected:   true,
+		},
+		{
+			name:       "old pods still running",
+			generation: 2,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 1, AvailableReplicas: 2},
+			expected:   true,
+		},
+		{
+			name:       "new pods not available yet",
+			generation: 2,
+			status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1},
+			expected:   true,
+		},
+		{
+			name:       "stalled rollout",
+			generation: 2,
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 1, AvailableReplicas: 2,
+				Conditions: []appsv1.DeploymentCondition{{
+					Type:   appsv1.DeploymentProgressing,
+					Status: corev1.ConditionFalse,
+					Reason: progressDeadlineExceededReason,
+				}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Generation: tc.generation},
+				Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status:     tc.status,
+			}
+			assert.Equal(t, tc.expected, isRolloutInProgress(deployment))
+		})
+	}
+}
+
+func TestSerializeRollout(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	newInstance := func(name string) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team"}}
+	}
+	newDeployment := func(name, image string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team", Generation: 1},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To(int32(1)),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "llama-stack", Image: image}}},
+				},
+			},
+			Status: appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1},
+		}
+	}
+
+	first, second := newInstance("first"), newInstance("second")
+	// The first instance is rolling out, its new pods are not available yet
+	firstDeployment := newDeployment("first", "llama:new")
+	firstDeployment.Annotations = map[string]string{templateHashAnnotation: "first-new"}
+	firstDeployment.Status.AvailableReplicas = 0
+	secondDeployment := newDeployment("second", "llama:old")
+	secondDeployment.Annotations = map[string]string{templateHashAnnotation: "second-old"}
+
+	cli := fake.NewClientBuilder().WithScheme(testScheme).
+		WithObjects(first, second, firstDeployment, secondDeployment).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := NewReconciler(cli, testScheme, WithFeatureFlags(featureflags.FeatureFlags{
+		EnableRolloutSerialization: featureflags.FeatureFlag{Enabled: true},
+	}))
+	r.Recorder = recorder
+
+	// --- act: the second instance gets a new pod template ---
+	desired := newDeployment("second", "llama:new")
+	desired.Annotations = nil
+	require.NoError(t, r.serializeRollout(t.Context(), second, desired))
+
+	// --- assert: the running pod template is kept ---
+	assert.Equal(t, "llama:old", desired.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "second-old", desired.Annotations[templateHashAnnotation])
+	condition := GetCondition(&second.Status, ConditionTypeRolloutQueued)
+	require.NotNil(t, condition, "RolloutQueued condition should be set")
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonNamespaceRolloutInProgress, condition.Reason)
+	assert.Contains(t, condition.Message, "first")
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonRolloutQueued)
+
+	// --- act: the rollout of the first instance is still in flight on the next check ---
+	desired = newDeployment("second", "llama:new")
+	desired.Annotations = nil
+	require.NoError(t, r.serializeRollout(t.Context(), second, desired))
+
+	// --- assert ---
+	assert.Equal(t, "llama:old", desired.Spec.Template.Spec.Containers[0].Image)
+	assert.Empty(t, recorder.Events, "the event should only be emitted when the rollout gets queued")
+
+	// --- act: the rollout of the first instance completes ---
+	firstDeployment.Status.AvailableReplicas = 1
+	require.NoError(t, cli.Status().Update(t.Context(), firstDeployment))
+	desired = newDeployment("second", "llama:new")
+	desired.Annotations = nil
+	require.NoError(t, r.serializeRollout(t.Context(), second, desired))
+
+	// --- assert: the new pod template is applied and the second instance holds the lock ---
+	assert.Equal(t, "llama:new", desired.Spec.Template.Spec.Containers[0].Image)
+	newHash := desired.Annotations[templateHashAnnotation]
+	assert.NotEqual(t, "second-old", newHash)
+	assert.Nil(t, GetCondition(&second.Status, ConditionTypeRolloutQueued), "condition should be removed once the rollout starts")
+	lock, held := r.rolloutLocks.Holder("team")
+	require.True(t, held)
+	assert.Equal(t, rolloutLock{instance: "second", templateHash: newHash}, lock)
+
+	// --- act: the first instance gets a new pod template before the cache shows the rollout of the second ---
+	desired = newDeployment("first", "llama:newer")
+	desired.Annotations = nil
+	require.NoError(t, r.serializeRollout(t.Context(), first, desired))
+
+	// --- assert ---
+	assert.Equal(t, "llama:new", desired.Spec.Template.Spec.Containers[0].Image)
+	assert.True(t, IsConditionTrue(&first.Status, ConditionTypeRolloutQueued))
+
+	// --- act: the feature flag is disabled ---
+	r.EnableRolloutSerialization = false
+	desired = newDeployment("first", "llama:newer")
+	desired.Annotations = nil
+	require.NoError(t, r.serializeRollout(t.Context(), first, desired))
+
+	// --- assert ---
+	assert.Equal(t, "llama:newer", desired.Spec.Template.Spec.Containers[0].Image)
+	assert.Nil(t, GetCondition(&first.Status, ConditionTypeRolloutQueued))
+}
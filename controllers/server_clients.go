@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"net/http"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// serverClients caches the clients querying the llama-stack servers that serve a certificate, so that their
+// connections are reused across reconciliations. A client is rebuilt when the TLS configuration of its instance
+// changes, e.g. when the CA is rotated.
+// State is kept in memory per LlamaStackDistribution and is lost on operator restart.
+// A nil cache builds a client for every request.
+type serverClients struct {
+	mu      sync.Mutex
+	clients map[types.NamespacedName]serverClient
+}
+
+// serverClient is a client built for the TLS configuration identified by fingerprint.
+type serverClient struct {
+	fingerprint string
+	client      *http.Client
+}
+
+// newServerClients creates an empty cache.
+func newServerClients() *serverClients {
+	return &serverClients{clients: make(map[types.NamespacedName]serverClient)}
+}
+
+// Get returns the client of the instance for the TLS configuration identified by fingerprint. The client is built
+// if the instance has none yet or has one for another configuration, whose idle connections are then closed.
+func (c *serverClients) Get(key types.NamespacedName, fingerprint string, build func() (*http.Client, error)) (*http.Client, error) {
+	if c == nil {
+		return build()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, exists := c.clients[key]
+	if exists && cached.fingerprint == fingerprint {
+		return cached.client, nil
+	}
+	client, err := build()
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		cached.client.CloseIdleConnections()
+	}
+	c.clients[key] = serverClient{fingerprint: fingerprint, client: client}
+	return client, nil
+}
+
+// Forget drops the client of the instance and closes its idle connections.
+func (c *serverClients) Forget(key types.NamespacedName) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, exists := c.clients[key]; exists {
+		cached.client.CloseIdleConnections()
+		delete(c.clients, key)
+	}
+}
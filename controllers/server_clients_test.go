@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestServerClients(t *testing.T) {
+	clients := newServerClients()
+	key := types.NamespacedName{Namespace: "test-ns", Name: "test-instance"}
+	otherKey := types.NamespacedName{Namespace: "test-ns", Name: "other-instance"}
+	builds := 0
+	build := func() (*http.Client, error) {
+		builds++
+		return &http.Client{}, nil
+	}
+	get := func(key types.NamespacedName, fingerprint string) *http.Client {
+		t.Helper()
+		client, err := clients.Get(key, fingerprint, build)
+		require.NoError(t, err)
+		return client
+	}
+
+	// The client is built once per TLS configuration
+	first := get(key, "ca-1")
+	assert.Same(t, first, get(key, "ca-1"))
+	assert.Equal(t, 1, builds)
+
+	// Clients are cached per instance
+	assert.NotSame(t, first, get(otherKey, "ca-1"))
+	assert.Equal(t, 2, builds)
+
+	// A new TLS configuration replaces the client
+	rotated := get(key, "ca-2")
+	assert.NotSame(t, first, rotated)
+	assert.Same(t, rotated, get(key, "ca-2"))
+	assert.Equal(t, 3, builds)
+
+	// A failed build keeps the cached client
+	_, err := clients.Get(key, "ca-3", func() (*http.Client, error) { return nil, errors.New("invalid CA") })
+	require.Error(t, err)
+	assert.Same(t, rotated, get(key, "ca-2"))
+
+	// A forgotten instance gets a new client
+	clients.Forget(key)
+	assert.NotSame(t, rotated, get(key, "ca-2"))
+	assert.Equal(t, 4, builds)
+
+	// A nil cache builds a client for every request
+	var nilClients *serverClients
+	_, err = nilClients.Get(key, "ca-1", build)
+	require.NoError(t, err)
+	assert.Equal(t, 5, builds)
+	nilClients.Forget(key)
+}
@@ -1,8 +1,15 @@
 package controllers
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 // Condition types.
@@ -15,6 +22,32 @@ const (
 	ConditionTypeStorageReady = "StorageReady"
 	// ConditionTypeServiceReady indicates whether the service is ready.
 	ConditionTypeServiceReady = "ServiceReady"
+	// ConditionTypeUserConfigReady indicates whether the user ConfigMap is present and valid.
+	ConditionTypeUserConfigReady = "UserConfigReady"
+	// ConditionTypeQueuedForResources indicates whether the server pods are waiting for Kueue admission.
+	ConditionTypeQueuedForResources = "QueuedForResources"
+	// ConditionTypeUnreachable indicates health probing is suspended because the server endpoint keeps failing.
+	ConditionTypeUnreachable = "Unreachable"
+	// ConditionTypeNetworkPolicyReady indicates whether the NetworkPolicy is applied and enforced.
+	ConditionTypeNetworkPolicyReady = "NetworkPolicyReady"
+	// ConditionTypeIngressReady indicates whether the Ingress is admitted by an ingress controller.
+	ConditionTypeIngressReady = "IngressReady"
+	// ConditionTypeRouteReady indicates whether the OpenShift Route is admitted by the router.
+	ConditionTypeRouteReady = "RouteReady"
+	// ConditionTypeCABundleReady indicates whether the CA bundle is present and valid.
+	ConditionTypeCABundleReady = "CABundleReady"
+	// ConditionTypeConfigMapWatched indicates whether changes to the referenced ConfigMaps are watched.
+	ConditionTypeConfigMapWatched = "ConfigMapWatched"
+	// ConditionTypeRollbackTriggered indicates the Deployment was rolled back to the last known-good pod template.
+	ConditionTypeRollbackTriggered = "RollbackTriggered"
+	// ConditionTypeStorageBackendChanged indicates the storage switches between emptyDir and a PVC without migrating the data.
+	ConditionTypeStorageBackendChanged = "StorageBackendChanged"
+	// ConditionTypeQuotaWouldBeExceeded indicates whether the pods would exceed the namespace ResourceQuotas.
+	ConditionTypeQuotaWouldBeExceeded = "QuotaWouldBeExceeded"
+	// ConditionTypeInsufficientPermissions indicates the operator lacks the RBAC permissions to read a referenced object.
+	ConditionTypeInsufficientPermissions = "InsufficientPermissions"
+	// ConditionTypeRolloutQueued indicates a new pod template waits for the rollout of another instance of the namespace.
+	ConditionTypeRolloutQueued = "RolloutQueued"
 )
 
 // Condition reasons.
@@ -37,6 +70,74 @@ const (
 	ReasonServiceReady = "ServiceReady"
 	// ReasonServiceFailed indicates the service failed.
 	ReasonServiceFailed = "ServiceFailed"
+	// ReasonUserConfigValid indicates the user ConfigMap is valid.
+	ReasonUserConfigValid = "UserConfigValid"
+	// ReasonUserConfigInvalid indicates the user ConfigMap is missing or invalid.
+	ReasonUserConfigInvalid = "UserConfigInvalid"
+	// ReasonUserConfigMapDeleted indicates the user ConfigMap was deleted after it was found.
+	ReasonUserConfigMapDeleted = "UserConfigMapDeleted"
+	// ReasonCABundleValid indicates the CA bundle is valid.
+	ReasonCABundleValid = "CABundleValid"
+	// ReasonCABundleInvalid indicates the CA bundle ConfigMap or one of its keys is missing or invalid.
+	ReasonCABundleInvalid = "CABundleInvalid"
+	// ReasonConfigMapNamespacesWatched indicates the namespaces of the referenced ConfigMaps are watched.
+	ReasonConfigMapNamespacesWatched = "ConfigMapNamespacesWatched"
+	// ReasonConfigMapNamespaceNotWatched indicates a referenced ConfigMap is in a namespace excluded from the watch.
+	ReasonConfigMapNamespaceNotWatched = "ConfigMapNamespaceNotWatched"
+	// ReasonWaitingForAdmission indicates the server pods are gated until Kueue admits them.
+	ReasonWaitingForAdmission = "WaitingForAdmission"
+	// ReasonAdmitted indicates the server pods are not held by Kueue.
+	ReasonAdmitted = "Admitted"
+	// ReasonDeploymentInvalid indicates the API server rejected the deployment as invalid.
+	ReasonDeploymentInvalid = "DeploymentInvalid"
+	// ReasonDeploymentForbidden indicates the deployment was rejected by admission control or a quota.
+	ReasonDeploymentForbidden = "DeploymentForbidden"
+	// ReasonDeploymentConflict indicates the deployment kept conflicting with concurrent updates.
+	ReasonDeploymentConflict = "DeploymentConflict"
+	// ReasonManifestRenderFailed indicates the manifests could not be rendered from the spec.
+	ReasonManifestRenderFailed = "ManifestRenderFailed"
+	// ReasonImmutableFieldChanged indicates the spec changes an immutable field of a managed resource.
+	ReasonImmutableFieldChanged = "ImmutableFieldChanged"
+	// ReasonResourceNotOwned indicates an object with the name of a managed resource is not owned by the instance.
+	ReasonResourceNotOwned = "ResourceNotOwned"
+	// ReasonResourceScopeUnknown indicates the scope of a managed resource kind could not be determined.
+	ReasonResourceScopeUnknown = "ResourceScopeUnknown"
+	// ReasonHealthCheckSuspended indicates health probing is suspended after repeated failures.
+	ReasonHealthCheckSuspended = "HealthCheckSuspended"
+	// ReasonNetworkPolicyEnforced indicates the NetworkPolicy is applied and the cluster network plugin enforces it.
+	ReasonNetworkPolicyEnforced = "NetworkPolicyEnforced"
+	// ReasonNetworkPolicyUnverified indicates the NetworkPolicy is applied but enforcement could not be verified.
+	ReasonNetworkPolicyUnverified = "NetworkPolicyUnverified"
+	// ReasonNetworkPolicyFailed indicates the NetworkPolicy could not be applied.
+	ReasonNetworkPolicyFailed = "NetworkPolicyFailed"
+	// ReasonIngressAdmitted indicates an ingress controller admitted the Ingress.
+	ReasonIngressAdmitted = "IngressAdmitted"
+	// ReasonIngressPending indicates the Ingress is applied but no ingress controller admitted it yet.
+	ReasonIngressPending = "IngressPending"
+	// ReasonIngressFailed indicates the Ingress could not be applied.
+	ReasonIngressFailed = "IngressFailed"
+	// ReasonRouteAdmitted indicates the router admitted the Route.
+	ReasonRouteAdmitted = "RouteAdmitted"
+	// ReasonRoutePending indicates the Route is applied but the router did not admit it yet.
+	ReasonRoutePending = "RoutePending"
+	// ReasonRouteFailed indicates the Route could not be applied.
+	ReasonRouteFailed = "RouteFailed"
+	// ReasonRolloutStalled indicates the rollout of a new pod template exceeded its progress deadline.
+	ReasonRolloutStalled = "RolloutStalled"
+	// ReasonQuotaExceeded indicates the pods request more than the namespace ResourceQuotas have left.
+	ReasonQuotaExceeded = "QuotaExceeded"
+	// ReasonWithinQuota indicates the pods fit within the namespace ResourceQuotas.
+	ReasonWithinQuota = "WithinQuota"
+	// ReasonConfigMapAccessForbidden indicates the operator may not get a referenced ConfigMap.
+	ReasonConfigMapAccessForbidden = "ConfigMapAccessForbidden"
+	// ReasonInsufficientPermissions indicates the reconciliation failed because the operator may not read a referenced object.
+	ReasonInsufficientPermissions = "InsufficientPermissions"
+	// ReasonStorageBackendChangeBlocked indicates a storage backend switch waits for acknowledgment.
+	ReasonStorageBackendChangeBlocked = "StorageBackendChangeBlocked"
+	// ReasonStorageBackendChangeAcknowledged indicates an acknowledged storage backend switch is applied.
+	ReasonStorageBackendChangeAcknowledged = "StorageBackendChangeAcknowledged"
+	// ReasonNamespaceRolloutInProgress indicates another instance of the namespace holds the rollout lock.
+	ReasonNamespaceRolloutInProgress = "NamespaceRolloutInProgress"
 )
 
 // Condition messages.
@@ -59,6 +160,107 @@ const (
 	MessageServiceReady = "Service is ready"
 	// MessageServiceFailed indicates the service failed.
 	MessageServiceFailed = "Service failed"
+	// MessageUserConfigValid indicates the user ConfigMap is valid.
+	MessageUserConfigValid = "User ConfigMap is valid"
+	// MessageCABundleValid indicates the CA bundle is valid.
+	MessageCABundleValid = "CA bundle is valid"
+	// MessageConfigMapNamespacesWatched indicates the namespaces of the referenced ConfigMaps are watched.
+	MessageConfigMapNamespacesWatched = "Changes to the referenced ConfigMaps are watched"
+	// MessageAdmitted indicates the server pods are not waiting for Kueue admission.
+	MessageAdmitted = "Pods are not waiting for admission"
+	// MessageDeploymentInvalid indicates the deployment was rejected as invalid.
+	MessageDeploymentInvalid = "Deployment was rejected as invalid, update the LlamaStackDistribution spec to resolve it"
+	// MessageDeploymentForbidden indicates the deployment was rejected by admission control or a quota.
+	MessageDeploymentForbidden = "Deployment was forbidden by admission control or a resource quota, retrying periodically"
+	// MessageDeploymentConflict indicates the deployment kept conflicting with concurrent updates.
+	MessageDeploymentConflict = "Deployment update conflicted with concurrent changes, retrying"
+	// MessageManifestRenderFailed indicates the manifests could not be rendered from the spec.
+	MessageManifestRenderFailed = "Manifests could not be rendered, update the LlamaStackDistribution spec to resolve it"
+	// MessageImmutableFieldChanged indicates the spec changes an immutable field of a managed resource.
+	MessageImmutableFieldChanged = "The spec changes an immutable field of a managed resource, revert the change or recreate the LlamaStackDistribution"
+	// MessageResourceNotOwned indicates an object with the name of a managed resource is not owned by the instance.
+	MessageResourceNotOwned = "An object with the name of a managed resource is not owned by this instance, " +
+		"delete or rename it to resolve it, retrying periodically"
+	// MessageResourceScopeUnknown indicates the scope of a managed resource kind could not be determined.
+	MessageResourceScopeUnknown = "The scope of a managed resource kind could not be determined, retrying"
+	// MessageNetworkPolicyEnforced indicates the NetworkPolicy is applied and enforced.
+	MessageNetworkPolicyEnforced = "NetworkPolicy applied and enforced"
+	// MessageNetworkPolicyUnverified indicates the NetworkPolicy is applied but enforcement is unverified.
+	MessageNetworkPolicyUnverified = "NetworkPolicy applied (enforcement unverified)"
+	// MessageIngressAdmitted indicates an ingress controller admitted the Ingress.
+	MessageIngressAdmitted = "Ingress admitted by the ingress controller"
+	// MessageIngressPending indicates the Ingress is waiting for an ingress controller.
+	MessageIngressPending = "Ingress applied, waiting for an ingress controller to admit it"
+	// MessageRouteAdmitted indicates the router admitted the Route.
+	MessageRouteAdmitted = "Route admitted by the router"
+	// MessageRoutePending indicates the Route is waiting for the router.
+	MessageRoutePending = "Route applied, waiting for the router to admit it"
+	// MessageWithinQuota indicates the pods fit within the namespace ResourceQuotas.
+	MessageWithinQuota = "Pods fit within the ResourceQuotas of the namespace"
+	// MessageInsufficientPermissions indicates the reconciliation failed because the operator may not read a referenced object.
+	MessageInsufficientPermissions = "Operator is not allowed to read a referenced object, see the InsufficientPermissions condition"
+)
+
+// Event reasons.
+const (
+	// EventReasonResourceAdopted indicates an existing resource was taken over by the instance.
+	EventReasonResourceAdopted = "ResourceAdopted"
+	// EventReasonResourceRecreated indicates an existing resource was deleted to be recreated by the operator.
+	EventReasonResourceRecreated = "ResourceRecreated"
+	// EventReasonAdoptionFailed indicates an existing resource could not be taken over.
+	EventReasonAdoptionFailed = "AdoptionFailed"
+	// EventReasonConfigMapNotFound indicates the referenced user ConfigMap does not exist.
+	EventReasonConfigMapNotFound = "ConfigMapNotFound"
+	// EventReasonSecretNotFound indicates the Secret referenced by the secretRef does not exist.
+	EventReasonSecretNotFound = "SecretNotFound"
+	// EventReasonImagePullSecretNotFound indicates an image pull Secret of the spec does not exist.
+	EventReasonImagePullSecretNotFound = "ImagePullSecretNotFound"
+	// EventReasonUserConfigValidated indicates new content of the user ConfigMap was validated.
+	EventReasonUserConfigValidated = "UserConfigValidated"
+	// EventReasonUserConfigInvalid indicates the content of the user ConfigMap failed validation.
+	EventReasonUserConfigInvalid = "UserConfigInvalid"
+	// EventReasonUserConfigMapDeleted indicates the user ConfigMap was deleted after it was found.
+	EventReasonUserConfigMapDeleted = "UserConfigMapDeleted"
+	// EventReasonPVCRetained indicates the PVC was kept when the instance was deleted.
+	EventReasonPVCRetained = "PVCRetained"
+	// EventReasonPVCDeleted indicates the PVC was deleted with the instance.
+	EventReasonPVCDeleted = "PVCDeleted"
+	// EventReasonClusterRoleBindingDeleted indicates a ClusterRoleBinding created for the instance was deleted with it.
+	EventReasonClusterRoleBindingDeleted = "ClusterRoleBindingDeleted"
+	// EventReasonReady indicates the instance transitioned to the Ready phase.
+	EventReasonReady = "Ready"
+	// EventReasonFailed indicates the instance transitioned to the Failed phase.
+	EventReasonFailed = "Failed"
+	// EventReasonHealthCheckFailed indicates the server health endpoint did not report healthy.
+	EventReasonHealthCheckFailed = "HealthCheckFailed"
+	// EventReasonNetworkPolicyCreated indicates the NetworkPolicy of the instance was created.
+	EventReasonNetworkPolicyCreated = "NetworkPolicyCreated"
+	// EventReasonNetworkPolicyUpdated indicates the NetworkPolicy of the instance was updated.
+	EventReasonNetworkPolicyUpdated = "NetworkPolicyUpdated"
+	// EventReasonNetworkPolicyDeleted indicates the NetworkPolicy of the instance was deleted after disabling the feature.
+	EventReasonNetworkPolicyDeleted = "NetworkPolicyDeleted"
+	// EventReasonHealthCheckRecovered indicates the server health endpoint reported healthy after failing.
+	EventReasonHealthCheckRecovered = "HealthCheckRecovered"
+	// EventReasonDeploymentCreated indicates the Deployment of the instance was created.
+	EventReasonDeploymentCreated = "DeploymentCreated"
+	// EventReasonDeploymentUpdated indicates the Deployment of the instance was updated to match the spec.
+	EventReasonDeploymentUpdated = "DeploymentUpdated"
+	// EventReasonDistributionNotSupported indicates the distribution name of the spec is not a known distribution.
+	EventReasonDistributionNotSupported = "DistributionNotSupported"
+	// EventReasonRollbackTriggered indicates the Deployment was rolled back after a stalled rollout.
+	EventReasonRollbackTriggered = "RollbackTriggered"
+	// EventReasonStorageBackendChanged indicates the storage was switched between emptyDir and a PVC.
+	EventReasonStorageBackendChanged = "StorageBackendChanged"
+	// EventReasonQuotaWouldBeExceeded indicates the pods would exceed the namespace ResourceQuotas.
+	EventReasonQuotaWouldBeExceeded = "QuotaWouldBeExceeded"
+	// EventReasonRolloutQueued indicates a new pod template waits for the rollout of another instance of the namespace.
+	EventReasonRolloutQueued = "RolloutQueued"
+	// EventReasonInsufficientPermissions indicates the operator may not read a referenced object.
+	EventReasonInsufficientPermissions = "InsufficientPermissions"
+	// EventReasonReconcilePaused indicates the reconciliation of the instance was suspended by the pause annotation.
+	EventReasonReconcilePaused = "ReconcilePaused"
+	// EventReasonReconcileResumed indicates the reconciliation of the instance resumed after a pause.
+	EventReasonReconcileResumed = "ReconcileResumed"
 )
 
 // SetDeploymentReadyCondition sets the deployment ready condition.
@@ -80,6 +282,17 @@ func SetDeploymentReadyCondition(status *llamav1alpha1.LlamaStackDistributionSta
 	SetCondition(status, condition)
 }
 
+// SetDeploymentFailedCondition sets the deployment ready condition to false with a specific failure reason.
+func SetDeploymentFailedCondition(status *llamav1alpha1.LlamaStackDistributionStatus, reason, message string) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeDeploymentReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
 // SetHealthCheckCondition sets the health check condition.
 func SetHealthCheckCondition(status *llamav1alpha1.LlamaStackDistributionStatus, healthy bool, message string) {
 	condition := metav1.Condition{
@@ -90,10 +303,12 @@ func SetHealthCheckCondition(status *llamav1alpha1.LlamaStackDistributionStatus,
 		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
 	}
 
+	if message != "" {
+		condition.Message = message
+	}
 	if !healthy {
 		condition.Status = metav1.ConditionFalse
 		condition.Reason = ReasonHealthCheckFailed
-		condition.Message = message
 	}
 
 	SetCondition(status, condition)
@@ -137,6 +352,259 @@ func SetServiceReadyCondition(status *llamav1alpha1.LlamaStackDistributionStatus
 	SetCondition(status, condition)
 }
 
+// SetUserConfigReadyCondition sets the user config ready condition.
+func SetUserConfigReadyCondition(status *llamav1alpha1.LlamaStackDistributionStatus, ready bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeUserConfigReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonUserConfigValid,
+		Message:            MessageUserConfigValid,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !ready {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonUserConfigInvalid
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetUserConfigMapDeletedCondition sets the user config ready condition of a deleted user ConfigMap.
+func SetUserConfigMapDeletedCondition(status *llamav1alpha1.LlamaStackDistributionStatus, message string) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeUserConfigReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonUserConfigMapDeleted,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
+// SetCABundleReadyCondition sets the CA bundle ready condition.
+func SetCABundleReadyCondition(status *llamav1alpha1.LlamaStackDistributionStatus, ready bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeCABundleReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonCABundleValid,
+		Message:            MessageCABundleValid,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if !ready {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonCABundleInvalid
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetConfigMapWatchedCondition sets the ConfigMapWatched condition.
+// The condition is False if referenced ConfigMaps are in namespaces excluded from the operator ConfigMap watch.
+func SetConfigMapWatchedCondition(status *llamav1alpha1.LlamaStackDistributionStatus, unwatchedNamespaces []string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeConfigMapWatched,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonConfigMapNamespacesWatched,
+		Message:            MessageConfigMapNamespacesWatched,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if len(unwatchedNamespaces) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonConfigMapNamespaceNotWatched
+		condition.Message = fmt.Sprintf("Namespaces %s are excluded from the operator ConfigMap watch, "+
+			"changes to the referenced ConfigMaps are only detected on the next reconciliation", strings.Join(unwatchedNamespaces, ", "))
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetRollbackTriggeredCondition marks the Deployment as rolled back to the last known-good pod template.
+// The condition is removed once the spec renders a new pod template.
+func SetRollbackTriggeredCondition(status *llamav1alpha1.LlamaStackDistributionStatus, message string) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeRollbackTriggered,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonRolloutStalled,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
+// SetRolloutQueuedCondition marks the new pod template as held back while the holder of the namespace
+// rollout lock rolls out. The condition is removed once the pod template is applied.
+func SetRolloutQueuedCondition(status *llamav1alpha1.LlamaStackDistributionStatus, holder string) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeRolloutQueued,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonNamespaceRolloutInProgress,
+		Message:            fmt.Sprintf("Rollout of the new pod template waits for the rollout of %s to complete", holder),
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
+// SetStorageBackendChangedCondition warns that the storage switches from one backend to another without
+// migrating the data. The switch is blocked until it is acknowledged.
+func SetStorageBackendChangedCondition(status *llamav1alpha1.LlamaStackDistributionStatus, from, to string, acknowledged bool) {
+	condition := metav1.Condition{
+		Type:   ConditionTypeStorageBackendChanged,
+		Status: metav1.ConditionTrue,
+		Reason: ReasonStorageBackendChangeBlocked,
+		Message: fmt.Sprintf("Storage backend change from %s to %s is blocked because the data is not migrated, "+
+			"set the %s annotation to %q to proceed", from, to, llamav1alpha1.AcknowledgeStorageChangeAnnotation,
+			storageChangeAcknowledgment(from, to)),
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if acknowledged {
+		condition.Reason = ReasonStorageBackendChangeAcknowledged
+		condition.Message = fmt.Sprintf("Storage backend changed from %s to %s, the data is not migrated", from, to)
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetQuotaWouldBeExceededCondition sets the QuotaWouldBeExceeded condition.
+// The condition is True with the exceeded quotas as message if the pods do not fit within the ResourceQuotas.
+func SetQuotaWouldBeExceededCondition(status *llamav1alpha1.LlamaStackDistributionStatus, exceeded []string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeQuotaWouldBeExceeded,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonWithinQuota,
+		Message:            MessageWithinQuota,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if len(exceeded) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonQuotaExceeded
+		condition.Message = "Pods would exceed the ResourceQuotas of the namespace: " + strings.Join(exceeded, "; ")
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetQueuedForResourcesCondition sets the QueuedForResources condition.
+// The condition is True while pods are held by Kueue and the message explains what they are waiting for.
+func SetQueuedForResourcesCondition(status *llamav1alpha1.LlamaStackDistributionStatus, queued bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeQueuedForResources,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonAdmitted,
+		Message:            MessageAdmitted,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	if queued {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonWaitingForAdmission
+		condition.Message = message
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetNetworkPolicyReadyCondition sets the NetworkPolicy ready condition.
+// An applied NetworkPolicy is reported as enforced only if the cluster network plugin is known to enforce it.
+func SetNetworkPolicyReadyCondition(status *llamav1alpha1.LlamaStackDistributionStatus, applied, enforced bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeNetworkPolicyReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonNetworkPolicyEnforced,
+		Message:            MessageNetworkPolicyEnforced,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	switch {
+	case !applied:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonNetworkPolicyFailed
+		condition.Message = message
+	case !enforced:
+		condition.Reason = ReasonNetworkPolicyUnverified
+		condition.Message = MessageNetworkPolicyUnverified
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetIngressReadyCondition sets the Ingress ready condition.
+// An applied Ingress is reported as ready once an ingress controller published its address in the Ingress status.
+func SetIngressReadyCondition(status *llamav1alpha1.LlamaStackDistributionStatus, applied, admitted bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeIngressReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonIngressAdmitted,
+		Message:            MessageIngressAdmitted,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	switch {
+	case !applied:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonIngressFailed
+		condition.Message = message
+	case !admitted:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonIngressPending
+		condition.Message = MessageIngressPending
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetRouteReadyCondition sets the Route ready condition.
+// An applied Route is reported as ready once the router admitted it.
+func SetRouteReadyCondition(status *llamav1alpha1.LlamaStackDistributionStatus, applied, admitted bool, message string) {
+	condition := metav1.Condition{
+		Type:               ConditionTypeRouteReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonRouteAdmitted,
+		Message:            MessageRouteAdmitted,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	}
+
+	switch {
+	case !applied:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonRouteFailed
+		condition.Message = message
+	case !admitted:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonRoutePending
+		condition.Message = MessageRoutePending
+	}
+
+	SetCondition(status, condition)
+}
+
+// SetUnreachableCondition marks the server endpoint as unreachable with health probing suspended.
+// The condition is removed once the endpoint responds again.
+func SetUnreachableCondition(status *llamav1alpha1.LlamaStackDistributionStatus, message string) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeUnreachable,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonHealthCheckSuspended,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
+// SetInsufficientPermissionsCondition sets the InsufficientPermissions condition with the missing permission as message.
+// The condition is removed once the operator can read the referenced objects again.
+func SetInsufficientPermissionsCondition(status *llamav1alpha1.LlamaStackDistributionStatus, message string) {
+	SetCondition(status, metav1.Condition{
+		Type:               ConditionTypeInsufficientPermissions,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonConfigMapAccessForbidden,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(metav1.Now().UTC()),
+	})
+}
+
 // SetCondition sets a condition in the status.
 func SetCondition(status *llamav1alpha1.LlamaStackDistributionStatus, condition metav1.Condition) {
 	// Initialize conditions if needed
@@ -157,6 +625,29 @@ func SetCondition(status *llamav1alpha1.LlamaStackDistributionStatus, condition
 	status.Conditions = append(status.Conditions, condition)
 }
 
+// StampConditionTransitions sets the LastTransitionTime of the conditions to now. Conditions whose status did not
+// change since the previous conditions keep their previous LastTransitionTime, as the Kubernetes API conventions require.
+func StampConditionTransitions(status *llamav1alpha1.LlamaStackDistributionStatus, previous []metav1.Condition, now time.Time) {
+	for i := range status.Conditions {
+		condition := &status.Conditions[i]
+		if old := meta.FindStatusCondition(previous, condition.Type); old != nil && old.Status == condition.Status {
+			condition.LastTransitionTime = old.LastTransitionTime
+			continue
+		}
+		condition.LastTransitionTime = metav1.NewTime(now)
+	}
+}
+
+// RemoveCondition removes a condition by type from the status.
+func RemoveCondition(status *llamav1alpha1.LlamaStackDistributionStatus, conditionType string) {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			status.Conditions = append(status.Conditions[:i], status.Conditions[i+1:]...)
+			return
+		}
+	}
+}
+
 // GetCondition returns a condition by type.
 func GetCondition(status *llamav1alpha1.LlamaStackDistributionStatus, conditionType string) *metav1.Condition {
 	if status == nil || status.Conditions == nil {
@@ -181,3 +672,82 @@ func IsConditionFalse(status *llamav1alpha1.LlamaStackDistributionStatus, condit
 	condition := GetCondition(status, conditionType)
 	return condition != nil && condition.Status == metav1.ConditionFalse
 }
+
+// Health statuses reported by llama-stack for the providers.
+const (
+	// providerHealthOK is the health status of a healthy provider.
+	providerHealthOK = "OK"
+	// providerHealthError is the health status of an unhealthy provider.
+	providerHealthError = "Error"
+)
+
+// SetStatusProviders stores the providers fetched at now in the distribution config, keeping at most maxProviders entries.
+// Unhealthy providers are kept first so problems remain visible when the list is truncated.
+// A maxProviders value of zero or less disables the cap. The health counts cover all the providers, truncated or not.
+func SetStatusProviders(config *llamav1alpha1.DistributionConfig, providers []llamav1alpha1.ProviderInfo, maxProviders int,
+	now time.Time) {
+	config.TotalProviders = int32(len(providers)) //nolint:gosec // provider counts are far below int32 limits
+	config.HealthyProviders, config.UnhealthyProviders = 0, 0
+	for _, provider := range providers {
+		switch provider.Health.Status {
+		case providerHealthOK:
+			config.HealthyProviders++
+		case providerHealthError:
+			config.UnhealthyProviders++
+		}
+	}
+	config.ProvidersHealthy = fmt.Sprintf("%d/%d", config.HealthyProviders, config.TotalProviders)
+	config.ProvidersTruncated = false
+	config.ProvidersLastUpdated = ptr.To(metav1.NewTime(now))
+	config.ProvidersStale = false
+
+	if maxProviders <= 0 || len(providers) <= maxProviders {
+		config.Providers = providers
+		return
+	}
+
+	prioritized := make([]llamav1alpha1.ProviderInfo, len(providers))
+	copy(prioritized, providers)
+	sort.SliceStable(prioritized, func(i, j int) bool {
+		return prioritized[i].Health.Status != providerHealthOK && prioritized[j].Health.Status == providerHealthOK
+	})
+
+	config.Providers = prioritized[:maxProviders]
+	config.ProvidersTruncated = true
+}
+
+// ClearStatusProviders removes all provider information from the distribution config.
+func ClearStatusProviders(config *llamav1alpha1.DistributionConfig) {
+	config.Providers = nil
+	config.ProvidersTruncated = false
+	config.TotalProviders = 0
+	config.HealthyProviders = 0
+	config.UnhealthyProviders = 0
+	config.ProvidersHealthy = ""
+	config.ProvidersLastUpdated = nil
+	config.ProvidersStale = false
+}
+
+// MarkStatusProvidersStale flags the providers of the distribution config as not refreshed, keeping the last
+// known list. Nothing is flagged if the providers were never fetched.
+func MarkStatusProvidersStale(config *llamav1alpha1.DistributionConfig) {
+	config.ProvidersStale = config.ProvidersLastUpdated != nil
+}
+
+// SetStatusModels stores the models registered on the server in the distribution config, keeping at most maxModels
+// entries. A maxModels value of zero or less disables the cap.
+func SetStatusModels(config *llamav1alpha1.DistributionConfig, models []llamav1alpha1.ModelInfo, maxModels int) {
+	config.TotalModels = int32(len(models)) //nolint:gosec // model counts are far below int32 limits
+	config.ModelsTruncated = maxModels > 0 && len(models) > maxModels
+	if config.ModelsTruncated {
+		models = models[:maxModels]
+	}
+	config.Models = models
+}
+
+// ClearStatusModels removes all model information from the distribution config.
+func ClearStatusModels(config *llamav1alpha1.DistributionConfig) {
+	config.Models = nil
+	config.ModelsTruncated = false
+	config.TotalModels = 0
+}
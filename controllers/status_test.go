@@ -0,0 +1,563 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/deploy"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/statusexport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestSetStatusProviders(t *testing.T) {
+	// statusSizeBudget is well below the 1.5MB etcd object limit, leaving room for conditions and other fields.
+	const statusSizeBudget = 64 * 1024
+
+	newProviders := func(count int, unhealthyEvery int) []llamav1alpha1.ProviderInfo {
+		providers := make([]llamav1alpha1.ProviderInfo, 0, count)
+		for i := range count {
+			health := llamav1alpha1.ProviderHealthStatus{Status: "OK"}
+			if unhealthyEvery > 0 && i%unhealthyEvery == 0 {
+				health = llamav1alpha1.ProviderHealthStatus{Status: "Error", Message: "connection refused"}
+			}
+			providers = append(providers, llamav1alpha1.ProviderInfo{
+				API:          "inference",
+				ProviderID:   fmt.Sprintf("provider-%03d", i),
+				ProviderType: "remote::vllm",
+				Config:       apiextensionsv1.JSON{Raw: []byte(`{"url": "http://vllm.example.svc.cluster.local:8000/v1", "max_tokens": 4096, "tls_verify": true}`)},
+				Health:       health,
+			})
+		}
+		return providers
+	}
+
+	t.Run("providers below the cap are stored unchanged", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+		providers := newProviders(5, 0)
+
+		SetStatusProviders(config, providers, DefaultMaxStatusProviders, time.Now())
+
+		assert.Equal(t, providers, config.Providers)
+		assert.False(t, config.ProvidersTruncated)
+		assert.Equal(t, int32(5), config.TotalProviders)
+	})
+
+	t.Run("a zero cap disables truncation", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+
+		SetStatusProviders(config, newProviders(200, 0), 0, time.Now())
+
+		assert.Len(t, config.Providers, 200)
+		assert.False(t, config.ProvidersTruncated)
+	})
+
+	t.Run("large provider lists are truncated and keep unhealthy providers", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+		// Every 10th provider is unhealthy and the unhealthy ones are spread over the whole list.
+		providers := newProviders(200, 10)
+
+		SetStatusProviders(config, providers, DefaultMaxStatusProviders, time.Now())
+
+		require.Len(t, config.Providers, DefaultMaxStatusProviders)
+		assert.True(t, config.ProvidersTruncated)
+		assert.Equal(t, int32(200), config.TotalProviders)
+		for i := range 20 {
+			assert.Equal(t, "Error", config.Providers[i].Health.Status, "unhealthy providers should be kept first")
+		}
+		assert.Equal(t, "provider-190", config.Providers[19].ProviderID, "original order should be preserved within a group")
+
+		status := llamav1alpha1.LlamaStackDistributionStatus{DistributionConfig: *config}
+		data, err := json.Marshal(status)
+		require.NoError(t, err)
+		assert.Less(t, len(data), statusSizeBudget, "status should stay within the size budget")
+	})
+
+	t.Run("health counts cover the truncated providers", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+		providers := newProviders(40, 10)
+		providers[1].Health = llamav1alpha1.ProviderHealthStatus{Status: "Not Implemented"}
+
+		SetStatusProviders(config, providers, DefaultMaxStatusProviders, time.Now())
+
+		assert.Equal(t, int32(35), config.HealthyProviders)
+		assert.Equal(t, int32(4), config.UnhealthyProviders, "a provider without health check should not count as unhealthy")
+		assert.Equal(t, "35/40", config.ProvidersHealthy)
+	})
+
+	t.Run("clearing resets the truncation marker", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+		SetStatusProviders(config, newProviders(200, 0), DefaultMaxStatusProviders, time.Now())
+
+		ClearStatusProviders(config)
+
+		assert.Nil(t, config.Providers)
+		assert.False(t, config.ProvidersTruncated)
+		assert.Zero(t, config.TotalProviders)
+		assert.Zero(t, config.HealthyProviders)
+		assert.Empty(t, config.ProvidersHealthy)
+		assert.Nil(t, config.ProvidersLastUpdated)
+	})
+
+	t.Run("stale providers are kept", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+		MarkStatusProvidersStale(config)
+		assert.False(t, config.ProvidersStale, "providers that were never fetched should not be flagged")
+
+		fetchedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		SetStatusProviders(config, newProviders(2, 0), DefaultMaxStatusProviders, fetchedAt)
+		MarkStatusProvidersStale(config)
+
+		assert.True(t, config.ProvidersStale)
+		assert.Len(t, config.Providers, 2)
+		assert.Equal(t, metav1.NewTime(fetchedAt), *config.ProvidersLastUpdated)
+
+		SetStatusProviders(config, newProviders(2, 0), DefaultMaxStatusProviders, fetchedAt.Add(time.Minute))
+		assert.False(t, config.ProvidersStale, "refreshed providers should no longer be stale")
+	})
+}
+
+func TestReconcileErrorMapping(t *testing.T) {
+	deploymentResource := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	classified := func(class error) error {
+		// Mirror the wrapping done between deploy.ApplyDeployment and Reconcile
+		applyErr := fmt.Errorf("failed to apply deployment: %w: %w", class,
+			k8serrors.NewBadRequest("raw API error"))
+		return fmt.Errorf("failed to reconcile Deployment: %w", applyErr)
+	}
+
+	testCases := []struct {
+		name             string
+		err              error
+		expectedReason   string
+		expectedMessage  string
+		expectedResult   ctrl.Result
+		expectedTerminal bool
+		expectReturnErr  bool
+	}{
+		{
+			name:             "invalid deployment is terminal",
+			err:              classified(deploy.ErrDeploymentInvalid),
+			expectedReason:   ReasonDeploymentInvalid,
+			expectedMessage:  MessageDeploymentInvalid,
+			expectedTerminal: true,
+			expectReturnErr:  true,
+		},
+		{
+			name:            "forbidden deployment is retried periodically",
+			err:             classified(deploy.ErrDeploymentForbidden),
+			expectedReason:  ReasonDeploymentForbidden,
+			expectedMessage: MessageDeploymentForbidden,
+			expectedResult:  ctrl.Result{RequeueAfter: deploymentForbiddenRequeueInterval},
+		},
+		{
+			name:            "conflicting deployment is retried with backoff",
+			err:             classified(deploy.ErrDeploymentConflict),
+			expectedReason:  ReasonDeploymentConflict,
+			expectedMessage: MessageDeploymentConflict,
+			expectReturnErr: true,
+		},
+		{
+			name:             "immutable field change of the deployment is terminal",
+			err:              classified(errors.Join(deploy.ErrDeploymentInvalid, deploy.ErrImmutableField)),
+			expectedReason:   ReasonImmutableFieldChanged,
+			expectedMessage:  MessageImmutableFieldChanged,
+			expectedTerminal: true,
+			expectReturnErr:  true,
+		},
+		{
+			name: "immutable field change of a manifest resource is terminal",
+			err: fmt.Errorf("failed to apply PVC manifests: %w",
+				fmt.Errorf("failed to manage resource Service/test: %w",
+					fmt.Errorf("failed to change immutable field: %w: %w", deploy.ErrImmutableField, k8serrors.NewBadRequest("raw API error")))),
+			expectedReason:   ReasonImmutableFieldChanged,
+			expectedMessage:  MessageImmutableFieldChanged,
+			expectedTerminal: true,
+			expectReturnErr:  true,
+		},
+		{
+			name: "render failure is terminal",
+			err: fmt.Errorf("failed to render PVC manifests: %w",
+				fmt.Errorf("failed to apply plugins: %w: %w", deploy.ErrRenderFailed, errors.New("raw API error"))),
+			expectedReason:   ReasonManifestRenderFailed,
+			expectedMessage:  MessageManifestRenderFailed,
+			expectedTerminal: true,
+			expectReturnErr:  true,
+		},
+		{
+			name:            "resource not owned is retried periodically",
+			err:             fmt.Errorf("failed to reconcile Ingress: %w", fmt.Errorf("failed to apply Ingress test: %w", deploy.ErrNotOwned)),
+			expectedReason:  ReasonResourceNotOwned,
+			expectedMessage: MessageResourceNotOwned,
+			expectedResult:  ctrl.Result{RequeueAfter: resourceNotOwnedRequeueInterval},
+		},
+		{
+			name:            "insufficient permissions are rechecked periodically",
+			err:             fmt.Errorf("failed to reconcile user ConfigMap: %w", fmt.Errorf("failed to get ConfigMap shared/test: %w", errInsufficientPermissions)),
+			expectedReason:  ReasonInsufficientPermissions,
+			expectedMessage: MessageInsufficientPermissions,
+			expectedResult:  ctrl.Result{RequeueAfter: insufficientPermissionsRequeueInterval},
+		},
+		{
+			name: "scope detection failure is retried with backoff",
+			err: fmt.Errorf("failed to manage resource ClusterRole/test: %w",
+				fmt.Errorf("failed to get REST mapping for GVK test: %w: %w", deploy.ErrScopeDetection, errors.New("raw API error"))),
+			expectedReason:  ReasonResourceScopeUnknown,
+			expectedMessage: MessageResourceScopeUnknown,
+			expectReturnErr: true,
+		},
+		{
+			name:             "blocked storage backend change waits for the instance to change",
+			err:              fmt.Errorf("failed to change storage backend: %w", errStorageBackendChangeBlocked),
+			expectedTerminal: true,
+			expectReturnErr:  true,
+		},
+		{
+			name:            "unclassified errors keep the generic handling",
+			err:             k8serrors.NewConflict(deploymentResource, "test", errors.New("conflict")),
+			expectReturnErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, message, ok := reconcileFailureReason(tc.err)
+			assert.Equal(t, tc.expectedReason != "", ok)
+			assert.Equal(t, tc.expectedReason, reason)
+			assert.Equal(t, tc.expectedMessage, message)
+			assert.NotContains(t, message, "raw API error", "condition messages should not carry raw API errors")
+
+			result, err := requeueForReconcileError(tc.err)
+			assert.Equal(t, tc.expectedResult, result)
+			if tc.expectReturnErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedTerminal, errors.Is(err, reconcile.TerminalError(nil)))
+		})
+	}
+}
+
+func TestSetNetworkPolicyReadyCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		applied         bool
+		enforced        bool
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "applied and enforced",
+			applied:         true,
+			enforced:        true,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonNetworkPolicyEnforced,
+			expectedMessage: MessageNetworkPolicyEnforced,
+		},
+		{
+			name:            "applied with enforcement unverified",
+			applied:         true,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonNetworkPolicyUnverified,
+			expectedMessage: MessageNetworkPolicyUnverified,
+		},
+		{
+			name:            "apply failed",
+			enforced:        true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonNetworkPolicyFailed,
+			expectedMessage: "apply failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &llamav1alpha1.LlamaStackDistributionStatus{}
+			SetNetworkPolicyReadyCondition(status, tc.applied, tc.enforced, "apply failed")
+
+			condition := GetCondition(status, ConditionTypeNetworkPolicyReady)
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, tc.expectedReason, condition.Reason)
+			assert.Equal(t, tc.expectedMessage, condition.Message)
+		})
+	}
+}
+
+func TestSetIngressReadyCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		applied         bool
+		admitted        bool
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "applied and admitted",
+			applied:         true,
+			admitted:        true,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonIngressAdmitted,
+			expectedMessage: MessageIngressAdmitted,
+		},
+		{
+			name:            "applied and waiting for admission",
+			applied:         true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonIngressPending,
+			expectedMessage: MessageIngressPending,
+		},
+		{
+			name:            "apply failed",
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonIngressFailed,
+			expectedMessage: "apply failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &llamav1alpha1.LlamaStackDistributionStatus{}
+			SetIngressReadyCondition(status, tc.applied, tc.admitted, "apply failed")
+
+			condition := GetCondition(status, ConditionTypeIngressReady)
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, tc.expectedReason, condition.Reason)
+			assert.Equal(t, tc.expectedMessage, condition.Message)
+		})
+	}
+}
+
+func TestSetCABundleReadyCondition(t *testing.T) {
+	status := &llamav1alpha1.LlamaStackDistributionStatus{}
+
+	SetCABundleReadyCondition(status, false, "failed to find CA bundle keys missing.crt")
+	condition := GetCondition(status, ConditionTypeCABundleReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReasonCABundleInvalid, condition.Reason)
+	assert.Equal(t, "failed to find CA bundle keys missing.crt", condition.Message)
+
+	SetCABundleReadyCondition(status, true, MessageCABundleValid)
+	condition = GetCondition(status, ConditionTypeCABundleReady)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonCABundleValid, condition.Reason)
+}
+
+func TestSetConfigMapWatchedCondition(t *testing.T) {
+	status := &llamav1alpha1.LlamaStackDistributionStatus{}
+
+	SetConfigMapWatchedCondition(status, []string{"kube-system", "openshift-config"})
+	condition := GetCondition(status, ConditionTypeConfigMapWatched)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReasonConfigMapNamespaceNotWatched, condition.Reason)
+	assert.Contains(t, condition.Message, "kube-system, openshift-config")
+
+	SetConfigMapWatchedCondition(status, nil)
+	condition = GetCondition(status, ConditionTypeConfigMapWatched)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonConfigMapNamespacesWatched, condition.Reason)
+	assert.Equal(t, MessageConfigMapNamespacesWatched, condition.Message)
+}
+
+func TestSetStorageBackendChangedCondition(t *testing.T) {
+	status := &llamav1alpha1.LlamaStackDistributionStatus{}
+
+	SetStorageBackendChangedCondition(status, storageBackendEmptyDir, storageBackendPVC, false)
+	condition := GetCondition(status, ConditionTypeStorageBackendChanged)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, ReasonStorageBackendChangeBlocked, condition.Reason)
+	assert.Contains(t, condition.Message, llamav1alpha1.AcknowledgeStorageChangeAnnotation+` annotation to "emptyDir->pvc"`)
+
+	SetStorageBackendChangedCondition(status, storageBackendEmptyDir, storageBackendPVC, true)
+	condition = GetCondition(status, ConditionTypeStorageBackendChanged)
+	require.NotNil(t, condition)
+	assert.Equal(t, ReasonStorageBackendChangeAcknowledged, condition.Reason)
+	assert.Equal(t, "Storage backend changed from emptyDir to PersistentVolumeClaim, the data is not migrated", condition.Message)
+}
+
+func TestSetRouteReadyCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		applied         bool
+		admitted        bool
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "applied and admitted",
+			applied:         true,
+			admitted:        true,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonRouteAdmitted,
+			expectedMessage: MessageRouteAdmitted,
+		},
+		{
+			name:            "applied and waiting for admission",
+			applied:         true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonRoutePending,
+			expectedMessage: MessageRoutePending,
+		},
+		{
+			name:            "apply failed",
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  ReasonRouteFailed,
+			expectedMessage: "apply failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &llamav1alpha1.LlamaStackDistributionStatus{}
+			SetRouteReadyCondition(status, tc.applied, tc.admitted, "apply failed")
+
+			condition := GetCondition(status, ConditionTypeRouteReady)
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, tc.expectedReason, condition.Reason)
+			assert.Equal(t, tc.expectedMessage, condition.Message)
+		})
+	}
+}
+
+// recordingPublisher is a stub sink recording the published snapshots.
+type recordingPublisher struct {
+	snapshots []statusexport.Snapshot
+	err       error
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, snapshot statusexport.Snapshot) error {
+	p.snapshots = append(p.snapshots, snapshot)
+	return p.err
+}
+
+func TestPublishStatusSnapshot(t *testing.T) {
+	newInstance := func() *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+			Status: llamav1alpha1.LlamaStackDistributionStatus{
+				Phase: llamav1alpha1.LlamaStackDistributionPhaseInitializing,
+			},
+		}
+	}
+
+	t.Run("publishes significant transitions", func(t *testing.T) {
+		publisher := &recordingPublisher{}
+		r := &LlamaStackDistributionReconciler{StatusPublisher: publisher}
+		instance := newInstance()
+		previous := instance.Status.DeepCopy()
+		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseReady
+
+		r.publishStatusSnapshot(t.Context(), previous, instance)
+
+		require.Len(t, publisher.snapshots, 1)
+		assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, publisher.snapshots[0].Phase)
+		assert.Equal(t, "http://test-instance-service.default.svc.cluster.local:8321", publisher.snapshots[0].URLs["service"])
+	})
+
+	t.Run("skips unchanged status", func(t *testing.T) {
+		publisher := &recordingPublisher{}
+		r := &LlamaStackDistributionReconciler{StatusPublisher: publisher}
+		instance := newInstance()
+
+		r.publishStatusSnapshot(t.Context(), instance.Status.DeepCopy(), instance)
+
+		assert.Empty(t, publisher.snapshots)
+	})
+
+	t.Run("fails open when the sink is unreachable", func(t *testing.T) {
+		publisher := &recordingPublisher{err: errors.New("connection refused")}
+		r := &LlamaStackDistributionReconciler{StatusPublisher: publisher}
+		instance := newInstance()
+		previous := instance.Status.DeepCopy()
+		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseFailed
+
+		assert.NotPanics(t, func() { r.publishStatusSnapshot(t.Context(), previous, instance) })
+		assert.Len(t, publisher.snapshots, 1)
+	})
+
+	t.Run("disabled without a publisher", func(t *testing.T) {
+		r := &LlamaStackDistributionReconciler{}
+		instance := newInstance()
+		previous := instance.Status.DeepCopy()
+		instance.Status.Phase = llamav1alpha1.LlamaStackDistributionPhaseReady
+
+		assert.NotPanics(t, func() { r.publishStatusSnapshot(t.Context(), previous, instance) })
+	})
+}
+
+func TestSetStatusModels(t *testing.T) {
+	newModels := func(count int) []llamav1alpha1.ModelInfo {
+		models := make([]llamav1alpha1.ModelInfo, 0, count)
+		for i := range count {
+			models = append(models, llamav1alpha1.ModelInfo{ModelID: fmt.Sprintf("model-%03d", i), ProviderID: "vllm", ModelType: "llm"})
+		}
+		return models
+	}
+
+	t.Run("below the cap", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+
+		SetStatusModels(config, newModels(3), 5)
+
+		assert.Len(t, config.Models, 3)
+		assert.Equal(t, int32(3), config.TotalModels)
+		assert.False(t, config.ModelsTruncated)
+	})
+
+	t.Run("above the cap", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+
+		SetStatusModels(config, newModels(8), 5)
+
+		assert.Equal(t, newModels(5), config.Models)
+		assert.Equal(t, int32(8), config.TotalModels, "the total should count the truncated models")
+		assert.True(t, config.ModelsTruncated)
+	})
+
+	t.Run("cleared", func(t *testing.T) {
+		config := &llamav1alpha1.DistributionConfig{}
+		SetStatusModels(config, newModels(8), 5)
+
+		ClearStatusModels(config)
+
+		assert.Equal(t, llamav1alpha1.DistributionConfig{}, *config)
+	})
+}
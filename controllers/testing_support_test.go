@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -105,6 +106,14 @@ func (b *DistributionBuilder) WithServiceAccountName(serviceAccountName string)
 	return b
 }
 
+func (b *DistributionBuilder) WithAnnotation(key, value string) *DistributionBuilder {
+	if b.instance.Annotations == nil {
+		b.instance.Annotations = map[string]string{}
+	}
+	b.instance.Annotations[key] = value
+	return b
+}
+
 func (b *DistributionBuilder) WithUserConfig(configMapName string) *DistributionBuilder {
 	b.instance.Spec.Server.UserConfig = &llamav1alpha1.UserConfigSpec{
 		ConfigMapName: configMapName,
@@ -112,6 +121,53 @@ func (b *DistributionBuilder) WithUserConfig(configMapName string) *Distribution
 	return b
 }
 
+func (b *DistributionBuilder) WithAdditionalConfigMaps(configMapNames ...string) *DistributionBuilder {
+	if b.instance.Spec.Server.UserConfig == nil {
+		b.instance.Spec.Server.UserConfig = &llamav1alpha1.UserConfigSpec{}
+	}
+	b.instance.Spec.Server.UserConfig.AdditionalConfigMaps = configMapNames
+	return b
+}
+
+func (b *DistributionBuilder) WithUserConfigs(userConfigs ...llamav1alpha1.UserConfigSpec) *DistributionBuilder {
+	b.instance.Spec.Server.UserConfigs = userConfigs
+	return b
+}
+
+func (b *DistributionBuilder) WithSecretRef(secretName string) *DistributionBuilder {
+	b.instance.Spec.Server.SecretRef = &llamav1alpha1.SecretRefSpec{
+		SecretName: secretName,
+	}
+	return b
+}
+
+func (b *DistributionBuilder) WithQueue(queueName string, schedulingGate bool) *DistributionBuilder {
+	b.instance.Spec.Server.Queue = &llamav1alpha1.QueueSpec{
+		Name:           queueName,
+		SchedulingGate: schedulingGate,
+	}
+	return b
+}
+
+func (b *DistributionBuilder) WithDashboard(enabled bool) *DistributionBuilder {
+	b.instance.Spec.Server.Monitoring = &llamav1alpha1.MonitoringSpec{
+		Dashboard: enabled,
+	}
+	return b
+}
+
+// WithTLSConfig sets the CA bundle ConfigMap trusted by the server, with the given keys concatenated into the bundle.
+func (b *DistributionBuilder) WithTLSConfig(caBundleConfigMapName string, keys ...string) *DistributionBuilder {
+	if b.instance.Spec.Server.TLSConfig == nil {
+		b.instance.Spec.Server.TLSConfig = &llamav1alpha1.TLSConfig{}
+	}
+	b.instance.Spec.Server.TLSConfig.CABundle = &llamav1alpha1.CABundleConfig{
+		ConfigMapName: caBundleConfigMapName,
+		ConfigMapKeys: keys,
+	}
+	return b
+}
+
 func (b *DistributionBuilder) Build() *llamav1alpha1.LlamaStackDistribution {
 	return b.instance.DeepCopy()
 }
@@ -273,6 +329,20 @@ func AssertPVCHasSize(t *testing.T, pvc *corev1.PersistentVolumeClaim, expectedS
 		"PVC should request %s storage, got %s", expectedSize, storageRequest.String())
 }
 
+// AssertPVCHasStorageClassAndAccessModes verifies that a PVC uses the storage class and access modes of the storage spec.
+func AssertPVCHasStorageClassAndAccessModes(t *testing.T, pvc *corev1.PersistentVolumeClaim, storage *llamav1alpha1.StorageSpec) {
+	t.Helper()
+	require.Equal(t, storage.StorageClassName, pvc.Spec.StorageClassName,
+		"PVC should use the configured storage class")
+
+	expectedAccessModes := storage.AccessModes
+	if len(expectedAccessModes) == 0 {
+		expectedAccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+	}
+	require.Equal(t, expectedAccessModes, pvc.Spec.AccessModes,
+		"PVC should use the configured access modes")
+}
+
 // AssertServicePortMatches verifies that a service has the expected port configuration.
 func AssertServicePortMatches(t *testing.T, service *corev1.Service, expectedPort corev1.ServicePort) {
 	t.Helper()
@@ -280,6 +350,15 @@ func AssertServicePortMatches(t *testing.T, service *corev1.Service, expectedPor
 	require.Equal(t, expectedPort, service.Spec.Ports[0], "Service port should match expected")
 }
 
+// AssertServiceExposureMatches verifies that a service has the type, source ranges and session affinity of the overrides.
+func AssertServiceExposureMatches(t *testing.T, service *corev1.Service, overrides *llamav1alpha1.ServiceOverrides) {
+	t.Helper()
+	require.Equal(t, overrides.Type, service.Spec.Type, "Service type should match the overrides")
+	require.Equal(t, overrides.LoadBalancerSourceRanges, service.Spec.LoadBalancerSourceRanges,
+		"Service source ranges should match the overrides")
+	require.Equal(t, overrides.SessionAffinity, service.Spec.SessionAffinity, "Service session affinity should match the overrides")
+}
+
 // AssertServiceAndDeploymentPortsAlign verifies that service target port matches deployment container port.
 func AssertServiceAndDeploymentPortsAlign(t *testing.T, service *corev1.Service, deployment *appsv1.Deployment) {
 	t.Helper()
@@ -374,6 +453,28 @@ func AssertNetworkPolicyAllowsDeploymentPort(t *testing.T, networkPolicy *networ
 		"NetworkPolicy is missing a rule to allow traffic from the operator in namespace '%s' on port %d", operatorNamespace, containerPort)
 }
 
+// AssertContainerPortsExposed verifies that every port of the server container is exposed by the Service under the
+// container port name, and allowed by the NetworkPolicy rules of the intra-stack and operator traffic.
+func AssertContainerPortsExposed(t *testing.T, service *corev1.Service, networkPolicy *networkingv1.NetworkPolicy, deployment *appsv1.Deployment) {
+	t.Helper()
+	require.Len(t, deployment.Spec.Template.Spec.Containers, 1, "Deployment should have exactly one container")
+	for _, containerPort := range deployment.Spec.Template.Spec.Containers[0].Ports {
+		servicePortIndex := slices.IndexFunc(service.Spec.Ports, func(p corev1.ServicePort) bool {
+			return p.TargetPort.IntVal == containerPort.ContainerPort && p.Protocol == containerPort.Protocol
+		})
+		require.NotEqual(t, -1, servicePortIndex, "Service should expose container port %d", containerPort.ContainerPort)
+		if containerPort.Name != "" {
+			require.Equal(t, containerPort.Name, service.Spec.Ports[servicePortIndex].Name,
+				"Service port should be named after container port %d", containerPort.ContainerPort)
+		}
+		for _, rule := range networkPolicy.Spec.Ingress {
+			require.True(t, slices.ContainsFunc(rule.Ports, func(p networkingv1.NetworkPolicyPort) bool {
+				return p.Port != nil && p.Port.IntVal == containerPort.ContainerPort
+			}), "NetworkPolicy rule should allow container port %d", containerPort.ContainerPort)
+		}
+	}
+}
+
 // AssertNetworkPolicyIsIngressOnly verifies that network policy is configured for ingress-only traffic.
 func AssertNetworkPolicyIsIngressOnly(t *testing.T, networkPolicy *networkingv1.NetworkPolicy) {
 	t.Helper()
@@ -411,6 +512,15 @@ func ReconcileDistribution(t *testing.T, instance *llamav1alpha1.LlamaStackDistr
 	require.NoError(t, err, "reconciliation should succeed")
 }
 
+// drainEvents returns the events recorded so far as "<type> <reason> <message>" strings.
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	return events
+}
+
 func ResourceTestName(instanceName, suffix string) string {
 	return instanceName + suffix
 }
@@ -21,15 +21,19 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	llamaxk8siov1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	"github.com/llamastack/llama-stack-k8s-operator/controllers"
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/cluster"
+	"github.com/llamastack/llama-stack-k8s-operator/pkg/metrics"
 	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -58,12 +62,31 @@ func init() { //nolint:gochecknoinits
 	//+kubebuilder:scaffold:scheme
 }
 
-func setupReconciler(ctx context.Context, cli client.Client, mgr ctrl.Manager, clusterInfo *cluster.ClusterInfo) error {
-	reconciler, err := controllers.NewLlamaStackDistributionReconciler(ctx, cli, scheme, clusterInfo)
-	if err != nil {
-		return fmt.Errorf("failed to create reconciler: %w", err)
+// managerCacheOptions restricts the ConfigMap informer to the namespaces selected in the operator ConfigMap.
+// The operator namespace stays watched for the distributions ConfigMap.
+func managerCacheOptions(reconciler *controllers.LlamaStackDistributionReconciler) cache.Options {
+	byObject, restricted := reconciler.ConfigMapWatchNamespaces.CacheByObject()
+	if !restricted {
+		return cache.Options{}
+	}
+	if byObject.Namespaces != nil && reconciler.ClusterInfo != nil {
+		byObject.Namespaces[reconciler.ClusterInfo.OperatorNamespace] = cache.Config{}
 	}
-	if err = reconciler.SetupWithManager(ctx, mgr); err != nil {
+	return cache.Options{ByObject: map[client.Object]cache.ByObject{&corev1.ConfigMap{}: byObject}}
+}
+
+// managerClientOptions reads the Secrets from the API server. They are only watched through their metadata, reading
+// them from the cache would start an informer caching every Secret of the cluster.
+func managerClientOptions() client.Options {
+	return client.Options{Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}}}}
+}
+
+func setupReconciler(ctx context.Context, reconciler *controllers.LlamaStackDistributionReconciler, mgr ctrl.Manager,
+	userConfigRevalidationInterval time.Duration, maxStatusProviders int) error {
+	reconciler.UserConfigRevalidationInterval = userConfigRevalidationInterval
+	reconciler.MaxStatusProviders = maxStatusProviders
+	reconciler.Recorder = mgr.GetEventRecorderFor("llama-stack-operator")
+	if err := reconciler.SetupWithManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed to create controller: %w", err)
 	}
 	return nil
@@ -83,11 +106,17 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var userConfigRevalidationInterval time.Duration
+	var maxStatusProviders int
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&userConfigRevalidationInterval, "user-config-revalidation-interval", controllers.DefaultUserConfigRevalidationInterval,
+		"How often referenced user ConfigMaps are re-validated without a change event. Set to 0 to disable.")
+	flag.IntVar(&maxStatusProviders, "max-status-providers", controllers.DefaultMaxStatusProviders,
+		"Maximum number of providers recorded in the LlamaStackDistribution status. Set to 0 to disable the cap.")
 	opts := zap.Options{
 		Development:     false,
 		StacktraceLevel: zapcore.PanicLevel, // Set higher than ErrorLevel to avoid stack traces in logs
@@ -101,8 +130,40 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	cfg, err := config.GetConfig()
+	if err != nil {
+		setupLog.Error(err, "failed to get config for setup")
+		os.Exit(1)
+	}
+
+	setupClient, err := client.New(cfg, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		setupLog.Error(err, "failed to set up clients")
+		os.Exit(1)
+	}
+
+	clusterInfo, err := cluster.NewClusterInfo(ctx, setupClient, embeddedDistributions)
+	if err != nil {
+		setupLog.Error(err, "failed to initialize cluster config")
+		os.Exit(1)
+	}
+
+	// The reconciler reads the operator ConfigMap, which also configures the manager cache
+	reconciler, err := controllers.NewLlamaStackDistributionReconciler(ctx, setupClient, scheme, clusterInfo)
+	if err != nil {
+		setupLog.Error(err, "failed to create reconciler")
+		os.Exit(1)
+	}
+
+	// The reconciler metrics are served by the metrics endpoint of the manager
+	metrics.Register()
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                     scheme,
+		Cache:                      managerCacheOptions(reconciler),
+		Client:                     managerClientOptions(),
 		Metrics:                    metricsserver.Options{BindAddress: metricsAddr},
 		HealthProbeBindAddress:     probeAddr,
 		LeaderElection:             enableLeaderElection,
@@ -126,27 +187,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	cfg, err := config.GetConfig()
-	if err != nil {
-		setupLog.Error(err, "failed to get config for setup")
-		os.Exit(1)
-	}
-
-	setupClient, err := client.New(cfg, client.Options{
-		Scheme: scheme,
-	})
-	if err != nil {
-		setupLog.Error(err, "failed to set up clients")
-		os.Exit(1)
-	}
-
-	clusterInfo, err := cluster.NewClusterInfo(ctx, setupClient, embeddedDistributions)
-	if err != nil {
-		setupLog.Error(err, "failed to initialize cluster config")
-		os.Exit(1)
-	}
-
-	if err := setupReconciler(ctx, setupClient, mgr, clusterInfo); err != nil {
+	if err := setupReconciler(ctx, reconciler, mgr, userConfigRevalidationInterval, maxStatusProviders); err != nil {
 		setupLog.Error(err, "failed to set up reconciler")
 		os.Exit(1)
 	}
@@ -4,17 +4,44 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/deploy"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// kueueLocalQueueGroupKind identifies the Kueue LocalQueue CRD used to detect a Kueue installation.
+var kueueLocalQueueGroupKind = schema.GroupKind{Group: "kueue.x-k8s.io", Kind: "LocalQueue"}
+
+// routeGroupKind identifies the OpenShift Route API used to detect an OpenShift cluster.
+var routeGroupKind = schema.GroupKind{Group: "route.openshift.io", Kind: "Route"}
+
+// serviceMonitorGroupKind identifies the Prometheus Operator ServiceMonitor CRD.
+var serviceMonitorGroupKind = schema.GroupKind{Group: "monitoring.coreos.com", Kind: "ServiceMonitor"}
+
 type ClusterInfo struct {
-	OperatorNamespace  string
+	OperatorNamespace string
+	// DistributionImages maps the embedded distribution names to their images. Use Distributions to include
+	// the distributions ConfigMap.
 	DistributionImages map[string]string
+	// KueueAvailable reports whether the Kueue CRDs are installed in the cluster.
+	KueueAvailable bool
+	// RouteAvailable reports whether the OpenShift Route API is served by the cluster.
+	RouteAvailable bool
+	// ServiceMonitorAvailable reports whether the Prometheus Operator ServiceMonitor CRD is installed in the cluster.
+	ServiceMonitorAvailable bool
+	// NetworkPolicyEnforced reports whether the cluster network plugin is known to enforce NetworkPolicies.
+	NetworkPolicyEnforced bool
+
+	distributionsMutex    sync.RWMutex
+	distributionOverrides map[string]string
 }
 
-// NewClusterInfo creates a new ClusterInfo object using embedded distributions data.
+// NewClusterInfo creates a new ClusterInfo object using embedded distributions data, merged with the
+// distributions ConfigMap of the operator namespace if it exists and is valid.
 func NewClusterInfo(ctx context.Context, client client.Client, embeddedDistributions []byte) (*ClusterInfo, error) {
 	operatorNamespace, err := deploy.GetOperatorNamespace()
 	if err != nil {
@@ -26,8 +53,74 @@ func NewClusterInfo(ctx context.Context, client client.Client, embeddedDistribut
 		return nil, fmt.Errorf("failed to parse embedded distributions JSON: %w", err)
 	}
 
+	// An invalid ConfigMap must not keep the operator from starting. The embedded distributions are used
+	// until the watch of the ConfigMap loads a fixed version.
+	distributionOverrides, err := LoadDistributionOverrides(ctx, client, operatorNamespace)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to load the distributions ConfigMap, using the embedded distribution images")
+		distributionOverrides = nil
+	}
+
+	kueueAvailable, err := IsKueueAvailable(client)
+	if err != nil {
+		return nil, err
+	}
+
+	routeAvailable, err := IsRouteAvailable(client)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceMonitorAvailable, err := IsServiceMonitorAvailable(client)
+	if err != nil {
+		return nil, err
+	}
+
+	networkPolicyEnforced, err := IsNetworkPolicyEnforced(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ClusterInfo{
-		OperatorNamespace:  operatorNamespace,
-		DistributionImages: distributionImages,
+		OperatorNamespace:       operatorNamespace,
+		DistributionImages:      distributionImages,
+		KueueAvailable:          kueueAvailable,
+		RouteAvailable:          routeAvailable,
+		ServiceMonitorAvailable: serviceMonitorAvailable,
+		NetworkPolicyEnforced:   networkPolicyEnforced,
+		distributionOverrides:   distributionOverrides,
 	}, nil
 }
+
+// IsKueueAvailable reports whether the Kueue CRDs are served by the cluster.
+func IsKueueAvailable(client client.Client) (bool, error) {
+	if _, err := client.RESTMapper().RESTMapping(kueueLocalQueueGroupKind); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to detect Kueue CRDs: %w", err)
+	}
+	return true, nil
+}
+
+// IsRouteAvailable reports whether the OpenShift Route API is served by the cluster.
+func IsRouteAvailable(client client.Client) (bool, error) {
+	if _, err := client.RESTMapper().RESTMapping(routeGroupKind); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to detect the Route API: %w", err)
+	}
+	return true, nil
+}
+
+// IsServiceMonitorAvailable reports whether the Prometheus Operator ServiceMonitor CRD is served by the cluster.
+func IsServiceMonitorAvailable(client client.Client) (bool, error) {
+	if _, err := client.RESTMapper().RESTMapping(serviceMonitorGroupKind); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to detect the ServiceMonitor CRD: %w", err)
+	}
+	return true, nil
+}
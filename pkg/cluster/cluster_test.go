@@ -1,9 +1,21 @@
 package cluster
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // TestDistributionsJSONIsValid ensures that the distributions.json file always
@@ -28,3 +40,175 @@ func TestDistributionsJSONIsValid(t *testing.T) {
 		}
 	}
 }
+
+// TestIsKueueAvailable ensures Kueue detection follows the presence of the LocalQueue CRD.
+func TestIsKueueAvailable(t *testing.T) {
+	kueueGroupVersion := schema.GroupVersion{Group: "kueue.x-k8s.io", Version: "v1beta1"}
+
+	withKueue := meta.NewDefaultRESTMapper([]schema.GroupVersion{kueueGroupVersion})
+	withKueue.Add(kueueGroupVersion.WithKind("LocalQueue"), meta.RESTScopeNamespace)
+	withoutKueue := meta.NewDefaultRESTMapper(nil)
+
+	tests := []struct {
+		name       string
+		restMapper meta.RESTMapper
+		expected   bool
+	}{
+		{name: "Kueue CRDs installed", restMapper: withKueue, expected: true},
+		{name: "Kueue CRDs missing", restMapper: withoutKueue, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithRESTMapper(tt.restMapper).Build()
+			available, err := IsKueueAvailable(c)
+			if err != nil {
+				t.Fatalf("failed to detect Kueue: %v", err)
+			}
+			if available != tt.expected {
+				t.Fatalf("failed to detect Kueue: expected %v, got %v", tt.expected, available)
+			}
+		})
+	}
+}
+
+// TestIsRouteAvailable ensures Route detection follows the presence of the OpenShift Route API.
+func TestIsRouteAvailable(t *testing.T) {
+	routeGroupVersion := schema.GroupVersion{Group: "route.openshift.io", Version: "v1"}
+
+	withRoute := meta.NewDefaultRESTMapper([]schema.GroupVersion{routeGroupVersion})
+	withRoute.Add(routeGroupVersion.WithKind("Route"), meta.RESTScopeNamespace)
+	withoutRoute := meta.NewDefaultRESTMapper(nil)
+
+	tests := []struct {
+		name       string
+		restMapper meta.RESTMapper
+		expected   bool
+	}{
+		{name: "Route API served", restMapper: withRoute, expected: true},
+		{name: "Route API missing", restMapper: withoutRoute, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithRESTMapper(tt.restMapper).Build()
+			available, err := IsRouteAvailable(c)
+			if err != nil {
+				t.Fatalf("failed to detect the Route API: %v", err)
+			}
+			if available != tt.expected {
+				t.Fatalf("failed to detect the Route API: expected %v, got %v", tt.expected, available)
+			}
+		})
+	}
+}
+
+// TestIsServiceMonitorAvailable ensures ServiceMonitor detection follows the presence of the Prometheus Operator CRD.
+func TestIsServiceMonitorAvailable(t *testing.T) {
+	monitoringGroupVersion := schema.GroupVersion{Group: "monitoring.coreos.com", Version: "v1"}
+
+	withServiceMonitor := meta.NewDefaultRESTMapper([]schema.GroupVersion{monitoringGroupVersion})
+	withServiceMonitor.Add(monitoringGroupVersion.WithKind("ServiceMonitor"), meta.RESTScopeNamespace)
+	withoutServiceMonitor := meta.NewDefaultRESTMapper(nil)
+
+	tests := []struct {
+		name       string
+		restMapper meta.RESTMapper
+		expected   bool
+	}{
+		{name: "ServiceMonitor CRD installed", restMapper: withServiceMonitor, expected: true},
+		{name: "ServiceMonitor CRD missing", restMapper: withoutServiceMonitor, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithRESTMapper(tt.restMapper).Build()
+			available, err := IsServiceMonitorAvailable(c)
+			if err != nil {
+				t.Fatalf("failed to detect the ServiceMonitor CRD: %v", err)
+			}
+			if available != tt.expected {
+				t.Fatalf("failed to detect the ServiceMonitor CRD: expected %v, got %v", tt.expected, available)
+			}
+		})
+	}
+}
+
+// TestIsNetworkPolicyEnforced ensures enforcement detection follows the network plugin DaemonSets in the cluster.
+func TestIsNetworkPolicyEnforced(t *testing.T) {
+	daemonSet := func(namespace, name string) client.Object {
+		return &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	daemonSetsResource := schema.GroupResource{Group: "apps", Resource: "daemonsets"}
+
+	tests := []struct {
+		name        string
+		inventory   []client.Object
+		listErr     error
+		expected    bool
+		expectError bool
+	}{
+		{
+			name:      "Calico",
+			inventory: []client.Object{daemonSet("kube-system", "kube-proxy"), daemonSet("calico-system", "calico-node")},
+			expected:  true,
+		},
+		{
+			name:      "Cilium",
+			inventory: []client.Object{daemonSet("kube-system", "cilium")},
+			expected:  true,
+		},
+		{
+			name:      "OVN-Kubernetes",
+			inventory: []client.Object{daemonSet("openshift-ovn-kubernetes", "ovnkube-node")},
+			expected:  true,
+		},
+		{
+			name:      "flannel only",
+			inventory: []client.Object{daemonSet("kube-flannel", "kube-flannel-ds"), daemonSet("kube-system", "kube-proxy")},
+			expected:  false,
+		},
+		{
+			name:      "no network plugin DaemonSets",
+			inventory: nil,
+			expected:  false,
+		},
+		{
+			name:     "listing DaemonSets forbidden",
+			listErr:  k8serrors.NewForbidden(daemonSetsResource, "", errors.New("forbidden")),
+			expected: false,
+		},
+		{
+			name:        "listing DaemonSets fails",
+			listErr:     k8serrors.NewServiceUnavailable("unavailable"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tt.inventory...)
+			if tt.listErr != nil {
+				builder = builder.WithInterceptorFuncs(interceptor.Funcs{
+					List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+						return tt.listErr
+					},
+				})
+			}
+
+			enforced, err := IsNetworkPolicyEnforced(t.Context(), builder.Build())
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("failed to detect NetworkPolicy enforcement: expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to detect NetworkPolicy enforcement: %v", err)
+			}
+			if enforced != tt.expected {
+				t.Fatalf("failed to detect NetworkPolicy enforcement: expected %v, got %v", tt.expected, enforced)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DistributionsConfigMapName is the name of the optional ConfigMap, in the operator namespace, mapping
+	// distribution names to images. Its entries are merged over the embedded distributions.
+	DistributionsConfigMapName = "llama-stack-distributions"
+	// DistributionsConfigMapKey is the key of the distributions ConfigMap holding the JSON or YAML map.
+	DistributionsConfigMapKey = "distributions"
+)
+
+// Distributions returns the images of the supported distributions by name: the embedded distributions,
+// overridden and extended by the distributions ConfigMap. The returned map is a copy.
+func (c *ClusterInfo) Distributions() map[string]string {
+	c.distributionsMutex.RLock()
+	defer c.distributionsMutex.RUnlock()
+
+	distributions := make(map[string]string, len(c.DistributionImages)+len(c.distributionOverrides))
+	maps.Copy(distributions, c.DistributionImages)
+	maps.Copy(distributions, c.distributionOverrides)
+	return distributions
+}
+
+// SetDistributionOverrides replaces the distributions read from the distributions ConfigMap.
+func (c *ClusterInfo) SetDistributionOverrides(overrides map[string]string) {
+	c.distributionsMutex.Lock()
+	defer c.distributionsMutex.Unlock()
+
+	c.distributionOverrides = overrides
+}
+
+// LoadDistributionOverrides reads the distributions ConfigMap of the namespace.
+// It returns nil if the ConfigMap does not exist.
+func LoadDistributionOverrides(ctx context.Context, reader client.Reader, namespace string) (map[string]string, error) {
+	configMap := &corev1.ConfigMap{}
+	key := types.NamespacedName{Name: DistributionsConfigMapName, Namespace: namespace}
+	if err := reader.Get(ctx, key, configMap); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get distributions ConfigMap %s: %w", key, err)
+	}
+	return ParseDistributionOverrides(configMap.Data)
+}
+
+// ParseDistributionOverrides parses the name to image map of the distributions ConfigMap data.
+func ParseDistributionOverrides(configMapData map[string]string) (map[string]string, error) {
+	distributionsYAML, exists := configMapData[DistributionsConfigMapKey]
+	if !exists {
+		return nil, nil
+	}
+
+	var distributions map[string]string
+	if err := yaml.Unmarshal([]byte(distributionsYAML), &distributions); err != nil {
+		return nil, fmt.Errorf("failed to parse distributions: %w", err)
+	}
+	for name, image := range distributions {
+		if name == "" {
+			return nil, errors.New("failed to validate distributions: empty distribution name")
+		}
+		if image == "" {
+			return nil, fmt.Errorf("failed to validate distributions: empty image for distribution %q", name)
+		}
+	}
+	return distributions, nil
+}
@@ -0,0 +1,122 @@
+package cluster
+
+import (
+	"maps"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestParseDistributionOverrides ensures the distributions ConfigMap accepts JSON and YAML maps.
+func TestParseDistributionOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]string
+		expected    map[string]string
+		expectError bool
+	}{
+		{name: "missing key", data: map[string]string{}},
+		{
+			name:     "YAML map",
+			data:     map[string]string{DistributionsConfigMapKey: "custom: registry.example.com/custom:1.0\n"},
+			expected: map[string]string{"custom": "registry.example.com/custom:1.0"},
+		},
+		{
+			name:     "JSON map",
+			data:     map[string]string{DistributionsConfigMapKey: `{"custom": "registry.example.com/custom:1.0"}`},
+			expected: map[string]string{"custom": "registry.example.com/custom:1.0"},
+		},
+		{name: "invalid YAML", data: map[string]string{DistributionsConfigMapKey: "custom: ["}, expectError: true},
+		{name: "empty image", data: map[string]string{DistributionsConfigMapKey: `custom: ""`}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distributions, err := ParseDistributionOverrides(tt.data)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("failed to validate distributions: expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to parse distributions: %v", err)
+			}
+			if !maps.Equal(distributions, tt.expected) {
+				t.Fatalf("failed to parse distributions: expected %v, got %v", tt.expected, distributions)
+			}
+		})
+	}
+}
+
+// TestDistributions ensures the distributions ConfigMap is merged over the embedded distributions.
+func TestDistributions(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: DistributionsConfigMapName, Namespace: "operator"},
+		Data: map[string]string{
+			DistributionsConfigMapKey: "ollama: mirror.example.com/ollama:latest\ncustom: registry.example.com/custom:1.0\n",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		objects  []client.Object
+		expected map[string]string
+	}{
+		{
+			name:     "no distributions ConfigMap",
+			expected: map[string]string{"ollama": "ollama:latest", "starter": "starter:latest"},
+		},
+		{
+			name:    "distributions ConfigMap",
+			objects: []client.Object{configMap},
+			expected: map[string]string{
+				"ollama":  "mirror.example.com/ollama:latest",
+				"starter": "starter:latest",
+				"custom":  "registry.example.com/custom:1.0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tt.objects...).Build()
+			overrides, err := LoadDistributionOverrides(t.Context(), c, "operator")
+			if err != nil {
+				t.Fatalf("failed to load distributions: %v", err)
+			}
+
+			info := &ClusterInfo{DistributionImages: map[string]string{"ollama": "ollama:latest", "starter": "starter:latest"}}
+			info.SetDistributionOverrides(overrides)
+
+			if distributions := info.Distributions(); !maps.Equal(distributions, tt.expected) {
+				t.Fatalf("failed to merge distributions: expected %v, got %v", tt.expected, distributions)
+			}
+		})
+	}
+}
+
+// TestNewClusterInfoInvalidDistributionsConfigMap ensures an invalid distributions ConfigMap does not keep the
+// operator from starting and falls back to the embedded distributions.
+func TestNewClusterInfoInvalidDistributionsConfigMap(t *testing.T) {
+	t.Setenv("OPERATOR_NAMESPACE", "operator")
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: DistributionsConfigMapName, Namespace: "operator"},
+		Data:       map[string]string{DistributionsConfigMapKey: "ollama: ["},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()
+
+	info, err := NewClusterInfo(t.Context(), c, []byte(`{"ollama": "ollama:latest"}`))
+	if err != nil {
+		t.Fatalf("failed to create cluster info with an invalid distributions ConfigMap: %v", err)
+	}
+
+	expected := map[string]string{"ollama": "ollama:latest"}
+	if distributions := info.Distributions(); !maps.Equal(distributions, expected) {
+		t.Fatalf("failed to fall back to the embedded distributions: expected %v, got %v", expected, distributions)
+	}
+}
@@ -0,0 +1,46 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// networkPolicyEnforcingDaemonSets lists the DaemonSet names of network plugins known to enforce NetworkPolicies.
+// Plugins such as flannel or kindnet on older releases accept NetworkPolicies without enforcing them.
+var networkPolicyEnforcingDaemonSets = []string{
+	"calico-node",  // Calico
+	"canal",        // Canal
+	"cilium",       // Cilium
+	"antrea-agent", // Antrea
+	"kube-router",  // kube-router
+	"weave-net",    // Weave Net
+	"ovnkube-node", // OVN-Kubernetes
+	"sdn",          // OpenShift SDN
+}
+
+// IsNetworkPolicyEnforced reports whether a network plugin known to enforce NetworkPolicies runs in the cluster.
+// It returns false if none is found or the operator is not allowed to list DaemonSets, in which case
+// enforcement cannot be verified.
+func IsNetworkPolicyEnforced(ctx context.Context, c client.Client) (bool, error) {
+	daemonSets := &metav1.PartialObjectMetadataList{}
+	daemonSets.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("DaemonSetList"))
+	if err := c.List(ctx, daemonSets); err != nil {
+		if k8serrors.IsForbidden(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to list DaemonSets for NetworkPolicy enforcement detection: %w", err)
+	}
+
+	for _, daemonSet := range daemonSets.Items {
+		if slices.Contains(networkPolicyEnforcingDaemonSets, daemonSet.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
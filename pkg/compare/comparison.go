@@ -20,7 +20,10 @@ import (
 // explicitly managed by the operator or the cluster.
 func HasUnexpectedServiceChanges(desired, current *corev1.Service) (bool, string) {
 	// Ignore fields that we are intentionally managing and expect to be different.
-	managedSpecFields := cmpopts.IgnoreFields(corev1.ServiceSpec{}, "Ports", "Selector")
+	// The type, load balancer settings and session affinity follow the service overrides of the LlamaStackDistribution.
+	// The API server defaults the session affinity config when the affinity is ClientIP.
+	managedSpecFields := cmpopts.IgnoreFields(corev1.ServiceSpec{}, "Ports", "Selector", "Type", "LoadBalancerClass",
+		"LoadBalancerSourceRanges", "SessionAffinity", "SessionAffinityConfig")
 
 	// Ignore metadata fields that are managed by the Kubernetes API server.
 	// Comparing these would cause unnecessary diffs on every update.
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 // baseService is a helper to create a consistent Service object for tests.
@@ -92,10 +93,37 @@ func TestHasUnexpectedServiceChanges(t *testing.T) {
 			expectChange: true,
 		},
 		{
-			name: "unexpected field changed - ServiceType",
+			name: "only managed type changed",
 			modifier: func(s *corev1.Service) {
 				s.Spec.Type = corev1.ServiceTypeNodePort
 			},
+			expectChange: false,
+		},
+		{
+			name: "only managed load balancer class changed",
+			modifier: func(s *corev1.Service) {
+				s.Spec.Type = corev1.ServiceTypeLoadBalancer
+				s.Spec.LoadBalancerClass = ptr.To("example.com/lb")
+			},
+			expectChange: false,
+		},
+		{
+			name: "only managed source ranges and session affinity changed",
+			modifier: func(s *corev1.Service) {
+				s.Spec.Type = corev1.ServiceTypeLoadBalancer
+				s.Spec.LoadBalancerSourceRanges = []string{"10.0.0.0/8"}
+				s.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+				s.Spec.SessionAffinityConfig = &corev1.SessionAffinityConfig{
+					ClientIP: &corev1.ClientIPConfig{TimeoutSeconds: ptr.To(int32(10800))},
+				}
+			},
+			expectChange: false,
+		},
+		{
+			name: "unexpected field changed - ExternalTrafficPolicy",
+			modifier: func(s *corev1.Service) {
+				s.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyLocal
+			},
 			expectChange: true,
 		},
 		{
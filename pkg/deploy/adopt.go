@@ -0,0 +1,131 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AdoptedResource describes an existing resource taken over by an instance.
+type AdoptedResource struct {
+	Kind string
+	Name string
+	// Recreated is set when the resource was incompatible and deleted so that it is recreated by the operator.
+	Recreated bool
+}
+
+// IsAdoptionRequested reports whether the instance asks to take over existing resources.
+func IsAdoptionRequested(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance.GetAnnotations()[llamav1alpha1.AdoptExistingAnnotation] == "true"
+}
+
+// AdoptExistingResources takes over the Deployment, Service and PVC that already exist with the names the
+// operator generates for the instance, by setting an owner reference on them. Resources already owned by the
+// instance are left untouched, and resources controlled by another owner are never taken over.
+// A Deployment whose selector does not match the operator pod labels cannot be updated in place, as the
+// selector is immutable, so it is deleted and recreated by the following reconciliation.
+func AdoptExistingResources(ctx context.Context, cli client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution) ([]AdoptedResource, error) {
+	candidates := []client.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: GetServiceName(instance), Namespace: instance.Namespace}},
+	}
+	// A PVC is only adopted if the instance uses persistent storage, so that unrelated data is not garbage collected
+	if instance.Spec.Server.Storage != nil {
+		candidates = append(candidates,
+			&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: GetPVCName(instance), Namespace: instance.Namespace}})
+	}
+
+	var adopted []AdoptedResource
+	for _, obj := range candidates {
+		kind := kindOf(obj)
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return adopted, fmt.Errorf("failed to get %s %s for adoption: %w", kind, obj.GetName(), err)
+		}
+
+		if isOwnedBy(obj, instance) {
+			continue
+		}
+		if controller := metav1.GetControllerOf(obj); controller != nil {
+			return adopted, fmt.Errorf("failed to adopt %s %s: controlled by %s %s", kind, obj.GetName(), controller.Kind, controller.Name)
+		}
+
+		if deployment, ok := obj.(*appsv1.Deployment); ok && !isSelectorCompatible(deployment, instance) {
+			if err := cli.Delete(ctx, deployment, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+				!k8serrors.IsNotFound(err) {
+				return adopted, fmt.Errorf("failed to delete Deployment %s with incompatible selector: %w", deployment.Name, err)
+			}
+			adopted = append(adopted, AdoptedResource{Kind: kind, Name: obj.GetName(), Recreated: true})
+			continue
+		}
+
+		if err := SetOwnerReference(instance, obj, scheme, ownerRefPolicy); err != nil {
+			return adopted, fmt.Errorf("failed to set owner reference on %s %s: %w", kind, obj.GetName(), err)
+		}
+		// Only the owner references are patched so the spec of the adopted resource is left untouched
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{
+				"ownerReferences": obj.GetOwnerReferences(),
+				"resourceVersion": obj.GetResourceVersion(),
+			},
+		})
+		if err != nil {
+			return adopted, fmt.Errorf("failed to marshal owner references of %s %s: %w", kind, obj.GetName(), err)
+		}
+		if err := cli.Patch(ctx, obj, client.RawPatch(k8stypes.MergePatchType, patch)); err != nil {
+			return adopted, fmt.Errorf("failed to adopt %s %s: %w", kind, obj.GetName(), err)
+		}
+		adopted = append(adopted, AdoptedResource{Kind: kind, Name: obj.GetName()})
+	}
+	return adopted, nil
+}
+
+// isOwnedBy reports whether obj has an owner reference to the instance.
+func isOwnedBy(obj client.Object, instance *llamav1alpha1.LlamaStackDistribution) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == instance.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// isSelectorCompatible reports whether the Deployment selector matches the pod labels set by the operator.
+func isSelectorCompatible(deployment *appsv1.Deployment, instance *llamav1alpha1.LlamaStackDistribution) bool {
+	if deployment.Spec.Selector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return !selector.Empty() && selector.Matches(labels.Set(GetSelectorLabels(instance)))
+}
+
+// kindOf returns the kind of the typed objects handled by AdoptExistingResources,
+// as the client does not populate the TypeMeta of typed objects.
+func kindOf(obj client.Object) string {
+	switch obj.(type) {
+	case *appsv1.Deployment:
+		return "Deployment"
+	case *corev1.Service:
+		return "Service"
+	case *corev1.PersistentVolumeClaim:
+		return "PersistentVolumeClaim"
+	default:
+		return fmt.Sprintf("%T", obj)
+	}
+}
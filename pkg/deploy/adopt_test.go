@@ -0,0 +1,125 @@
+package deploy
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAdoptExistingResources(t *testing.T) {
+	newInstance := func(storage *llamav1alpha1.StorageSpec) *llamav1alpha1.LlamaStackDistribution {
+		return &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-instance",
+				Namespace:   "default",
+				UID:         "test-uid",
+				Annotations: map[string]string{llamav1alpha1.AdoptExistingAnnotation: "true"},
+			},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{Storage: storage},
+			},
+		}
+	}
+	newDeployment := func(selector map[string]string, ownerReferences ...metav1.OwnerReference) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", OwnerReferences: ownerReferences},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: selector},
+				Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: selector}},
+			},
+		}
+	}
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test-instance-service", Namespace: "default"}}
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "test-instance-pvc", Namespace: "default"}}
+	compatibleSelector := map[string]string{"app.kubernetes.io/instance": "test-instance"}
+
+	testCases := []struct {
+		name             string
+		instance         *llamav1alpha1.LlamaStackDistribution
+		existing         []client.Object
+		expectedAdopted  []AdoptedResource
+		expectError      bool
+		expectDeployment bool
+	}{
+		{
+			name:             "adopts compatible resources",
+			instance:         newInstance(&llamav1alpha1.StorageSpec{}),
+			existing:         []client.Object{newDeployment(compatibleSelector), service.DeepCopy(), pvc.DeepCopy()},
+			expectDeployment: true,
+			expectedAdopted: []AdoptedResource{
+				{Kind: "Deployment", Name: "test-instance"},
+				{Kind: "Service", Name: "test-instance-service"},
+				{Kind: "PersistentVolumeClaim", Name: "test-instance-pvc"},
+			},
+		},
+		{
+			name:             "leaves the PVC alone without storage",
+			instance:         newInstance(nil),
+			existing:         []client.Object{newDeployment(compatibleSelector), pvc.DeepCopy()},
+			expectDeployment: true,
+			expectedAdopted:  []AdoptedResource{{Kind: "Deployment", Name: "test-instance"}},
+		},
+		{
+			name:     "recreates a Deployment with an incompatible selector",
+			instance: newInstance(nil),
+			existing: []client.Object{newDeployment(map[string]string{"app": "hand-rolled"})},
+			expectedAdopted: []AdoptedResource{
+				{Kind: "Deployment", Name: "test-instance", Recreated: true},
+			},
+		},
+		{
+			name:     "skips resources already owned by the instance",
+			instance: newInstance(nil),
+			existing: []client.Object{
+				newDeployment(compatibleSelector, metav1.OwnerReference{UID: "test-uid", Kind: "LlamaStackDistribution", Name: "test-instance"}),
+			},
+			expectDeployment: true,
+		},
+		{
+			name:     "refuses resources controlled by another owner",
+			instance: newInstance(nil),
+			existing: []client.Object{
+				newDeployment(compatibleSelector, metav1.OwnerReference{
+					UID: "other-uid", Kind: "Application", Name: "other", APIVersion: "argoproj.io/v1alpha1", Controller: ptr.To(true),
+				}),
+			},
+			expectDeployment: true,
+			expectError:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, llamav1alpha1.AddToScheme(scheme.Scheme))
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.existing...).Build()
+
+			adopted, err := AdoptExistingResources(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, tc.instance)
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedAdopted, adopted)
+
+			deployment := &appsv1.Deployment{}
+			err = cli.Get(t.Context(), client.ObjectKey{Name: "test-instance", Namespace: "default"}, deployment)
+			if !tc.expectDeployment {
+				require.True(t, k8serrors.IsNotFound(err), "Deployment should be deleted")
+				return
+			}
+			require.NoError(t, err)
+			if !tc.expectError {
+				require.True(t, isOwnedBy(deployment, tc.instance), "Deployment should be owned by the instance")
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetInstanceLabels returns the labels tracking the cluster-scoped resources created for the instance.
+// Cluster-scoped resources cannot be owned by a namespaced instance, so they are not garbage collected with it.
+func GetInstanceLabels(instance *llamav1alpha1.LlamaStackDistribution) map[string]string {
+	return map[string]string{
+		llamav1alpha1.InstanceNamespaceLabel: instance.Namespace,
+		llamav1alpha1.InstanceNameLabel:      instance.Name,
+	}
+}
+
+// setInstanceLabels adds the labels tracking the instance to a cluster-scoped resource.
+func setInstanceLabels(obj client.Object, instance *llamav1alpha1.LlamaStackDistribution) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for key, value := range GetInstanceLabels(instance) {
+		labels[key] = value
+	}
+	obj.SetLabels(labels)
+}
+
+// DeleteClusterScopedResources deletes the ClusterRoleBindings created for an instance being deleted. Bindings are
+// selected by the instance labels, so instances with the same name in other namespaces keep theirs. A binding
+// created before the labels were introduced is deleted if it has the rendered name and binds the ServiceAccount of
+// the instance. It returns the names of the deleted bindings.
+func DeleteClusterScopedResources(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution,
+	log logr.Logger) ([]string, error) {
+	bindings := &rbacv1.ClusterRoleBindingList{}
+	if err := c.List(ctx, bindings, client.MatchingLabels(GetInstanceLabels(instance))); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterRoleBindings: %w", err)
+	}
+
+	legacy := &rbacv1.ClusterRoleBinding{}
+	if err := c.Get(ctx, client.ObjectKey{Name: GetClusterRoleBindingName(instance)}, legacy); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get ClusterRoleBinding: %w", err)
+		}
+	} else if isUnlabeledBindingOf(legacy, instance) {
+		bindings.Items = append(bindings.Items, *legacy)
+	}
+
+	deleted := make([]string, 0, len(bindings.Items))
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if err := c.Delete(ctx, binding); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return deleted, fmt.Errorf("failed to delete ClusterRoleBinding %s: %w", binding.Name, err)
+		}
+		log.Info("Deleted ClusterRoleBinding of deleted instance", "name", binding.Name)
+		deleted = append(deleted, binding.Name)
+	}
+	return deleted, nil
+}
+
+// isUnlabeledBindingOf reports whether a ClusterRoleBinding without instance labels binds the ServiceAccount
+// rendered for the instance.
+func isUnlabeledBindingOf(binding *rbacv1.ClusterRoleBinding, instance *llamav1alpha1.LlamaStackDistribution) bool {
+	if _, labeled := binding.Labels[llamav1alpha1.InstanceNamespaceLabel]; labeled {
+		return false
+	}
+	for _, subject := range binding.Subjects {
+		if subject.Kind == rbacv1.ServiceAccountKind && subject.Name == GetServiceAccountName(instance) && subject.Namespace == instance.Namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package deploy
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestDeleteClusterScopedResources(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team-a"},
+	}
+	otherInstance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team-b"},
+	}
+	newBinding := func(name string, labels map[string]string, subjectNamespace string) *rbacv1.ClusterRoleBinding {
+		return &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      "test-instance-sa",
+				Namespace: subjectNamespace,
+			}},
+			RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "system:openshift:scc:anyuid"},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		bindings []client.Object
+		deleted  []string
+		kept     []string
+	}{
+		{
+			name: "labeled binding of the instance",
+			bindings: []client.Object{
+				newBinding("test-instance-crb", GetInstanceLabels(instance), "team-a"),
+				newBinding("test-instance-extra", GetInstanceLabels(instance), "team-a"),
+			},
+			deleted: []string{"test-instance-crb", "test-instance-extra"},
+		},
+		{
+			name: "binding of an instance with the same name in another namespace",
+			bindings: []client.Object{
+				newBinding("test-instance-crb", GetInstanceLabels(otherInstance), "team-b"),
+			},
+			kept: []string{"test-instance-crb"},
+		},
+		{
+			name: "unlabeled binding of the instance",
+			bindings: []client.Object{
+				newBinding("test-instance-crb", nil, "team-a"),
+			},
+			deleted: []string{"test-instance-crb"},
+		},
+		{
+			name: "unlabeled binding of another namespace",
+			bindings: []client.Object{
+				newBinding("test-instance-crb", nil, "team-b"),
+			},
+			kept: []string{"test-instance-crb"},
+		},
+		{
+			name: "no binding",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.bindings...).Build()
+
+			deleted, err := DeleteClusterScopedResources(t.Context(), cli, instance, logf.Log.WithName("test-cluster-scoped"))
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.deleted, deleted)
+			for _, name := range tc.deleted {
+				err := cli.Get(t.Context(), client.ObjectKey{Name: name}, &rbacv1.ClusterRoleBinding{})
+				require.True(t, k8serrors.IsNotFound(err), "ClusterRoleBinding %s should be deleted", name)
+			}
+			for _, name := range tc.kept {
+				require.NoError(t, cli.Get(t.Context(), client.ObjectKey{Name: name}, &rbacv1.ClusterRoleBinding{}),
+					"ClusterRoleBinding %s should be kept", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyConfigMap creates or updates a ConfigMap generated by the operator. A ConfigMap with the same name that is
+// not owned by the instance is left untouched. The ConfigMap is only updated if its data changed.
+func ApplyConfigMap(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, configMap *corev1.ConfigMap, log logr.Logger) error {
+	if err := SetOwnerReference(instance, configMap, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, configMap)
+
+	existing := &corev1.ConfigMap{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(configMap), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, configMap); err != nil {
+				return fmt.Errorf("failed to create ConfigMap: %w", err)
+			}
+			log.Info("Created ConfigMap", "name", configMap.Name)
+			return nil
+		}
+		return fmt.Errorf("failed to get ConfigMap: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply ConfigMap %s: %w", configMap.Name, ErrNotOwned)
+	}
+
+	if maps.Equal(existing.Data, configMap.Data) && maps.Equal(existing.Labels, configMap.Labels) &&
+		hasAnnotations(existing, configMap.Annotations) {
+		return nil
+	}
+
+	configMap.ResourceVersion = existing.ResourceVersion
+	if err := c.Update(ctx, configMap); err != nil {
+		return fmt.Errorf("failed to update ConfigMap: %w", classifyImmutableFieldError(err))
+	}
+	log.Info("Updated ConfigMap", "name", configMap.Name)
+	return nil
+}
+
+// HandleDisabledCABundle deletes the derived CA bundle ConfigMap when the CA bundle no longer needs concatenating.
+// Only a ConfigMap owned by the instance is deleted, so a user-managed ConfigMap with the same name is left alone.
+func HandleDisabledCABundle(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, log logr.Logger) error {
+	existing := &corev1.ConfigMap{}
+	key := client.ObjectKey{Name: GetCABundleConfigMapName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check CA bundle ConfigMap existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping deletion of CA bundle ConfigMap not owned by this instance", "name", existing.Name)
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete CA bundle ConfigMap: %w", err)
+	}
+	log.Info("Deleted CA bundle ConfigMap", "name", existing.Name)
+	return nil
+}
@@ -0,0 +1,71 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MaintenanceJobLabel is set on maintenance CronJobs to the name of the job in the spec.
+const MaintenanceJobLabel = "llamastack.io/maintenance-job"
+
+// ApplyCronJob creates or updates a CronJob. A CronJob with the same name that is not owned by the instance
+// is left untouched. On return cronJob holds the state of the CronJob in the cluster, including its status.
+func ApplyCronJob(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, cronJob *batchv1.CronJob, log logr.Logger) error {
+	if err := SetOwnerReference(instance, cronJob, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, cronJob)
+
+	existing := &batchv1.CronJob{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(cronJob), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, cronJob); err != nil {
+				return fmt.Errorf("failed to create CronJob: %w", err)
+			}
+			log.Info("Created CronJob", "name", cronJob.Name)
+			return nil
+		}
+		return fmt.Errorf("failed to get CronJob: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply CronJob %s: %w", cronJob.Name, ErrNotOwned)
+	}
+
+	cronJob.ResourceVersion = existing.ResourceVersion
+	if err := c.Update(ctx, cronJob); err != nil {
+		return fmt.Errorf("failed to update CronJob: %w", classifyImmutableFieldError(err))
+	}
+	log.V(1).Info("Updated CronJob", "name", cronJob.Name)
+	return nil
+}
+
+// DeleteStaleCronJobs deletes the maintenance CronJobs owned by the instance whose job is no longer in the spec.
+func DeleteStaleCronJobs(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, jobNames []string, log logr.Logger) error {
+	cronJobs := &batchv1.CronJobList{}
+	if err := c.List(ctx, cronJobs, client.InNamespace(instance.Namespace), client.HasLabels{MaintenanceJobLabel}); err != nil {
+		return fmt.Errorf("failed to list CronJobs: %w", err)
+	}
+
+	for i := range cronJobs.Items {
+		cronJob := &cronJobs.Items[i]
+		if !isOwnedBy(cronJob, instance) || slices.Contains(jobNames, cronJob.Labels[MaintenanceJobLabel]) {
+			continue
+		}
+		if err := c.Delete(ctx, cronJob); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete CronJob %s: %w", cronJob.Name, err)
+		}
+		log.Info("Deleted CronJob of removed maintenance job", "name", cronJob.Name)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HandleDisabledDashboard handles the deletion of the Grafana dashboard ConfigMap when monitoring is disabled.
+// Only a ConfigMap owned by the instance is deleted, so a user-managed ConfigMap with the same name is left alone.
+func HandleDisabledDashboard(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, log logr.Logger) error {
+	existing := &corev1.ConfigMap{}
+	key := client.ObjectKey{Name: GetDashboardConfigMapName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check dashboard ConfigMap existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping deletion of dashboard ConfigMap not owned by this instance", "name", existing.Name)
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete dashboard ConfigMap: %w", err)
+	}
+	log.Info("Deleted dashboard ConfigMap", "name", existing.Name)
+	return nil
+}
@@ -2,37 +2,84 @@ package deploy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 
 	"github.com/go-logr/logr"
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	ctrl "sigs.k8s.io/controller-runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-// ApplyDeployment creates or updates the Deployment.
-func ApplyDeployment(ctx context.Context, cli client.Client, scheme *runtime.Scheme,
-	instance *llamav1alpha1.LlamaStackDistribution, deployment *appsv1.Deployment, logger logr.Logger) error {
-	if err := ctrl.SetControllerReference(instance, deployment, scheme); err != nil {
-		return fmt.Errorf("failed to set controller reference: %w", err)
+const (
+	// deploymentFieldOwner is the field manager of the server-side applies of the Deployment
+	deploymentFieldOwner = "llama-stack-operator"
+	// replicasHandoverFieldOwner holds the replicas while they are handed over to an external controller
+	replicasHandoverFieldOwner = "llama-stack-operator-replicas-handover"
+)
+
+// ApplyDeployment creates or updates the Deployment, and reports whether it was created or updated.
+// Conflicts are retried with backoff; other failures are classified with one of the ErrDeployment errors.
+func ApplyDeployment(ctx context.Context, cli client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, deployment *appsv1.Deployment, logger logr.Logger) (controllerutil.OperationResult, error) {
+	if err := SetOwnerReference(instance, deployment, scheme, ownerRefPolicy); err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to set owner reference: %w", err)
 	}
+	SetCommonMetadata(instance, deployment)
+
+	result := controllerutil.OperationResultNone
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var err error
+		// The replicas of an autoscaled Deployment belong to the HorizontalPodAutoscaler
+		externalReplicas := instance.IsReplicaCountExternal() || instance.IsAutoscalingEnabled()
+		result, err = applyDeployment(ctx, cli, deployment, externalReplicas, logger)
+		return err
+	})
+	if err != nil {
+		return controllerutil.OperationResultNone, classifyDeploymentError(err)
+	}
+	return result, nil
+}
 
+// applyDeployment performs a single create or update attempt of the Deployment.
+// With externalReplicas, the replicas are only set on creation and left out of the updates, so that the
+// operator does not own the field and another controller may scale the Deployment.
+func applyDeployment(ctx context.Context, cli client.Client, deployment *appsv1.Deployment, externalReplicas bool,
+	logger logr.Logger) (controllerutil.OperationResult, error) {
 	found := &appsv1.Deployment{}
 	err := cli.Get(ctx, client.ObjectKeyFromObject(deployment), found)
-	if err != nil && errors.IsNotFound(err) {
+	if err != nil && k8serrors.IsNotFound(err) {
 		logger.Info("Creating Deployment", "deployment", deployment.Name)
-		return cli.Create(ctx, deployment)
+		if err := cli.Create(ctx, deployment, client.FieldOwner(deploymentFieldOwner)); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		return controllerutil.OperationResultCreated, nil
 	} else if err != nil {
-		return fmt.Errorf("failed to fetch deployment: %w", err)
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to fetch deployment: %w", err)
+	}
+
+	if err := removeStaleMetadata(ctx, cli, found, deployment); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	// Replicas left unset are managed by an autoscaler, keep the current count
+	if deployment.Spec.Replicas == nil || externalReplicas {
+		deployment.Spec.Replicas = found.Spec.Replicas
 	}
 
 	// For updates, preserve the existing selector since it's immutable
 	// and use server-side apply for other fields
-	if !reflect.DeepEqual(found.Spec, deployment.Spec) {
+	if !reflect.DeepEqual(found.Spec, deployment.Spec) || !hasLabels(found, deployment.Labels) ||
+		!hasAnnotations(found, deployment.Annotations) {
 		logger.Info("Updating Deployment", "deployment", deployment.Name)
 
 		// Preserve the existing selector to avoid immutable field error during upgrades
@@ -41,7 +88,143 @@ func ApplyDeployment(ctx context.Context, cli client.Client, scheme *runtime.Sch
 		// Use server-side apply to merge changes properly
 		// Ensure the deployment has proper TypeMeta for server-side apply
 		deployment.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
-		return cli.Patch(ctx, deployment, client.Apply, client.ForceOwnership, client.FieldOwner("llama-stack-operator"))
+		if externalReplicas {
+			if err := handOverReplicas(ctx, cli, found); err != nil {
+				return controllerutil.OperationResultNone, err
+			}
+			deployment.Spec.Replicas = nil
+		}
+		if err := cli.Patch(ctx, deployment, client.Apply, client.ForceOwnership, client.FieldOwner(deploymentFieldOwner)); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		return controllerutil.OperationResultUpdated, nil
+	}
+	return controllerutil.OperationResultNone, nil
+}
+
+// handOverReplicas keeps the current replicas of the Deployment when the operator stops applying them.
+// A field dropped from the applied configuration is removed if no other manager owns it, which would reset
+// the Deployment to a single replica, so the replicas are first applied by a second field manager sharing
+// ownership. Whoever scales the Deployment next takes the field over from it.
+func handOverReplicas(ctx context.Context, cli client.Client, found *appsv1.Deployment) error {
+	if found.Spec.Replicas == nil || !ownsField(found, deploymentFieldOwner, "f:spec", "f:replicas") {
+		return nil
+	}
+	handover := &unstructured.Unstructured{}
+	handover.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+	handover.SetName(found.Name)
+	handover.SetNamespace(found.Namespace)
+	if err := unstructured.SetNestedField(handover.Object, int64(*found.Spec.Replicas), "spec", "replicas"); err != nil {
+		return fmt.Errorf("failed to build replicas handover: %w", err)
+	}
+	if err := cli.Patch(ctx, handover, client.Apply, client.FieldOwner(replicasHandoverFieldOwner)); err != nil {
+		return fmt.Errorf("failed to hand over deployment replicas: %w", err)
+	}
+	return nil
+}
+
+// removeStaleMetadata removes the labels and annotations the operator set on the Deployment and its pod template
+// that the desired Deployment no longer has, e.g. after they were removed from the spec of the instance. A
+// server-side apply only drops the fields it applied itself, not those set when the Deployment was created.
+func removeStaleMetadata(ctx context.Context, cli client.Client, found, deployment *appsv1.Deployment) error {
+	patch := map[string]any{}
+	for _, metadata := range []struct {
+		path              []string
+		existing, desired map[string]string
+	}{
+		{[]string{"metadata", "labels"}, found.Labels, deployment.Labels},
+		{[]string{"metadata", "annotations"}, found.Annotations, deployment.Annotations},
+		{[]string{"spec", "template", "metadata", "labels"}, found.Spec.Template.Labels, deployment.Spec.Template.Labels},
+		{[]string{"spec", "template", "metadata", "annotations"}, found.Spec.Template.Annotations, deployment.Spec.Template.Annotations},
+	} {
+		fieldPath := make([]string, 0, len(metadata.path)+1)
+		for _, field := range metadata.path {
+			fieldPath = append(fieldPath, "f:"+field)
+		}
+		for key := range metadata.existing {
+			if _, desired := metadata.desired[key]; desired ||
+				!managesField(found, deploymentFieldOwner, "", append(fieldPath, "f:"+key)...) {
+				continue
+			}
+			if err := unstructured.SetNestedField(patch, nil, append(slices.Clone(metadata.path), key)...); err != nil {
+				return fmt.Errorf("failed to build stale metadata patch: %w", err)
+			}
+		}
+	}
+	if len(patch) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal stale metadata patch: %w", err)
+	}
+	if err := cli.Patch(ctx, found, client.RawPatch(k8stypes.MergePatchType, data), client.FieldOwner(deploymentFieldOwner)); err != nil {
+		return fmt.Errorf("failed to remove stale deployment metadata: %w", err)
 	}
 	return nil
 }
+
+// ownsField reports whether the field at path was applied by the given field manager.
+func ownsField(obj client.Object, manager string, path ...string) bool {
+	return managesField(obj, manager, metav1.ManagedFieldsOperationApply, path...)
+}
+
+// managesField reports whether the field at path is managed by the given field manager through the given
+// operation, or through any operation if it is empty.
+func managesField(obj client.Object, manager string, operation metav1.ManagedFieldsOperationType, path ...string) bool {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != manager || (operation != "" && entry.Operation != operation) || entry.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]any{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, found, _ := unstructured.NestedFieldNoCopy(fields, path...); found {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLabels reports whether obj carries all the given labels with the same values.
+func hasLabels(obj client.Object, labels map[string]string) bool {
+	existing := obj.GetLabels()
+	for key, value := range labels {
+		if existing[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// hasAnnotations reports whether obj carries all the given annotations with the same values.
+func hasAnnotations(obj client.Object, annotations map[string]string) bool {
+	existing := obj.GetAnnotations()
+	for key, value := range annotations {
+		if existing[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// classifyDeploymentError wraps API errors with the ErrDeployment class they belong to.
+// Errors that do not match a class are returned unchanged.
+func classifyDeploymentError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case isImmutableFieldError(err):
+		return fmt.Errorf("failed to apply deployment: %w: %w: %w", ErrDeploymentInvalid, ErrImmutableField, err)
+	case k8serrors.IsInvalid(err):
+		return fmt.Errorf("failed to apply deployment: %w: %w", ErrDeploymentInvalid, err)
+	case k8serrors.IsForbidden(err):
+		return fmt.Errorf("failed to apply deployment: %w: %w", ErrDeploymentForbidden, err)
+	case k8serrors.IsConflict(err):
+		return fmt.Errorf("failed to apply deployment: %w: %w", ErrDeploymentConflict, err)
+	default:
+		return err
+	}
+}
@@ -1,14 +1,25 @@
 package deploy
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -53,7 +64,7 @@ func TestApplyDeploymentPreservesSelector(t *testing.T) {
 		},
 	}
 
-	err := ApplyDeployment(ctx, k8sClient, k8sClient.Scheme(), instance, initialDeployment.DeepCopy(), logger)
+	_, err := ApplyDeployment(ctx, k8sClient, k8sClient.Scheme(), OwnerReferencePolicy{}, instance, initialDeployment.DeepCopy(), logger)
 	require.NoError(t, err)
 
 	// Verify the deployment was created
@@ -90,7 +101,7 @@ func TestApplyDeploymentPreservesSelector(t *testing.T) {
 		},
 	}
 
-	err = ApplyDeployment(ctx, k8sClient, k8sClient.Scheme(), instance, updatedDeployment.DeepCopy(), logger)
+	_, err = ApplyDeployment(ctx, k8sClient, k8sClient.Scheme(), OwnerReferencePolicy{}, instance, updatedDeployment.DeepCopy(), logger)
 	require.NoError(t, err)
 
 	err = k8sClient.Get(ctx, types.NamespacedName{Name: deploymentName, Namespace: namespace}, foundDeployment)
@@ -103,3 +114,318 @@ func TestApplyDeploymentPreservesSelector(t *testing.T) {
 	// And the other updates should be applied
 	require.Equal(t, "quay.io/llamastack/llama-stack-k8s-operator:v0.0.2", foundDeployment.Spec.Template.Spec.Containers[0].Image)
 }
+
+func TestApplyDeploymentClassifiesErrors(t *testing.T) {
+	logger := logf.Log.WithName("test-apply-deployment-errors")
+	deploymentResource := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-instance",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+	}
+	newDeployment := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-deployment-errors", Namespace: "default"},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		createErrors  []error
+		expectedClass error
+		expectedCalls int
+	}{
+		{
+			name:          "invalid deployment",
+			createErrors:  []error{k8serrors.NewInvalid(appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), "test-deployment-errors", nil)},
+			expectedClass: ErrDeploymentInvalid,
+			expectedCalls: 1,
+		},
+		{
+			name:          "quota exceeded",
+			createErrors:  []error{k8serrors.NewForbidden(deploymentResource, "test-deployment-errors", errors.New("exceeded quota"))},
+			expectedClass: ErrDeploymentForbidden,
+			expectedCalls: 1,
+		},
+		{
+			name: "conflict resolved by retrying",
+			createErrors: []error{
+				k8serrors.NewConflict(deploymentResource, "test-deployment-errors", errors.New("object was modified")),
+				nil,
+			},
+			expectedClass: nil,
+			expectedCalls: 2,
+		},
+		{
+			name:          "conflict persisting after retries",
+			createErrors:  nil, // every attempt conflicts
+			expectedClass: ErrDeploymentConflict,
+			expectedCalls: retry.DefaultBackoff.Steps,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						calls++
+						if tc.createErrors == nil {
+							return k8serrors.NewConflict(deploymentResource, obj.GetName(), errors.New("object was modified"))
+						}
+						if err := tc.createErrors[calls-1]; err != nil {
+							return err
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+				}).
+				Build()
+
+			_, err := ApplyDeployment(t.Context(), fakeClient, scheme.Scheme, OwnerReferencePolicy{}, instance, newDeployment(), logger)
+
+			if tc.expectedClass == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedClass)
+			}
+			require.Equal(t, tc.expectedCalls, calls, "unexpected number of create attempts")
+		})
+	}
+}
+
+func TestApplyDeploymentReplicaManagementPolicy(t *testing.T) {
+	logger := logf.Log.WithName("test-apply-deployment-replicas")
+
+	testCases := []struct {
+		name             string
+		policy           llamav1alpha1.ReplicaManagementPolicy
+		autoscaling      *llamav1alpha1.AutoscalingSpec
+		expectedReplicas int32
+	}{
+		{name: "managed replicas are reverted", policy: llamav1alpha1.ReplicaManagementPolicyManaged, expectedReplicas: 2},
+		{name: "external replicas are kept", policy: llamav1alpha1.ReplicaManagementPolicyExternal, expectedReplicas: 4},
+		{name: "autoscaled replicas are kept", policy: llamav1alpha1.ReplicaManagementPolicyManaged,
+			autoscaling: &llamav1alpha1.AutoscalingSpec{MaxReplicas: 5}, expectedReplicas: 4},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			key := types.NamespacedName{Name: fmt.Sprintf("test-deployment-replicas-%d", i), Namespace: "default"}
+			instance := &llamav1alpha1.LlamaStackDistribution{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid"},
+			}
+			newDeployment := func(image string) *appsv1.Deployment {
+				// Like reconcileDeployment, leave the replicas of an autoscaled Deployment unset
+				replicas := ptr.To(int32(2))
+				if instance.IsAutoscalingEnabled() {
+					replicas = nil
+				}
+				return &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: replicas,
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": key.Name}},
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": key.Name}},
+							Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "llamastack", Image: image}}},
+						},
+					},
+				}
+			}
+			apply := func(image string) *appsv1.Deployment {
+				_, err := ApplyDeployment(ctx, k8sClient, k8sClient.Scheme(), OwnerReferencePolicy{}, instance, newDeployment(image), logger)
+				require.NoError(t, err)
+				found := &appsv1.Deployment{}
+				require.NoError(t, k8sClient.Get(ctx, key, found))
+				return found
+			}
+
+			// Create the Deployment and apply it once, so that the operator owns the replicas
+			apply("quay.io/llamastack/llama-stack-k8s-operator:v0.0.1")
+			apply("quay.io/llamastack/llama-stack-k8s-operator:v0.0.2")
+
+			// Switching the policy keeps the current count
+			instance.Spec.ReplicaManagementPolicy = tc.policy
+			instance.Spec.Autoscaling = tc.autoscaling
+			found := apply("quay.io/llamastack/llama-stack-k8s-operator:v0.0.3")
+			require.Equal(t, int32(2), *found.Spec.Replicas)
+			externalReplicas := tc.policy == llamav1alpha1.ReplicaManagementPolicyExternal || tc.autoscaling != nil
+			require.Equal(t, !externalReplicas, ownsField(found, deploymentFieldOwner, "f:spec", "f:replicas"),
+				"the operator should only own the replicas it manages")
+
+			// Another controller scales the Deployment
+			require.NoError(t, retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				scaled := &appsv1.Deployment{}
+				if err := k8sClient.Get(ctx, key, scaled); err != nil {
+					return err
+				}
+				scaled.Spec.Replicas = ptr.To(int32(4))
+				return k8sClient.Update(ctx, scaled)
+			}))
+
+			found = apply("quay.io/llamastack/llama-stack-k8s-operator:v0.0.4")
+			require.Equal(t, tc.expectedReplicas, *found.Spec.Replicas)
+			require.Equal(t, "quay.io/llamastack/llama-stack-k8s-operator:v0.0.4", found.Spec.Template.Spec.Containers[0].Image)
+		})
+	}
+}
+
+func TestOwnsField(t *testing.T) {
+	obj := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{
+					Manager:   deploymentFieldOwner,
+					Operation: metav1.ManagedFieldsOperationApply,
+					FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{}}}`)},
+				},
+				{
+					Manager:   "kube-controller-manager",
+					Operation: metav1.ManagedFieldsOperationUpdate,
+					FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:readyReplicas":{}}}`)},
+				},
+			},
+		},
+	}
+
+	require.True(t, ownsField(obj, deploymentFieldOwner, "f:spec", "f:replicas"))
+	require.False(t, ownsField(obj, deploymentFieldOwner, "f:spec", "f:strategy"))
+	require.False(t, ownsField(obj, "kube-controller-manager", "f:spec", "f:replicas"))
+	require.False(t, ownsField(obj, replicasHandoverFieldOwner, "f:spec", "f:replicas"))
+}
+
+func TestApplyDeploymentRemovesStaleMetadata(t *testing.T) {
+	logger := logf.Log.WithName("test-apply-deployment-metadata")
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid"},
+	}
+	newDeployment := func(labels, podAnnotations map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-deployment-metadata", Namespace: "default", Labels: labels},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}, Annotations: podAnnotations},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "llamastack", Image: "llamastack:latest"}}},
+				},
+			},
+		}
+	}
+
+	existing := newDeployment(
+		map[string]string{"team": "inference", "cost-center": "ml-platform", "edited-by": "kubectl"},
+		map[string]string{"sidecar.istio.io/inject": "true"},
+	)
+	existing.ManagedFields = []metav1.ManagedFieldsEntry{
+		{
+			Manager:   deploymentFieldOwner,
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:team":{},"f:cost-center":{}}},` +
+				`"f:spec":{"f:template":{"f:metadata":{"f:annotations":{"f:sidecar.istio.io/inject":{}}}}}}`)},
+		},
+		{
+			Manager:   "kubectl-edit",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:edited-by":{}}}}`)},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+
+	// The cost-center label and the pod annotation were removed from the spec of the instance
+	_, err := ApplyDeployment(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance,
+		newDeployment(map[string]string{"team": "inference"}, nil), logger)
+	require.NoError(t, err)
+
+	found := &appsv1.Deployment{}
+	require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(existing), found))
+	require.Equal(t, map[string]string{"team": "inference", "edited-by": "kubectl"}, found.Labels,
+		"labels set by other managers should be kept")
+	require.Empty(t, found.Spec.Template.Annotations)
+}
+
+func TestApplyDeploymentRevertsSchedulingDrift(t *testing.T) {
+	ctx := t.Context()
+	logger := logf.Log.WithName("test-apply-deployment-scheduling")
+	key := types.NamespacedName{Name: "test-deployment-scheduling", Namespace: "default"}
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid"},
+	}
+	nodeSelector := map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB"}
+	tolerations := []corev1.Toleration{{
+		Key:      "nvidia.com/gpu",
+		Operator: corev1.TolerationOpExists,
+		Effect:   corev1.TaintEffectNoSchedule,
+	}}
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: "nvidia.com/gpu.count", Operator: corev1.NodeSelectorOpGt, Values: []string{"0"}},
+					},
+				}},
+			},
+		},
+	}
+	topologySpreadConstraints := []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       corev1.LabelTopologyZone,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": key.Name}},
+	}}
+	apply := func() *appsv1.Deployment {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To(int32(2)),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": key.Name}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": key.Name}},
+					Spec: corev1.PodSpec{
+						Containers:                []corev1.Container{{Name: "llamastack", Image: "llamastack:latest"}},
+						NodeSelector:              nodeSelector,
+						Tolerations:               tolerations,
+						Affinity:                  affinity,
+						TopologySpreadConstraints: topologySpreadConstraints,
+					},
+				},
+			},
+		}
+		_, err := ApplyDeployment(ctx, k8sClient, k8sClient.Scheme(), OwnerReferencePolicy{}, instance, deployment, logger)
+		require.NoError(t, err)
+		found := &appsv1.Deployment{}
+		require.NoError(t, k8sClient.Get(ctx, key, found))
+		return found
+	}
+
+	// Create the Deployment and apply it once, so that the operator owns the scheduling fields
+	apply()
+	apply()
+
+	// The scheduling fields are edited by hand
+	require.NoError(t, retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		edited := &appsv1.Deployment{}
+		if err := k8sClient.Get(ctx, key, edited); err != nil {
+			return err
+		}
+		podSpec := &edited.Spec.Template.Spec
+		podSpec.NodeSelector = map[string]string{"nvidia.com/gpu.product": "Tesla-T4"}
+		podSpec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+		podSpec.Affinity = nil
+		podSpec.TopologySpreadConstraints[0].MaxSkew = 3
+		return k8sClient.Update(ctx, edited)
+	}))
+
+	found := apply()
+	podSpec := found.Spec.Template.Spec
+	require.Equal(t, nodeSelector, podSpec.NodeSelector)
+	require.Equal(t, tolerations, podSpec.Tolerations)
+	require.Equal(t, affinity, podSpec.Affinity)
+	require.Equal(t, topologySpreadConstraints, podSpec.TopologySpreadConstraints)
+}
@@ -0,0 +1,62 @@
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Classes of failures returned by the functions of this package. They are wrapped with %w, so callers
+// tell them apart with errors.Is rather than by matching error messages.
+var (
+	// ErrRenderFailed indicates the manifests could not be rendered through kustomize and the plugins.
+	ErrRenderFailed = errors.New("manifest rendering failed")
+	// ErrNotOwned indicates an object with the name of a managed resource exists but is not owned by the instance.
+	ErrNotOwned = errors.New("object with the same name is not owned by this instance")
+	// ErrImmutableField indicates the API server rejected a change to an immutable field.
+	ErrImmutableField = errors.New("immutable field changed")
+	// ErrScopeDetection indicates the REST mapping of a resource kind, and hence its scope, could not be determined.
+	ErrScopeDetection = errors.New("resource scope detection failed")
+	// ErrClusterRoleMissing indicates a ClusterRoleBinding references a ClusterRole that does not exist.
+	ErrClusterRoleMissing = errors.New("referenced ClusterRole does not exist")
+)
+
+// Classes of Deployment apply failures returned by ApplyDeployment.
+var (
+	// ErrDeploymentInvalid indicates the API server rejected the Deployment, e.g. because an immutable field changed.
+	ErrDeploymentInvalid = errors.New("deployment is invalid")
+	// ErrDeploymentForbidden indicates the Deployment was rejected by admission control or a resource quota.
+	ErrDeploymentForbidden = errors.New("deployment is forbidden")
+	// ErrDeploymentConflict indicates the Deployment kept conflicting with concurrent updates after retrying.
+	ErrDeploymentConflict = errors.New("deployment update conflicted")
+)
+
+// isImmutableFieldError reports whether err is an API error rejecting a change to an immutable field.
+// The API server reports them as invalid with a cause per rejected field.
+func isImmutableFieldError(err error) bool {
+	var apiStatus k8serrors.APIStatus
+	if !k8serrors.IsInvalid(err) || !errors.As(err, &apiStatus) {
+		return false
+	}
+	details := apiStatus.Status().Details
+	if details == nil {
+		return false
+	}
+	for _, cause := range details.Causes {
+		if strings.Contains(cause.Message, "immutable") {
+			return true
+		}
+	}
+	return false
+}
+
+// classifyImmutableFieldError wraps an API error rejecting a change to an immutable field with ErrImmutableField.
+// Other errors are returned unchanged.
+func classifyImmutableFieldError(err error) error {
+	if isImmutableFieldError(err) {
+		return fmt.Errorf("failed to change immutable field: %w: %w", ErrImmutableField, err)
+	}
+	return err
+}
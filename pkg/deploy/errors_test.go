@@ -0,0 +1,209 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// newImmutableFieldError returns the error the API server reports for a change to an immutable field.
+func newImmutableFieldError(kind string) error {
+	return k8serrors.NewInvalid(schema.GroupKind{Kind: kind}, "test", field.ErrorList{
+		field.Invalid(field.NewPath("spec", "selector"), "changed", "field is immutable"),
+	})
+}
+
+func TestIsImmutableFieldError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "immutable field", err: newImmutableFieldError("Deployment"), expected: true},
+		{
+			name: "forbidden update of an immutable spec",
+			err: k8serrors.NewInvalid(schema.GroupKind{Kind: "PersistentVolumeClaim"}, "test", field.ErrorList{
+				field.Forbidden(field.NewPath("spec"), "spec is immutable after creation except resources.requests"),
+			}),
+			expected: true,
+		},
+		{name: "wrapped immutable field", err: errors.Join(errors.New("context"), newImmutableFieldError("Deployment")), expected: true},
+		{
+			name: "invalid value",
+			err: k8serrors.NewInvalid(schema.GroupKind{Kind: "Deployment"}, "test", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "replicas"), -1, "must be greater than or equal to 0"),
+			}),
+		},
+		{name: "forbidden", err: k8serrors.NewForbidden(schema.GroupResource{Resource: "deployments"}, "test", errors.New("immutable"))},
+		{name: "not an API error", err: errors.New("field is immutable")},
+		{name: "nil", err: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isImmutableFieldError(tc.err))
+			if tc.err == nil {
+				return
+			}
+			classified := classifyImmutableFieldError(tc.err)
+			require.Equal(t, tc.expected, errors.Is(classified, ErrImmutableField))
+			require.ErrorIs(t, classified, tc.err, "the API error should stay in the chain")
+		})
+	}
+}
+
+func TestErrorClassWrapping(t *testing.T) {
+	logger := logf.Log.WithName("test-error-classes")
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "llamastack.io/v1alpha1", Kind: "LlamaStackDistribution"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid"},
+	}
+	ownedBy := []metav1.OwnerReference{{APIVersion: "llamastack.io/v1alpha1", Kind: "LlamaStackDistribution", Name: "test-instance", UID: "test-uid"}}
+	resourcesOf := func(t *testing.T, kind, name string, content map[string]any) *resmap.ResMap {
+		t.Helper()
+		resMap := resmap.New()
+		require.NoError(t, resMap.Append(newTestResource(t, "v1", kind, name, "default", content)))
+		return &resMap
+	}
+
+	t.Run("render failure", func(t *testing.T) {
+		_, err := RenderManifest(filesys.MakeFsInMemory(), "missing", instance)
+
+		require.ErrorIs(t, err, ErrRenderFailed)
+	})
+
+	t.Run("ingress not owned", func(t *testing.T) {
+		existing := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "test-ingress", Namespace: "default"}}
+		cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+
+		err := ApplyIngress(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance, existing.DeepCopy(), logger)
+
+		require.ErrorIs(t, err, ErrNotOwned)
+	})
+
+	t.Run("ConfigMap not owned", func(t *testing.T) {
+		existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "default"}}
+		cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing).Build()
+
+		desired := existing.DeepCopy()
+		desired.Data = map[string]string{"key": "value"}
+		err := ApplyConfigMap(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance, desired, logger)
+
+		require.ErrorIs(t, err, ErrNotOwned)
+	})
+
+	t.Run("deployment immutable field", func(t *testing.T) {
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(context.Context, client.WithWatch, client.Object, ...client.CreateOption) error {
+					return newImmutableFieldError("Deployment")
+				},
+			}).
+			Build()
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"}}
+
+		_, err := ApplyDeployment(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance, deployment, logger)
+
+		require.ErrorIs(t, err, ErrDeploymentInvalid)
+		require.ErrorIs(t, err, ErrImmutableField)
+		require.True(t, k8serrors.IsInvalid(err), "the API error should stay in the chain")
+	})
+
+	t.Run("NetworkPolicy immutable field", func(t *testing.T) {
+		existing := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"}}
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(existing).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(context.Context, client.WithWatch, client.Object, ...client.UpdateOption) error {
+					return newImmutableFieldError("NetworkPolicy")
+				},
+			}).
+			Build()
+		desired := existing.DeepCopy()
+		desired.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+
+		_, err := ApplyNetworkPolicy(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance, desired, logger)
+
+		require.ErrorIs(t, err, ErrImmutableField)
+	})
+
+	t.Run("manifest resource immutable field", func(t *testing.T) {
+		existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "default", OwnerReferences: ownedBy}}
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(existing).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+					return newImmutableFieldError("ConfigMap")
+				},
+			}).
+			Build()
+
+		err := ApplyResources(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance, resourcesOf(t, "ConfigMap", "test-configmap", nil))
+
+		require.ErrorIs(t, err, ErrImmutableField)
+	})
+
+	t.Run("manifest resource patch failure is not classified", func(t *testing.T) {
+		existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-configmap", Namespace: "default", OwnerReferences: ownedBy}}
+		cli := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(existing).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(context.Context, client.WithWatch, client.Object, client.Patch, ...client.PatchOption) error {
+					return k8serrors.NewServiceUnavailable("unavailable")
+				},
+			}).
+			Build()
+
+		err := ApplyResources(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance, resourcesOf(t, "ConfigMap", "test-configmap", nil))
+
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrImmutableField)
+		require.True(t, k8serrors.IsServiceUnavailable(err))
+	})
+
+	t.Run("scope detection failure", func(t *testing.T) {
+		cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(meta.NewDefaultRESTMapper(nil)).Build()
+
+		err := ApplyResources(t.Context(), cli, scheme.Scheme, OwnerReferencePolicy{}, instance, resourcesOf(t, "ConfigMap", "test-configmap", nil))
+
+		require.ErrorIs(t, err, ErrScopeDetection)
+	})
+
+	t.Run("missing ClusterRole", func(t *testing.T) {
+		cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		crb := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRoleBinding",
+			"metadata":   map[string]any{"name": "test-binding"},
+			"roleRef":    map[string]any{"apiGroup": "rbac.authorization.k8s.io", "kind": "ClusterRole", "name": "missing"},
+		}}
+
+		require.ErrorIs(t, CheckClusterRoleExists(t.Context(), cli, crb), ErrClusterRoleMissing)
+
+		unstructured.RemoveNestedField(crb.Object, "roleRef")
+		require.NoError(t, CheckClusterRoleExists(t.Context(), cli, crb), "a binding without roleRef is not skipped")
+	})
+}
@@ -0,0 +1,71 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyHorizontalPodAutoscaler creates or updates a HorizontalPodAutoscaler. A HorizontalPodAutoscaler with
+// the same name that is not owned by the instance is left untouched.
+func ApplyHorizontalPodAutoscaler(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, hpa *autoscalingv2.HorizontalPodAutoscaler, log logr.Logger) error {
+	if err := SetOwnerReference(instance, hpa, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, hpa)
+
+	existing := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(hpa), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, hpa); err != nil {
+				return fmt.Errorf("failed to create HorizontalPodAutoscaler: %w", err)
+			}
+			log.Info("Created HorizontalPodAutoscaler", "name", hpa.Name)
+			return nil
+		}
+		return fmt.Errorf("failed to get HorizontalPodAutoscaler: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply HorizontalPodAutoscaler %s: %w", hpa.Name, ErrNotOwned)
+	}
+
+	hpa.ResourceVersion = existing.ResourceVersion
+	if err := c.Update(ctx, hpa); err != nil {
+		return fmt.Errorf("failed to update HorizontalPodAutoscaler: %w", classifyImmutableFieldError(err))
+	}
+	log.V(1).Info("Updated HorizontalPodAutoscaler", "name", hpa.Name)
+	return nil
+}
+
+// HandleDisabledHorizontalPodAutoscaler deletes the HorizontalPodAutoscaler of the instance when autoscaling is
+// removed from the spec. Only a HorizontalPodAutoscaler owned by the instance is deleted.
+func HandleDisabledHorizontalPodAutoscaler(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, log logr.Logger) error {
+	existing := &autoscalingv2.HorizontalPodAutoscaler{}
+	key := client.ObjectKey{Name: GetHorizontalPodAutoscalerName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check HorizontalPodAutoscaler existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping deletion of HorizontalPodAutoscaler not owned by this instance", "name", existing.Name)
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete HorizontalPodAutoscaler: %w", err)
+	}
+	log.Info("Deleted HorizontalPodAutoscaler", "name", existing.Name)
+	return nil
+}
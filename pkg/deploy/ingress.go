@@ -0,0 +1,71 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyIngress creates or updates an Ingress. An Ingress with the same name that is not owned by the instance
+// is left untouched. On return ingress holds the state of the Ingress in the cluster, including its status.
+func ApplyIngress(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, ingress *networkingv1.Ingress, log logr.Logger) error {
+	if err := SetOwnerReference(instance, ingress, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, ingress)
+
+	existing := &networkingv1.Ingress{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(ingress), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, ingress); err != nil {
+				return fmt.Errorf("failed to create Ingress: %w", err)
+			}
+			log.Info("Created Ingress", "name", ingress.Name)
+			return nil
+		}
+		return fmt.Errorf("failed to get Ingress: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply Ingress %s: %w", ingress.Name, ErrNotOwned)
+	}
+
+	ingress.ResourceVersion = existing.ResourceVersion
+	if err := c.Update(ctx, ingress); err != nil {
+		return fmt.Errorf("failed to update Ingress: %w", classifyImmutableFieldError(err))
+	}
+	log.V(1).Info("Updated Ingress", "name", ingress.Name)
+	return nil
+}
+
+// HandleDisabledIngress deletes the Ingress of the instance when it is disabled or removed from the spec.
+// Only an Ingress owned by the instance is deleted, so a user-managed Ingress with the same name is left alone.
+func HandleDisabledIngress(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, log logr.Logger) error {
+	existing := &networkingv1.Ingress{}
+	key := client.ObjectKey{Name: GetIngressName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check Ingress existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping deletion of Ingress not owned by this instance", "name", existing.Name)
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Ingress: %w", err)
+	}
+	log.Info("Deleted Ingress", "name", existing.Name)
+	return nil
+}
@@ -3,6 +3,7 @@ package deploy
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"slices"
@@ -10,6 +11,7 @@ import (
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/compare"
 	"github.com/llamastack/llama-stack-k8s-operator/pkg/deploy/plugins"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -17,7 +19,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/kustomize/api/krusty"
@@ -44,10 +45,10 @@ func RenderManifest(
 
 	resMapVal, err := k.Run(fs, finalManifestPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to run kustomize: %w", err)
+		return nil, fmt.Errorf("failed to run kustomize: %w: %w", ErrRenderFailed, err)
 	}
 	if err := applyPlugins(&resMapVal, ownerInstance); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to apply plugins: %w: %w", ErrRenderFailed, err)
 	}
 	return &resMapVal, nil
 }
@@ -57,11 +58,12 @@ func ApplyResources(
 	ctx context.Context,
 	cli client.Client,
 	scheme *runtime.Scheme,
+	ownerRefPolicy OwnerReferencePolicy,
 	ownerInstance *llamav1alpha1.LlamaStackDistribution,
 	resMap *resmap.ResMap,
 ) error {
 	for _, res := range (*resMap).Resources() {
-		if err := manageResource(ctx, cli, scheme, res, ownerInstance); err != nil {
+		if err := manageResource(ctx, cli, scheme, ownerRefPolicy, res, ownerInstance); err != nil {
 			return fmt.Errorf("failed to manage resource %s/%s: %w", res.GetKind(), res.GetName(), err)
 		}
 	}
@@ -74,6 +76,7 @@ func manageResource(
 	ctx context.Context,
 	cli client.Client,
 	scheme *runtime.Scheme,
+	ownerRefPolicy OwnerReferencePolicy,
 	res *resource.Resource,
 	ownerInstance *llamav1alpha1.LlamaStackDistribution,
 ) error {
@@ -89,12 +92,12 @@ func manageResource(
 
 	// Check if ClusterRoleBinding references a ClusterRole that exists
 	if u.GetKind() == "ClusterRoleBinding" {
-		if shouldSkip, err := CheckClusterRoleExists(ctx, cli, u); err != nil {
-			return fmt.Errorf("failed to check ClusterRole existence: %w", err)
-		} else if shouldSkip {
+		if err := CheckClusterRoleExists(ctx, cli, u); errors.Is(err, ErrClusterRoleMissing) {
 			log.FromContext(ctx).V(1).Info("Skipping ClusterRoleBinding - referenced ClusterRole not found",
 				"clusterRoleBinding", u.GetName())
 			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to check ClusterRole existence: %w", err)
 		}
 	}
 
@@ -111,18 +114,21 @@ func manageResource(
 		if !k8serr.IsNotFound(err) {
 			return fmt.Errorf("failed to get resource: %w", err)
 		}
-		return createResource(ctx, cli, u, ownerInstance, scheme, gvk)
+		return createResource(ctx, cli, u, ownerInstance, scheme, ownerRefPolicy, gvk)
 	}
 	return patchResource(ctx, cli, u, found, ownerInstance)
 }
 
-// createResource creates a new resource, setting an owner reference only if it's namespace-scoped.
+// createResource creates a new resource, setting an owner reference only if it's namespace-scoped. It is created
+// with a server-side apply under the field manager of the later patches, so that the labels and annotations
+// dropped from the manifests are removed from it.
 func createResource(
 	ctx context.Context,
 	cli client.Client,
 	obj *unstructured.Unstructured,
 	ownerInstance *llamav1alpha1.LlamaStackDistribution,
 	scheme *runtime.Scheme,
+	ownerRefPolicy OwnerReferencePolicy,
 	gvk schema.GroupVersionKind,
 ) error {
 	// Check if the resource is cluster-scoped (like a ClusterRole) to avoid
@@ -131,19 +137,22 @@ func createResource(
 	if err != nil {
 		return fmt.Errorf("failed to determine resource scope: %w", err)
 	}
-	if !isClusterScoped {
-		if err := ctrl.SetControllerReference(ownerInstance, obj, scheme); err != nil {
-			return fmt.Errorf("failed to set controller reference for %s: %w", gvk.Kind, err)
+	if isClusterScoped {
+		// Track the resource with labels instead, so that it is deleted with the instance
+		setInstanceLabels(obj, ownerInstance)
+	} else {
+		if err := SetOwnerReference(ownerInstance, obj, scheme, ownerRefPolicy); err != nil {
+			return fmt.Errorf("failed to set owner reference for %s: %w", gvk.Kind, err)
 		}
 	}
-	return cli.Create(ctx, obj)
+	return cli.Patch(ctx, obj, client.Apply, client.ForceOwnership, client.FieldOwner(ownerInstance.GetName()))
 }
 
 // isClusterScoped checks if a given GVK refers to a cluster-scoped resource.
 func isClusterScoped(mapper meta.RESTMapper, gvk schema.GroupVersionKind) (bool, error) {
 	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
 	if err != nil {
-		return false, fmt.Errorf("failed to get REST mapping for GVK %v: %w", gvk, err)
+		return false, fmt.Errorf("failed to get REST mapping for GVK %v: %w: %w", gvk, ErrScopeDetection, err)
 	}
 	return mapping.Scope.Name() == meta.RESTScopeNameRoot, nil
 }
@@ -170,10 +179,8 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 	}
 
 	if existing.GetKind() == "PersistentVolumeClaim" {
-		logger.Info("Skipping PVC patch - PVCs are immutable after creation",
-			"name", existing.GetName(),
-			"namespace", existing.GetNamespace())
-		return nil
+		// The PVC spec is immutable after creation, except for expanding its storage request
+		return resizePVC(ctx, cli, desired, existing)
 	} else if existing.GetKind() == "Service" {
 		if err := compare.CheckAndLogServiceChanges(ctx, cli, desired); err != nil {
 			return fmt.Errorf("failed to validate resource mutations while patching: %w", err)
@@ -185,13 +192,16 @@ func patchResource(ctx context.Context, cli client.Client, desired, existing *un
 		return fmt.Errorf("failed to marshal desired state: %w", err)
 	}
 
-	return cli.Patch(
+	if err := cli.Patch(
 		ctx,
 		existing,
 		client.RawPatch(k8stypes.ApplyPatchType, data),
 		client.ForceOwnership,
 		client.FieldOwner(ownerInstance.GetName()),
-	)
+	); err != nil {
+		return classifyImmutableFieldError(err)
+	}
+	return nil
 }
 
 // applyPlugins runs all Go-based transformations on the resource map.
@@ -203,7 +213,9 @@ func applyPlugins(resMap *resmap.ResMap, ownerInstance *llamav1alpha1.LlamaStack
 		return fmt.Errorf("failed to apply name prefix: %w", err)
 	}
 
-	namespaceSetterPlugin, err := plugins.CreateNamespacePlugin(ownerInstance.GetNamespace())
+	namespaceSetterPlugin, err := plugins.CreateNamespacePlugin(plugins.NamespaceConfig{
+		Namespace: ownerInstance.GetNamespace(),
+	})
 	if err != nil {
 		return err
 	}
@@ -212,53 +224,22 @@ func applyPlugins(resMap *resmap.ResMap, ownerInstance *llamav1alpha1.LlamaStack
 	}
 
 	fieldTransformerPlugin := plugins.CreateFieldMutator(plugins.FieldMutatorConfig{
-		Mappings: []plugins.FieldMapping{
-			{
-				SourceValue:       getStorageSize(ownerInstance),
-				DefaultValue:      llamav1alpha1.DefaultStorageSize.String(),
-				TargetField:       "/spec/resources/requests/storage",
-				TargetKind:        "PersistentVolumeClaim",
-				CreateIfNotExists: true,
-			},
-			{
-				SourceValue:       ownerInstance.GetNamespace(),
-				TargetField:       "/subjects/0/namespace",
-				TargetKind:        "ClusterRoleBinding",
-				CreateIfNotExists: true,
-			},
-			{
-				SourceValue:       ownerInstance.GetName() + "-sa",
-				TargetField:       "/subjects/0/name",
-				TargetKind:        "ClusterRoleBinding",
-				CreateIfNotExists: true,
-			},
+		Mappings: slices.Concat(
+			getServiceMappings(ownerInstance),
+			getPersistentVolumeClaimMappings(ownerInstance),
+			getClusterRoleBindingMappings(ownerInstance),
+		),
+		MergeMappings: []plugins.FieldMergeMapping{
 			{
-				SourceValue:       getServicePort(ownerInstance),
-				DefaultValue:      llamav1alpha1.DefaultServerPort,
-				TargetField:       "/spec/ports/0/port",
-				TargetKind:        "Service",
-				CreateIfNotExists: true,
+				// Added to the labels of every resource without replacing those of the manifests
+				TargetPath: "/metadata/labels",
+				Value:      map[string]string{managedByLabelKey: managedByLabelValue},
 			},
 			{
-				SourceValue:       getServicePort(ownerInstance),
-				DefaultValue:      llamav1alpha1.DefaultServerPort,
-				TargetField:       "/spec/ports/0/targetPort",
-				TargetKind:        "Service",
-				CreateIfNotExists: true,
-			},
-			{
-				SourceValue:       nil,
-				DefaultValue:      llamav1alpha1.DefaultLabelValue,
-				TargetField:       "/spec/selector/" + llamav1alpha1.DefaultLabelKey,
-				TargetKind:        "Service",
-				CreateIfNotExists: true,
-			},
-			{
-				SourceValue:       nil,
-				DefaultValue:      ownerInstance.GetName(),
-				TargetField:       "/spec/selector/app.kubernetes.io~1instance",
-				TargetKind:        "Service",
-				CreateIfNotExists: true,
+				// Keeps the annotations of the manifests, such as those read by the cloud load balancers
+				TargetPath: "/metadata/annotations",
+				TargetKind: "Service",
+				Value:      getServiceAnnotations(ownerInstance),
 			},
 		},
 	})
@@ -266,9 +247,144 @@ func applyPlugins(resMap *resmap.ResMap, ownerInstance *llamav1alpha1.LlamaStack
 		return fmt.Errorf("failed to apply field transformer: %w", err)
 	}
 
+	// Added last, so that the labels and annotations set above take precedence
+	metadataPlugin := plugins.CreateMetadataPlugin(plugins.MetadataConfig{
+		Labels:      ownerInstance.Spec.Labels,
+		Annotations: ownerInstance.Spec.Annotations,
+	})
+	if err := metadataPlugin.Transform(*resMap); err != nil {
+		return fmt.Errorf("failed to apply metadata plugin: %w", err)
+	}
+
+	dataPlaceholderPlugin := plugins.CreateDataPlaceholderPlugin(plugins.DataPlaceholderConfig{
+		Values: map[string]string{
+			"__INSTANCE_NAME__":      ownerInstance.GetName(),
+			"__INSTANCE_NAMESPACE__": ownerInstance.GetNamespace(),
+		},
+	})
+	if err := dataPlaceholderPlugin.Transform(*resMap); err != nil {
+		return fmt.Errorf("failed to apply data placeholder plugin: %w", err)
+	}
+
 	return nil
 }
 
+// getServiceMappings returns the field mappings of the Service, in the order they are applied.
+func getServiceMappings(instance *llamav1alpha1.LlamaStackDistribution) []plugins.FieldMapping {
+	return []plugins.FieldMapping{
+		{
+			// Expose separate health, metrics and extra ports next to the server port
+			SourceValue:       getServicePorts(instance),
+			TargetField:       "/spec/ports",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getServicePort(instance),
+			DefaultValue:      llamav1alpha1.DefaultServerPort,
+			TargetField:       "/spec/ports/0/port",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getServicePort(instance),
+			DefaultValue:      llamav1alpha1.DefaultServerPort,
+			TargetField:       "/spec/ports/0/targetPort",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getServiceType(instance),
+			TargetField:       "/spec/type",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getServiceLoadBalancerClass(instance),
+			TargetField:       "/spec/loadBalancerClass",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getServiceLoadBalancerSourceRanges(instance),
+			TargetField:       "/spec/loadBalancerSourceRanges",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getServiceSessionAffinity(instance),
+			TargetField:       "/spec/sessionAffinity",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       nil,
+			DefaultValue:      llamav1alpha1.DefaultLabelValue,
+			TargetField:       "/spec/selector/" + llamav1alpha1.DefaultLabelKey,
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       nil,
+			DefaultValue:      instance.GetName(),
+			TargetField:       "/spec/selector/app.kubernetes.io~1instance",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+		{
+			// Lets the ServiceMonitor select the Service of the instance
+			SourceValue:       nil,
+			DefaultValue:      instance.GetName(),
+			TargetField:       "/metadata/labels/app.kubernetes.io~1instance",
+			TargetKind:        "Service",
+			CreateIfNotExists: true,
+		},
+	}
+}
+
+// getPersistentVolumeClaimMappings returns the field mappings of the PersistentVolumeClaim.
+func getPersistentVolumeClaimMappings(instance *llamav1alpha1.LlamaStackDistribution) []plugins.FieldMapping {
+	return []plugins.FieldMapping{
+		{
+			SourceValue:       getStorageSize(instance),
+			DefaultValue:      llamav1alpha1.DefaultStorageSize.String(),
+			TargetField:       "/spec/resources/requests/storage",
+			TargetKind:        "PersistentVolumeClaim",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getStorageClassName(instance),
+			TargetField:       "/spec/storageClassName",
+			TargetKind:        "PersistentVolumeClaim",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       getStorageAccessModes(instance),
+			TargetField:       "/spec/accessModes",
+			TargetKind:        "PersistentVolumeClaim",
+			CreateIfNotExists: true,
+		},
+	}
+}
+
+// getClusterRoleBindingMappings returns the field mappings binding the ServiceAccount of the instance.
+func getClusterRoleBindingMappings(instance *llamav1alpha1.LlamaStackDistribution) []plugins.FieldMapping {
+	return []plugins.FieldMapping{
+		{
+			SourceValue:       instance.GetNamespace(),
+			TargetField:       "/subjects/0/namespace",
+			TargetKind:        "ClusterRoleBinding",
+			CreateIfNotExists: true,
+		},
+		{
+			SourceValue:       GetServiceAccountName(instance),
+			TargetField:       "/subjects/0/name",
+			TargetKind:        "ClusterRoleBinding",
+			CreateIfNotExists: true,
+		},
+	}
+}
+
 // getStorageSize extracts the storage size from the CR spec.
 func getStorageSize(instance *llamav1alpha1.LlamaStackDistribution) string {
 	if instance.Spec.Server.Storage != nil && instance.Spec.Server.Storage.Size != nil {
@@ -278,6 +394,28 @@ func getStorageSize(instance *llamav1alpha1.LlamaStackDistribution) string {
 	return ""
 }
 
+// getStorageClassName returns the storage class name or nil if not specified.
+func getStorageClassName(instance *llamav1alpha1.LlamaStackDistribution) any {
+	if instance.Spec.Server.Storage != nil && instance.Spec.Server.Storage.StorageClassName != nil {
+		return *instance.Spec.Server.Storage.StorageClassName
+	}
+	// Returning nil keeps the cluster default StorageClass.
+	return nil
+}
+
+// getStorageAccessModes returns the PVC access modes or nil if not specified.
+func getStorageAccessModes(instance *llamav1alpha1.LlamaStackDistribution) any {
+	if instance.Spec.Server.Storage == nil || len(instance.Spec.Server.Storage.AccessModes) == 0 {
+		// Returning nil keeps the access modes of the base manifest.
+		return nil
+	}
+	accessModes := make([]any, 0, len(instance.Spec.Server.Storage.AccessModes))
+	for _, mode := range instance.Spec.Server.Storage.AccessModes {
+		accessModes = append(accessModes, string(mode))
+	}
+	return accessModes
+}
+
 // getServicePort returns the service port or nil if not specified.
 func getServicePort(instance *llamav1alpha1.LlamaStackDistribution) any {
 	if instance.Spec.Server.ContainerSpec.Port != 0 {
@@ -287,6 +425,85 @@ func getServicePort(instance *llamav1alpha1.LlamaStackDistribution) any {
 	return nil
 }
 
+// getServiceType returns the requested Service type, or an empty string to keep the type of the base manifest.
+func getServiceType(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.Server.Service == nil {
+		return ""
+	}
+	return string(instance.Spec.Server.Service.Type)
+}
+
+// getServiceLoadBalancerClass returns the requested load balancer class or nil if not specified.
+func getServiceLoadBalancerClass(instance *llamav1alpha1.LlamaStackDistribution) any {
+	if instance.Spec.Server.Service == nil || instance.Spec.Server.Service.LoadBalancerClass == nil {
+		return nil
+	}
+	return *instance.Spec.Server.Service.LoadBalancerClass
+}
+
+// getServiceLoadBalancerSourceRanges returns the client CIDRs allowed to reach a LoadBalancer Service, or nil if
+// none are specified. The source ranges are only valid on LoadBalancer Services, so they are dropped for other types.
+func getServiceLoadBalancerSourceRanges(instance *llamav1alpha1.LlamaStackDistribution) any {
+	service := instance.Spec.Server.Service
+	if service == nil || service.Type != corev1.ServiceTypeLoadBalancer || len(service.LoadBalancerSourceRanges) == 0 {
+		return nil
+	}
+	ranges := make([]any, 0, len(service.LoadBalancerSourceRanges))
+	for _, sourceRange := range service.LoadBalancerSourceRanges {
+		ranges = append(ranges, sourceRange)
+	}
+	return ranges
+}
+
+// getServiceSessionAffinity returns the requested session affinity, or an empty string to keep the API default.
+func getServiceSessionAffinity(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if instance.Spec.Server.Service == nil {
+		return ""
+	}
+	return string(instance.Spec.Server.Service.SessionAffinity)
+}
+
+// getServiceAnnotations returns the annotations requested for the Service or nil if none are specified.
+func getServiceAnnotations(instance *llamav1alpha1.LlamaStackDistribution) map[string]string {
+	if instance.Spec.Server.Service == nil {
+		return nil
+	}
+	return instance.Spec.Server.Service.Annotations
+}
+
+// getServicePorts returns the service ports including the dedicated health and metrics ports and the extra ports,
+// or nil if all of them are served on the server port.
+func getServicePorts(instance *llamav1alpha1.LlamaStackDistribution) any {
+	serverPort := GetServicePort(instance)
+	ports := []any{newServicePort(llamav1alpha1.DefaultServicePortName, corev1.ProtocolTCP, serverPort)}
+	exposed := map[int32]bool{serverPort: true}
+	if healthPort := GetHealthCheckPort(instance); !exposed[healthPort] {
+		exposed[healthPort] = true
+		ports = append(ports, newServicePort(healthServicePortName, corev1.ProtocolTCP, healthPort))
+	}
+	if instance.IsMetricsEnabled() && GetMetricsServicePortName(instance) == metricsServicePortName {
+		metricsPort := GetMetricsPort(instance)
+		exposed[metricsPort] = true
+		ports = append(ports, newServicePort(metricsServicePortName, corev1.ProtocolTCP, metricsPort))
+	}
+	// A TCP extra port already exposed as the health or metrics port would be a duplicate of the Service port
+	for _, port := range GetExtraPorts(instance) {
+		if port.Protocol == corev1.ProtocolTCP && exposed[port.ContainerPort] {
+			continue
+		}
+		ports = append(ports, newServicePort(port.Name, port.Protocol, port.ContainerPort))
+	}
+	if len(ports) == 1 {
+		return nil
+	}
+	return ports
+}
+
+// newServicePort returns a complete Service port entry forwarding the port to the same container port.
+func newServicePort(name string, protocol corev1.Protocol, port int32) map[string]any {
+	return map[string]any{"name": name, "protocol": string(protocol), "port": port, "targetPort": port}
+}
+
 func FilterExcludeKinds(resMap *resmap.ResMap, kindsToExclude []string) (*resmap.ResMap, error) {
 	filteredResMap := resmap.New()
 	for _, res := range (*resMap).Resources() {
@@ -299,16 +516,17 @@ func FilterExcludeKinds(resMap *resmap.ResMap, kindsToExclude []string) (*resmap
 	return &filteredResMap, nil
 }
 
-// CheckClusterRoleExists checks if a ClusterRoleBinding should be skipped due to missing ClusterRole.
-func CheckClusterRoleExists(ctx context.Context, cli client.Client, crb *unstructured.Unstructured) (bool, error) {
+// CheckClusterRoleExists checks whether the ClusterRole referenced by a ClusterRoleBinding exists.
+// It returns an error wrapping ErrClusterRoleMissing if it does not, in which case the binding should be skipped.
+func CheckClusterRoleExists(ctx context.Context, cli client.Client, crb *unstructured.Unstructured) error {
 	roleRef, found, _ := unstructured.NestedMap(crb.Object, "roleRef")
 	if !found {
-		return false, nil // No roleRef, don't skip
+		return nil // No roleRef, don't skip
 	}
 
 	roleName, _, _ := unstructured.NestedString(roleRef, "name")
 	if roleName == "" {
-		return false, nil // Empty roleName, don't skip
+		return nil // Empty roleName, don't skip
 	}
 
 	// Check if the referenced ClusterRole exists
@@ -322,9 +540,9 @@ func CheckClusterRoleExists(ctx context.Context, cli client.Client, crb *unstruc
 
 	err := cli.Get(ctx, client.ObjectKey{Name: roleName}, clusterRole)
 	if err != nil && k8serr.IsNotFound(err) {
-		return true, nil
+		return fmt.Errorf("failed to find ClusterRole %s: %w", roleName, ErrClusterRoleMissing)
 	} else if err != nil {
-		return false, err
+		return fmt.Errorf("failed to get ClusterRole %s: %w", roleName, err)
 	}
-	return false, nil
+	return nil
 }
@@ -16,9 +16,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
@@ -118,6 +120,8 @@ spec:
 		require.NoError(t, err)
 		require.True(t, found, "storage field should exist")
 		require.Equal(t, "10Gi", storage, "storage size should be updated to the default")
+		assert.Equal(t, map[string]string{managedByLabelKey: managedByLabelValue}, res.GetLabels(),
+			"resources should be labeled as managed by the operator")
 	})
 
 	t.Run("should fall back to the default directory if kustomization.yaml is missing", func(t *testing.T) {
@@ -192,6 +196,247 @@ resources:
 	})
 }
 
+func TestRenderManifestServiceOverrides(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - service.yaml
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+spec:
+  type: ClusterIP
+  selector: {}
+  ports:
+  - name: http
+    protocol: TCP
+`)))
+
+	renderServerService := func(t *testing.T, server llamav1alpha1.ServerSpec) *corev1.Service {
+		t.Helper()
+		owner := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+			Spec:       llamav1alpha1.LlamaStackDistributionSpec{Server: server},
+		}
+		resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+		require.NoError(t, err)
+		require.Equal(t, 1, (*resMap).Size())
+		serviceMap, err := (*resMap).Resources()[0].Map()
+		require.NoError(t, err)
+		service := &corev1.Service{}
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(serviceMap, service))
+		return service
+	}
+	renderService := func(t *testing.T, overrides *llamav1alpha1.ServiceOverrides) *corev1.Service {
+		t.Helper()
+		return renderServerService(t, llamav1alpha1.ServerSpec{Service: overrides})
+	}
+
+	t.Run("defaults to the base manifest", func(t *testing.T) {
+		service := renderService(t, nil)
+		assert.Equal(t, corev1.ServiceTypeClusterIP, service.Spec.Type)
+		assert.Empty(t, service.Annotations)
+		assert.Nil(t, service.Spec.LoadBalancerClass)
+		assert.Empty(t, service.Spec.LoadBalancerSourceRanges)
+		assert.Empty(t, service.Spec.SessionAffinity)
+		assert.Equal(t, "test-instance", service.Labels["app.kubernetes.io/instance"], "the ServiceMonitor selects the Service by instance")
+	})
+
+	t.Run("NodePort with annotations", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.ServiceOverrides{
+			Type:        corev1.ServiceTypeNodePort,
+			Annotations: map[string]string{"example.com/owner": "team-a"},
+		})
+		assert.Equal(t, corev1.ServiceTypeNodePort, service.Spec.Type)
+		assert.Equal(t, map[string]string{"example.com/owner": "team-a"}, service.Annotations)
+	})
+
+	t.Run("LoadBalancer with load balancer class", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.ServiceOverrides{
+			Type:              corev1.ServiceTypeLoadBalancer,
+			Annotations:       map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "nlb"},
+			LoadBalancerClass: ptr.To("service.k8s.aws/nlb"),
+		})
+		assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+		assert.Equal(t, ptr.To("service.k8s.aws/nlb"), service.Spec.LoadBalancerClass)
+		assert.Equal(t, "nlb", service.Annotations["service.beta.kubernetes.io/aws-load-balancer-type"])
+	})
+
+	t.Run("LoadBalancer with source ranges and session affinity", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.ServiceOverrides{
+			Type:                     corev1.ServiceTypeLoadBalancer,
+			LoadBalancerSourceRanges: []string{"10.0.0.0/8", "192.0.2.0/24"},
+			SessionAffinity:          corev1.ServiceAffinityClientIP,
+		})
+		assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+		assert.Equal(t, []string{"10.0.0.0/8", "192.0.2.0/24"}, service.Spec.LoadBalancerSourceRanges)
+		assert.Equal(t, corev1.ServiceAffinityClientIP, service.Spec.SessionAffinity)
+	})
+
+	t.Run("source ranges are dropped without type LoadBalancer", func(t *testing.T) {
+		service := renderService(t, &llamav1alpha1.ServiceOverrides{
+			Type:                     corev1.ServiceTypeNodePort,
+			LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+		})
+		assert.Equal(t, corev1.ServiceTypeNodePort, service.Spec.Type)
+		assert.Empty(t, service.Spec.LoadBalancerSourceRanges)
+		assert.Empty(t, service.Spec.SessionAffinity)
+	})
+
+	t.Run("metrics on a port of their own", func(t *testing.T) {
+		service := renderServerService(t, llamav1alpha1.ServerSpec{
+			Monitoring: &llamav1alpha1.MonitoringSpec{Metrics: &llamav1alpha1.MetricsSpec{Enabled: true, Port: 9090}},
+		})
+		require.Len(t, service.Spec.Ports, 2)
+		assert.Equal(t, "http", service.Spec.Ports[0].Name)
+		assert.Equal(t, "metrics", service.Spec.Ports[1].Name)
+		assert.Equal(t, int32(9090), service.Spec.Ports[1].Port)
+	})
+
+	t.Run("extra ports", func(t *testing.T) {
+		service := renderServerService(t, llamav1alpha1.ServerSpec{
+			ContainerSpec: llamav1alpha1.ContainerSpec{ExtraPorts: []corev1.ContainerPort{
+				{Name: "grpc", ContainerPort: 50051},
+				{ContainerPort: 8125, Protocol: corev1.ProtocolUDP},
+				// Already exposed as the metrics port
+				{ContainerPort: 9090},
+			}},
+			Monitoring: &llamav1alpha1.MonitoringSpec{Metrics: &llamav1alpha1.MetricsSpec{Enabled: true, Port: 9090}},
+		})
+		require.Len(t, service.Spec.Ports, 4)
+		assert.Equal(t, "http", service.Spec.Ports[0].Name)
+		assert.Equal(t, "metrics", service.Spec.Ports[1].Name)
+		assert.Equal(t, corev1.ServicePort{
+			Name: "grpc", Protocol: corev1.ProtocolTCP, Port: 50051, TargetPort: intstr.FromInt32(50051),
+		}, service.Spec.Ports[2])
+		assert.Equal(t, corev1.ServicePort{
+			Name: "port-8125-udp", Protocol: corev1.ProtocolUDP, Port: 8125, TargetPort: intstr.FromInt32(8125),
+		}, service.Spec.Ports[3])
+	})
+
+	t.Run("metrics on the server port", func(t *testing.T) {
+		service := renderServerService(t, llamav1alpha1.ServerSpec{
+			Monitoring: &llamav1alpha1.MonitoringSpec{Metrics: &llamav1alpha1.MetricsSpec{Enabled: true}},
+		})
+		require.Len(t, service.Spec.Ports, 1)
+		assert.Equal(t, "http", service.Spec.Ports[0].Name)
+	})
+}
+
+func TestRenderManifestServiceAnnotations(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - service.yaml
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "service.yaml"), []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: service
+  annotations:
+    example.com/from-manifest: base
+    example.com/overridden: base
+spec:
+  selector: {}
+  ports:
+  - name: http
+    protocol: TCP
+`)))
+	owner := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Annotations: map[string]string{"example.com/propagated": "spec"},
+			Server: llamav1alpha1.ServerSpec{
+				Service: &llamav1alpha1.ServiceOverrides{
+					Annotations: map[string]string{"example.com/service": "override", "example.com/overridden": "override"},
+				},
+			},
+		},
+	}
+
+	resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+	require.NoError(t, err)
+	require.Equal(t, 1, (*resMap).Size())
+
+	assert.Equal(t, map[string]string{
+		"example.com/from-manifest": "base",
+		"example.com/overridden":    "override",
+		"example.com/service":       "override",
+		"example.com/propagated":    "spec",
+	}, (*resMap).Resources()[0].GetAnnotations())
+}
+
+func TestRenderManifestStorageOverrides(t *testing.T) {
+	fsys := filesys.MakeFsInMemory()
+	require.NoError(t, fsys.MkdirAll(manifestBasePath))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "kustomization.yaml"), []byte(`
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - pvc.yaml
+`)))
+	require.NoError(t, fsys.WriteFile(filepath.Join(manifestBasePath, "pvc.yaml"), []byte(`
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: pvc
+spec:
+  accessModes:
+  - ReadWriteOnce
+  resources:
+    requests:
+      storage: {}
+`)))
+
+	renderPVC := func(t *testing.T, storage *llamav1alpha1.StorageSpec) *corev1.PersistentVolumeClaim {
+		t.Helper()
+		owner := &llamav1alpha1.LlamaStackDistribution{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "test-render-ns"},
+			Spec: llamav1alpha1.LlamaStackDistributionSpec{
+				Server: llamav1alpha1.ServerSpec{Storage: storage},
+			},
+		}
+		resMap, err := RenderManifest(fsys, manifestBasePath, owner)
+		require.NoError(t, err)
+		require.Equal(t, 1, (*resMap).Size())
+		pvcMap, err := (*resMap).Resources()[0].Map()
+		require.NoError(t, err)
+		pvc := &corev1.PersistentVolumeClaim{}
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(pvcMap, pvc))
+		return pvc
+	}
+
+	t.Run("defaults to the cluster default StorageClass and ReadWriteOnce", func(t *testing.T) {
+		pvc := renderPVC(t, &llamav1alpha1.StorageSpec{})
+		assert.Nil(t, pvc.Spec.StorageClassName, "the cluster default StorageClass should be used")
+		assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, pvc.Spec.AccessModes)
+	})
+
+	t.Run("explicit StorageClass and access modes", func(t *testing.T) {
+		pvc := renderPVC(t, &llamav1alpha1.StorageSpec{
+			StorageClassName: ptr.To("fast-nvme"),
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+		})
+		assert.Equal(t, ptr.To("fast-nvme"), pvc.Spec.StorageClassName)
+		assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}, pvc.Spec.AccessModes)
+	})
+
+	t.Run("empty StorageClass keeps the cluster default", func(t *testing.T) {
+		pvc := renderPVC(t, &llamav1alpha1.StorageSpec{StorageClassName: ptr.To("")})
+		assert.Nil(t, pvc.Spec.StorageClassName)
+	})
+}
+
 // TestApplyResources contains tests for applying resources to the cluster.
 func TestApplyResources(t *testing.T) {
 	t.Run("happy path", func(t *testing.T) {
@@ -226,7 +471,7 @@ func TestApplyResources(t *testing.T) {
 		require.NoError(t, resMap.Append(desiredSvc))
 
 		// when
-		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap)) // Pass address of resMap
+		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, OwnerReferencePolicy{}, owner, &resMap)) // Pass address of resMap
 
 		// then
 		// verify deployment created correctly
@@ -244,6 +489,34 @@ func TestApplyResources(t *testing.T) {
 		require.Equal(t, "updated", updatedService.Labels["state"], "service label should be updated")
 	})
 
+	t.Run("removes stale metadata", func(t *testing.T) {
+		// given
+		ctx, testNs, owner := setupApplyResourcesTest(t, "stale-metadata-owner")
+		newService := func(labels, annotations map[string]string) *resmap.ResMap {
+			desiredSvc := newTestResource(t, "v1", "Service", "my-service", testNs, map[string]any{
+				"ports": []any{map[string]any{"name": "web", "protocol": "TCP", "port": 80, "targetPort": 8080}},
+			})
+			desiredSvc.SetLabels(labels)
+			desiredSvc.SetAnnotations(annotations)
+			resMap := resmap.New()
+			require.NoError(t, resMap.Append(desiredSvc))
+			return &resMap
+		}
+		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, OwnerReferencePolicy{}, owner,
+			newService(map[string]string{"team": "a", "stale": "true"}, map[string]string{"example.com/stale": "true"})))
+
+		// when
+		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, OwnerReferencePolicy{}, owner,
+			newService(map[string]string{"team": "a"}, nil)))
+
+		// then
+		service := &corev1.Service{}
+		require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: "my-service", Namespace: testNs}, service))
+		require.Equal(t, "a", service.Labels["team"])
+		require.NotContains(t, service.Labels, "stale", "a label removed from the manifests should be removed")
+		require.NotContains(t, service.Annotations, "example.com/stale", "an annotation removed from the manifests should be removed")
+	})
+
 	t.Run("skips owner", func(t *testing.T) {
 		// given
 		ctx, testNs, owner := setupApplyResourcesTest(t, "skip-owner")
@@ -286,7 +559,7 @@ func TestApplyResources(t *testing.T) {
 		require.NoError(t, resMap.Append(ownerResrc))
 
 		// when
-		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap))
+		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, OwnerReferencePolicy{}, owner, &resMap))
 
 		// then
 		// verify deployment created correctly
@@ -372,7 +645,7 @@ func TestApplyResources(t *testing.T) {
 		require.NoError(t, resMap.Append(ownerOtherResrc))
 
 		// when
-		err := ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap)
+		err := ApplyResources(ctx, k8sClient, scheme.Scheme, OwnerReferencePolicy{}, owner, &resMap)
 		require.NoError(t, err, "should not error when encountering resources owned by other instances")
 
 		// then verify the existing service was not modified (still owned by the other instance)
@@ -406,7 +679,7 @@ func TestApplyResources(t *testing.T) {
 		require.NoError(t, resMap.Append(desiredClusterRole))
 
 		// when we apply the resources
-		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap))
+		require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, OwnerReferencePolicy{}, owner, &resMap))
 
 		// then verify the cluster role was created correctly
 		createdClusterRole := &rbacv1.ClusterRole{}
@@ -416,6 +689,11 @@ func TestApplyResources(t *testing.T) {
 
 		// verify it has NO owner reference
 		require.Empty(t, createdClusterRole.GetOwnerReferences(), "cluster-scoped resource should not have an owner reference from a namespaced owner")
+		// but it is labeled with the owner so that it can be deleted with it
+		require.Equal(t, GetInstanceLabels(owner), map[string]string{
+			llamav1alpha1.InstanceNamespaceLabel: createdClusterRole.Labels[llamav1alpha1.InstanceNamespaceLabel],
+			llamav1alpha1.InstanceNameLabel:      createdClusterRole.Labels[llamav1alpha1.InstanceNameLabel],
+		}, "cluster-scoped resource should be labeled with its owner")
 
 		// cleanup the clusterrole
 		require.NoError(t, k8sClient.Delete(t.Context(), createdClusterRole))
@@ -465,7 +743,7 @@ func TestApplyResources_PVCImmutability(t *testing.T) {
 	require.NoError(t, resMap.Append(desiredPVC))
 
 	// when
-	require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, owner, &resMap))
+	require.NoError(t, ApplyResources(ctx, k8sClient, scheme.Scheme, OwnerReferencePolicy{}, owner, &resMap))
 
 	// then
 	// the PVC was NOT modified
@@ -583,3 +861,20 @@ func TestSetDefaultPort(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, int(llamav1alpha1.DefaultServerPort), actualPort)
 }
+
+func TestGetServicePorts(t *testing.T) {
+	t.Run("nil when all endpoints are served on the server port", func(t *testing.T) {
+		assert.Nil(t, getServicePorts(&llamav1alpha1.LlamaStackDistribution{}))
+	})
+
+	t.Run("complete entries for every port", func(t *testing.T) {
+		instance := &llamav1alpha1.LlamaStackDistribution{}
+		instance.Spec.Server.ContainerSpec.Port = 8080
+		instance.Spec.Server.HealthCheck = &llamav1alpha1.HealthCheckSpec{Port: 8081}
+
+		assert.Equal(t, []any{
+			map[string]any{"name": llamav1alpha1.DefaultServicePortName, "protocol": "TCP", "port": int32(8080), "targetPort": int32(8080)},
+			map[string]any{"name": healthServicePortName, "protocol": "TCP", "port": int32(8081), "targetPort": int32(8081)},
+		}, getServicePorts(instance))
+	})
+}
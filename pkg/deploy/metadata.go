@@ -0,0 +1,51 @@
+package deploy
+
+import (
+	"maps"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SetCommonMetadata adds the labels and annotations of the spec of the instance to obj. Labels and annotations
+// already set on obj, such as those managed by the operator, take precedence.
+func SetCommonMetadata(instance *llamav1alpha1.LlamaStackDistribution, obj metav1.Object) {
+	obj.SetLabels(GetCommonLabels(instance, obj.GetLabels()))
+	if len(instance.Spec.Annotations) > 0 {
+		obj.SetAnnotations(mergeMissing(obj.GetAnnotations(), instance.Spec.Annotations))
+	}
+}
+
+// GetCommonLabels returns the labels with the labels of the spec of the instance added. The given labels take
+// precedence.
+func GetCommonLabels(instance *llamav1alpha1.LlamaStackDistribution, labels map[string]string) map[string]string {
+	if len(instance.Spec.Labels) == 0 {
+		return labels
+	}
+	return mergeMissing(labels, instance.Spec.Labels)
+}
+
+// GetDeploymentLabels returns the labels with the deployment labels of the server spec of the instance added. The
+// given labels take precedence.
+func GetDeploymentLabels(instance *llamav1alpha1.LlamaStackDistribution, labels map[string]string) map[string]string {
+	if len(instance.Spec.Server.DeploymentLabels) == 0 {
+		return labels
+	}
+	return mergeMissing(labels, instance.Spec.Server.DeploymentLabels)
+}
+
+// GetPodAnnotations returns the annotations with the pod annotations of the server spec of the instance added. The
+// given annotations take precedence.
+func GetPodAnnotations(instance *llamav1alpha1.LlamaStackDistribution, annotations map[string]string) map[string]string {
+	if len(instance.Spec.Server.PodAnnotations) == 0 {
+		return annotations
+	}
+	return mergeMissing(annotations, instance.Spec.Server.PodAnnotations)
+}
+
+// mergeMissing returns a copy of existing with the entries of added whose keys it does not have.
+func mergeMissing(existing, added map[string]string) map[string]string {
+	merged := maps.Clone(added)
+	maps.Copy(merged, existing)
+	return merged
+}
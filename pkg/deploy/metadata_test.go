@@ -0,0 +1,100 @@
+package deploy
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetCommonMetadata(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Labels:      map[string]string{"cost-center": "ml-platform", "app.kubernetes.io/instance": "other"},
+			Annotations: map[string]string{"owner": "ml-platform@example.com"},
+		},
+	}
+
+	t.Run("operator metadata takes precedence", func(t *testing.T) {
+		obj := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"app.kubernetes.io/instance": "test-instance"},
+			Annotations: map[string]string{"owner": "operator"},
+		}}
+
+		SetCommonMetadata(instance, obj)
+
+		assert.Equal(t, map[string]string{"cost-center": "ml-platform", "app.kubernetes.io/instance": "test-instance"}, obj.Labels)
+		assert.Equal(t, map[string]string{"owner": "operator"}, obj.Annotations)
+	})
+
+	t.Run("spec metadata is added", func(t *testing.T) {
+		obj := &corev1.ServiceAccount{}
+
+		SetCommonMetadata(instance, obj)
+
+		assert.Equal(t, instance.Spec.Labels, obj.Labels)
+		assert.Equal(t, instance.Spec.Annotations, obj.Annotations)
+	})
+
+	t.Run("no spec metadata leaves the object unchanged", func(t *testing.T) {
+		obj := &corev1.ServiceAccount{}
+
+		SetCommonMetadata(&llamav1alpha1.LlamaStackDistribution{}, obj)
+
+		assert.Nil(t, obj.Labels)
+		assert.Nil(t, obj.Annotations)
+	})
+}
+
+func TestGetDeploymentLabels(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Labels: map[string]string{"cost-center": "ml-platform", "team": "platform"},
+			Server: llamav1alpha1.ServerSpec{
+				DeploymentLabels: map[string]string{"team": "inference", "app.kubernetes.io/instance": "other"},
+			},
+		},
+	}
+
+	obj := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Labels: GetDeploymentLabels(instance, map[string]string{"app.kubernetes.io/instance": "test-instance"}),
+	}}
+	SetCommonMetadata(instance, obj)
+
+	// Operator labels take precedence over the deployment labels, which take precedence over the common labels
+	assert.Equal(t, map[string]string{
+		"app.kubernetes.io/instance": "test-instance",
+		"team":                       "inference",
+		"cost-center":                "ml-platform",
+	}, obj.Labels)
+
+	assert.Nil(t, GetDeploymentLabels(&llamav1alpha1.LlamaStackDistribution{}, nil))
+}
+
+func TestGetPodAnnotations(t *testing.T) {
+	instance := &llamav1alpha1.LlamaStackDistribution{
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				PodAnnotations: map[string]string{
+					"sidecar.istio.io/inject":    "true",
+					"configmap.hash/user-config": "user-value",
+				},
+			},
+		},
+	}
+
+	annotations := GetPodAnnotations(instance, map[string]string{"configmap.hash/user-config": "operator-value"})
+
+	assert.Equal(t, map[string]string{
+		"sidecar.istio.io/inject":    "true",
+		"configmap.hash/user-config": "operator-value",
+	}, annotations)
+
+	operatorAnnotations := map[string]string{"secret.hash/user-secret": "value"}
+	assert.Equal(t, operatorAnnotations, GetPodAnnotations(&llamav1alpha1.LlamaStackDistribution{}, operatorAnnotations))
+}
@@ -3,23 +3,27 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/go-logr/logr"
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-// ApplyNetworkPolicy creates or updates a NetworkPolicy.
-func ApplyNetworkPolicy(ctx context.Context, c client.Client, scheme *runtime.Scheme,
-	instance *llamav1alpha1.LlamaStackDistribution, networkPolicy *networkingv1.NetworkPolicy, log logr.Logger) error {
-	// Set the controller reference
-	if err := ctrl.SetControllerReference(instance, networkPolicy, scheme); err != nil {
-		return fmt.Errorf("failed to set controller reference: %w", err)
+// ApplyNetworkPolicy creates or updates a NetworkPolicy and reports which of the two happened.
+// An existing NetworkPolicy that already matches the desired spec is left untouched.
+func ApplyNetworkPolicy(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, networkPolicy *networkingv1.NetworkPolicy, log logr.Logger) (controllerutil.OperationResult, error) {
+	// Set the owner reference
+	if err := SetOwnerReference(instance, networkPolicy, scheme, ownerRefPolicy); err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to set owner reference: %w", err)
 	}
+	SetCommonMetadata(instance, networkPolicy)
 
 	// Check if the NetworkPolicy already exists
 	existing := &networkingv1.NetworkPolicy{}
@@ -28,41 +32,48 @@ func ApplyNetworkPolicy(ctx context.Context, c client.Client, scheme *runtime.Sc
 		if k8serrors.IsNotFound(err) {
 			// Create the NetworkPolicy if it doesn't exist
 			if err = c.Create(ctx, networkPolicy); err != nil {
-				return fmt.Errorf("failed to create NetworkPolicy: %w", err)
+				return controllerutil.OperationResultNone, fmt.Errorf("failed to create NetworkPolicy: %w", err)
 			}
 			log.Info("Created NetworkPolicy", "name", networkPolicy.Name)
-			return nil
+			return controllerutil.OperationResultCreated, nil
 		}
-		return fmt.Errorf("failed to get NetworkPolicy: %w", err)
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to get NetworkPolicy: %w", err)
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, networkPolicy.Spec) &&
+		equality.Semantic.DeepEqual(existing.OwnerReferences, networkPolicy.OwnerReferences) &&
+		maps.Equal(existing.Labels, networkPolicy.Labels) && hasAnnotations(existing, networkPolicy.Annotations) {
+		log.V(1).Info("NetworkPolicy is up to date", "name", networkPolicy.Name)
+		return controllerutil.OperationResultNone, nil
 	}
 
 	// Update the NetworkPolicy if it exists
 	networkPolicy.ResourceVersion = existing.ResourceVersion
 	if err := c.Update(ctx, networkPolicy); err != nil {
-		return fmt.Errorf("failed to update NetworkPolicy: %w", err)
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to update NetworkPolicy: %w", classifyImmutableFieldError(err))
 	}
 	log.Info("Updated NetworkPolicy", "name", networkPolicy.Name)
-	return nil
+	return controllerutil.OperationResultUpdated, nil
 }
 
 // HandleDisabledNetworkPolicy handles the deletion of a NetworkPolicy when the feature is disabled.
-// It checks if the NetworkPolicy exists and deletes it if found.
-func HandleDisabledNetworkPolicy(ctx context.Context, c client.Client, networkPolicy *networkingv1.NetworkPolicy, log logr.Logger) error {
+// It checks if the NetworkPolicy exists and deletes it if found, and reports whether it was deleted.
+func HandleDisabledNetworkPolicy(ctx context.Context, c client.Client, networkPolicy *networkingv1.NetworkPolicy, log logr.Logger) (bool, error) {
 	log.Info("NetworkPolicy creation is disabled, checking if deletion is needed")
 	existingPolicy := &networkingv1.NetworkPolicy{}
 	err := c.Get(ctx, client.ObjectKeyFromObject(networkPolicy), existingPolicy)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			log.Info("NetworkPolicy does not exist, nothing to delete", "name", networkPolicy.Name)
-			return nil // NetworkPolicy doesn't exist, nothing to do
+			return false, nil // NetworkPolicy doesn't exist, nothing to do
 		}
-		return fmt.Errorf("failed to check NetworkPolicy existence: %w", err)
+		return false, fmt.Errorf("failed to check NetworkPolicy existence: %w", err)
 	}
 
 	// NetworkPolicy exists, proceed with deletion
 	if err := c.Delete(ctx, existingPolicy); err != nil {
-		return fmt.Errorf("failed to delete NetworkPolicy: %w", err)
+		return false, fmt.Errorf("failed to delete NetworkPolicy: %w", err)
 	}
 	log.Info("Deleted NetworkPolicy", "name", networkPolicy.Name)
-	return nil
+	return true, nil
 }
@@ -0,0 +1,40 @@
+package deploy
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// OwnerReferencePolicy controls the flags of the owner references set on generated resources.
+// Unset fields keep the controller-runtime defaults, where both flags are true.
+type OwnerReferencePolicy struct {
+	// BlockOwnerDeletion makes a foreground deletion of the owner wait until the resource is deleted.
+	BlockOwnerDeletion *bool `yaml:"blockOwnerDeletion,omitempty"`
+	// Controller marks the owner as the managing controller of the resource.
+	Controller *bool `yaml:"controller,omitempty"`
+}
+
+// SetOwnerReference sets an owner reference to owner on obj using the flags of the policy.
+func SetOwnerReference(owner, obj metav1.Object, scheme *runtime.Scheme, policy OwnerReferencePolicy) error {
+	if ptr.Deref(policy.Controller, true) {
+		if err := ctrl.SetControllerReference(owner, obj, scheme); err != nil {
+			return fmt.Errorf("failed to set controller reference: %w", err)
+		}
+	} else if err := controllerutil.SetOwnerReference(owner, obj, scheme); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+
+	refs := obj.GetOwnerReferences()
+	for i := range refs {
+		if refs[i].UID == owner.GetUID() {
+			refs[i].BlockOwnerDeletion = ptr.To(ptr.Deref(policy.BlockOwnerDeletion, true))
+		}
+	}
+	obj.SetOwnerReferences(refs)
+	return nil
+}
@@ -0,0 +1,69 @@
+package deploy
+
+import (
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+)
+
+func TestSetOwnerReference(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	owner := &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-owner",
+			Namespace: "default",
+			UID:       "owner-uid",
+		},
+	}
+
+	testCases := []struct {
+		name                       string
+		policy                     OwnerReferencePolicy
+		expectedController         *bool
+		expectedBlockOwnerDeletion *bool
+	}{
+		{
+			name:                       "default policy keeps controller-runtime defaults",
+			policy:                     OwnerReferencePolicy{},
+			expectedController:         ptr.To(true),
+			expectedBlockOwnerDeletion: ptr.To(true),
+		},
+		{
+			name:                       "block owner deletion disabled",
+			policy:                     OwnerReferencePolicy{BlockOwnerDeletion: ptr.To(false)},
+			expectedController:         ptr.To(true),
+			expectedBlockOwnerDeletion: ptr.To(false),
+		},
+		{
+			name:                       "non-controller owner reference",
+			policy:                     OwnerReferencePolicy{Controller: ptr.To(false), BlockOwnerDeletion: ptr.To(false)},
+			expectedController:         nil,
+			expectedBlockOwnerDeletion: ptr.To(false),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start from a controller reference to verify that the policy also rewrites existing references
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-object", Namespace: "default"}}
+			require.NoError(t, SetOwnerReference(owner, obj, testScheme, OwnerReferencePolicy{}))
+
+			require.NoError(t, SetOwnerReference(owner, obj, testScheme, tc.policy))
+
+			refs := obj.GetOwnerReferences()
+			require.Len(t, refs, 1, "object should have exactly one owner reference")
+			require.Equal(t, owner.GetUID(), refs[0].UID)
+			require.Equal(t, tc.expectedController, refs[0].Controller)
+			require.Equal(t, tc.expectedBlockOwnerDeletion, refs[0].BlockOwnerDeletion)
+		})
+	}
+}
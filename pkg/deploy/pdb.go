@@ -0,0 +1,71 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyPodDisruptionBudget creates or updates a PodDisruptionBudget. A PodDisruptionBudget with
+// the same name that is not owned by the instance is left untouched.
+func ApplyPodDisruptionBudget(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, pdb *policyv1.PodDisruptionBudget, log logr.Logger) error {
+	if err := SetOwnerReference(instance, pdb, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, pdb)
+
+	existing := &policyv1.PodDisruptionBudget{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(pdb), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, pdb); err != nil {
+				return fmt.Errorf("failed to create PodDisruptionBudget: %w", err)
+			}
+			log.Info("Created PodDisruptionBudget", "name", pdb.Name)
+			return nil
+		}
+		return fmt.Errorf("failed to get PodDisruptionBudget: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply PodDisruptionBudget %s: %w", pdb.Name, ErrNotOwned)
+	}
+
+	pdb.ResourceVersion = existing.ResourceVersion
+	if err := c.Update(ctx, pdb); err != nil {
+		return fmt.Errorf("failed to update PodDisruptionBudget: %w", classifyImmutableFieldError(err))
+	}
+	log.V(1).Info("Updated PodDisruptionBudget", "name", pdb.Name)
+	return nil
+}
+
+// HandleDisabledPodDisruptionBudget deletes the PodDisruptionBudget of the instance when the server pods no longer
+// need one. Only a PodDisruptionBudget owned by the instance is deleted.
+func HandleDisabledPodDisruptionBudget(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, log logr.Logger) error {
+	existing := &policyv1.PodDisruptionBudget{}
+	key := client.ObjectKey{Name: GetPodDisruptionBudgetName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check PodDisruptionBudget existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping deletion of PodDisruptionBudget not owned by this instance", "name", existing.Name)
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete PodDisruptionBudget: %w", err)
+	}
+	log.Info("Deleted PodDisruptionBudget", "name", existing.Name)
+	return nil
+}
@@ -0,0 +1,55 @@
+package plugins
+
+import (
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+// DataPlaceholderConfig configures the replacement of placeholders in ConfigMap data.
+type DataPlaceholderConfig struct {
+	// Values maps each placeholder, e.g. "__INSTANCE_NAME__", to the value replacing it.
+	Values map[string]string `json:"values"`
+}
+
+// CreateDataPlaceholderPlugin creates a plugin that replaces placeholders in the data of ConfigMaps.
+// This allows file-based templates such as dashboards to reference the owning instance.
+func CreateDataPlaceholderPlugin(config DataPlaceholderConfig) *dataPlaceholderPlugin {
+	placeholders := make([]string, 0, len(config.Values))
+	for placeholder := range config.Values {
+		placeholders = append(placeholders, placeholder)
+	}
+	// Sort for a deterministic replacement order
+	slices.Sort(placeholders)
+
+	pairs := make([]string, 0, 2*len(placeholders))
+	for _, placeholder := range placeholders {
+		pairs = append(pairs, placeholder, config.Values[placeholder])
+	}
+	return &dataPlaceholderPlugin{replacer: strings.NewReplacer(pairs...)}
+}
+
+type dataPlaceholderPlugin struct {
+	replacer *strings.Replacer
+}
+
+// Config implements the TransformerPlugin interface.
+func (p *dataPlaceholderPlugin) Config(h *resmap.PluginHelpers, config []byte) error {
+	return nil
+}
+
+// Transform implements the TransformerPlugin interface.
+func (p *dataPlaceholderPlugin) Transform(m resmap.ResMap) error {
+	for _, res := range m.Resources() {
+		if res.GetKind() != "ConfigMap" {
+			continue
+		}
+		data := res.GetDataMap()
+		for key, value := range data {
+			data[key] = p.replacer.Replace(value)
+		}
+		res.SetDataMap(data)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+func TestDataPlaceholderPlugin(t *testing.T) {
+	plugin := CreateDataPlaceholderPlugin(DataPlaceholderConfig{
+		Values: map[string]string{
+			"__INSTANCE_NAME__":      "my-llsd",
+			"__INSTANCE_NAMESPACE__": "my-ns",
+		},
+	})
+
+	t.Run("replaces placeholders in ConfigMap data", func(t *testing.T) {
+		resMap := resmap.New()
+		configMap := newTestResource(t, "v1", "ConfigMap", "dashboard", "", map[string]any{
+			"data": map[string]any{
+				"dashboard.json": `{"title": "__INSTANCE_NAMESPACE__/__INSTANCE_NAME__", "expr": "up{pod=~\"__INSTANCE_NAME__-.*\"}"}`,
+			},
+		})
+		require.NoError(t, resMap.Append(configMap))
+
+		require.NoError(t, plugin.Transform(resMap))
+
+		assert.Equal(t,
+			`{"title": "my-ns/my-llsd", "expr": "up{pod=~\"my-llsd-.*\"}"}`,
+			resMap.Resources()[0].GetDataMap()["dashboard.json"])
+	})
+
+	t.Run("ignores other kinds", func(t *testing.T) {
+		resMap := resmap.New()
+		service := newTestResource(t, "v1", "Service", "__INSTANCE_NAME__", "", nil)
+		require.NoError(t, resMap.Append(service))
+
+		require.NoError(t, plugin.Transform(resMap))
+
+		assert.Equal(t, "__INSTANCE_NAME__", resMap.Resources()[0].GetName())
+	})
+}
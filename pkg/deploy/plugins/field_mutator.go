@@ -3,6 +3,7 @@ package plugins
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"strings"
 
 	"github.com/go-openapi/jsonpointer"
@@ -30,10 +31,25 @@ type FieldMapping struct {
 	CreateIfNotExists bool `json:"createIfNotExists,omitempty"`
 }
 
-// FieldMutatorConfig is a collection of FieldMappings.
+// FieldMergeMapping defines entries to merge into a map field, such as labels or annotations.
+type FieldMergeMapping struct {
+	// TargetPath is the JSON Pointer path to the map in the target object, like "/metadata/labels".
+	// The map and any intermediate map structures are created if they don't exist in the target resource.
+	TargetPath string `json:"targetPath"`
+	// TargetKind is the kind of resource to apply the transformation to.
+	// An empty kind applies the transformation to all resources.
+	TargetKind string `json:"targetKind,omitempty"`
+	// Value holds the entries to merge into the target map. Entries already in the map whose keys are not
+	// in Value are kept, while entries with the same key are replaced.
+	Value map[string]string `json:"value"`
+}
+
+// FieldMutatorConfig is a collection of FieldMappings and FieldMergeMappings.
 type FieldMutatorConfig struct {
 	// Mappings is a list of field mappings to apply.
 	Mappings []FieldMapping `json:"mappings"`
+	// MergeMappings is a list of map merges to apply after the field mappings.
+	MergeMappings []FieldMergeMapping `json:"mergeMappings,omitempty"`
 }
 
 // CreateFieldMutator creates a mutator plugin that sets a value for a given field.
@@ -87,6 +103,22 @@ func (t *fieldMutator) Transform(m resmap.ResMap) error {
 		}
 	}
 
+	for _, mapping := range t.config.MergeMappings {
+		if len(mapping.Value) == 0 {
+			continue
+		}
+
+		for _, res := range m.Resources() {
+			if mapping.TargetKind != "" && res.GetKind() != mapping.TargetKind {
+				continue
+			}
+
+			if err := mergeTargetField(res, mapping); err != nil {
+				return fmt.Errorf("failed to merge target field for mapping %s: %w", mapping.TargetPath, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -126,6 +158,44 @@ func setTargetField(res *resource.Resource, value any, mapping FieldMapping) err
 	return updateResource(res, updatedData)
 }
 
+// mergeTargetField modifies the resource by merging the entries of the mapping into the map at the given
+// JSON Pointer path, creating the map if it doesn't exist.
+func mergeTargetField(res *resource.Resource, mapping FieldMergeMapping) error {
+	yamlBytes, err := res.AsYAML()
+	if err != nil {
+		return fmt.Errorf("failed to get YAML: %w", err)
+	}
+
+	var data any
+	if unmarshalErr := yaml.Unmarshal(yamlBytes, &data); unmarshalErr != nil {
+		return fmt.Errorf("failed to unmarshal YAML: %w", unmarshalErr)
+	}
+
+	ptr, err := jsonpointer.New(mapping.TargetPath)
+	if err != nil {
+		return fmt.Errorf("failed to parse JSON Pointer path %q: %w", mapping.TargetPath, err)
+	}
+
+	merged := make(map[string]any, len(mapping.Value))
+	if existing, _, getErr := ptr.Get(data); getErr == nil && existing != nil {
+		existingMap, isMap := existing.(map[string]any)
+		if !isMap {
+			return fmt.Errorf("failed to merge field at path %q: found %T instead of a map", mapping.TargetPath, existing)
+		}
+		maps.Copy(merged, existingMap)
+	}
+	for key, value := range mapping.Value {
+		merged[key] = value
+	}
+
+	updatedData, err := setWithPathCreation(data, ptr, merged)
+	if err != nil {
+		return fmt.Errorf("failed to set field at path %q: %w", mapping.TargetPath, err)
+	}
+
+	return updateResource(res, updatedData)
+}
+
 func setWithPathCreation(data any, ptr jsonpointer.Pointer, value any) (any, error) {
 	// try direct set first
 	if result, err := ptr.Set(data, value); err == nil {
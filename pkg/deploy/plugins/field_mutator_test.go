@@ -350,3 +350,109 @@ func TestJsonPointerNilTraversalPanic(t *testing.T) {
 		require.NotNil(t, properties, "properties should no longer be nil")
 	})
 }
+
+func TestTransformMergeMappings(t *testing.T) {
+	newLabeledResource := func(t *testing.T, kind, name string, labels map[string]any) *resource.Resource {
+		t.Helper()
+		res := newTestResource(t, "v1", kind, name, "", map[string]any{})
+		if labels != nil {
+			resMap, err := res.Map()
+			require.NoError(t, err)
+			resMap["metadata"].(map[string]any)["labels"] = labels
+			rf := resource.NewFactory(nil)
+			res, err = rf.FromMap(resMap)
+			require.NoError(t, err)
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name           string
+		mappings       []FieldMergeMapping
+		initialLabels  map[string]map[string]any // map[resource-name]labels
+		expectedLabels map[string]map[string]string
+		expectError    bool
+	}{
+		{
+			name: "merge keeps other keys",
+			mappings: []FieldMergeMapping{
+				{TargetPath: "/metadata/labels", Value: map[string]string{"app.kubernetes.io/managed-by": "llama-stack-operator"}},
+			},
+			initialLabels: map[string]map[string]any{
+				"my-service": {"app": "llama-stack", "team": "ml"},
+			},
+			expectedLabels: map[string]map[string]string{
+				"my-service": {"app": "llama-stack", "team": "ml", "app.kubernetes.io/managed-by": "llama-stack-operator"},
+				"my-pvc":     {"app.kubernetes.io/managed-by": "llama-stack-operator"},
+			},
+		},
+		{
+			name: "merge replaces the value of the same key only",
+			mappings: []FieldMergeMapping{
+				{TargetPath: "/metadata/labels", Value: map[string]string{"app": "llama-stack"}},
+			},
+			initialLabels: map[string]map[string]any{
+				"my-service": {"app": "other", "team": "ml"},
+				"my-pvc":     {"team": "ml"},
+			},
+			expectedLabels: map[string]map[string]string{
+				"my-service": {"app": "llama-stack", "team": "ml"},
+				"my-pvc":     {"app": "llama-stack", "team": "ml"},
+			},
+		},
+		{
+			name: "merge only into the target kind",
+			mappings: []FieldMergeMapping{
+				{TargetPath: "/metadata/labels", TargetKind: "Service", Value: map[string]string{"exposed": "true"}},
+			},
+			initialLabels: map[string]map[string]any{
+				"my-pvc": {"team": "ml"},
+			},
+			expectedLabels: map[string]map[string]string{
+				"my-service": {"exposed": "true"},
+				"my-pvc":     {"team": "ml"},
+			},
+		},
+		{
+			name: "empty value is skipped",
+			mappings: []FieldMergeMapping{
+				{TargetPath: "/metadata/labels", Value: map[string]string{}},
+			},
+			expectedLabels: map[string]map[string]string{
+				"my-service": nil,
+				"my-pvc":     nil,
+			},
+		},
+		{
+			name: "target is not a map",
+			mappings: []FieldMergeMapping{
+				{TargetPath: "/metadata/name", Value: map[string]string{"key": "value"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resMap := resmap.New()
+			require.NoError(t, resMap.Append(newLabeledResource(t, "Service", "my-service", tc.initialLabels["my-service"])))
+			require.NoError(t, resMap.Append(newLabeledResource(t, "PersistentVolumeClaim", "my-pvc", tc.initialLabels["my-pvc"])))
+
+			err := CreateFieldMutator(FieldMutatorConfig{MergeMappings: tc.mappings}).Transform(resMap)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, res := range resMap.Resources() {
+				labels := res.GetLabels()
+				if len(tc.expectedLabels[res.GetName()]) == 0 {
+					require.Empty(t, labels, "labels of %s", res.GetName())
+					continue
+				}
+				require.Equal(t, tc.expectedLabels[res.GetName()], labels, "labels of %s", res.GetName())
+			}
+		})
+	}
+}
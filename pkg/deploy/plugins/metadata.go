@@ -0,0 +1,56 @@
+package plugins
+
+import (
+	"fmt"
+	"maps"
+
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+// MetadataConfig holds configuration for the metadata plugin.
+type MetadataConfig struct {
+	// Labels to add to the resources.
+	Labels map[string]string
+	// Annotations to add to the resources.
+	Annotations map[string]string
+}
+
+// CreateMetadataPlugin creates a transformer plugin that adds labels and annotations to all resources.
+// Labels and annotations already set on a resource, such as those managed by the operator, are kept.
+func CreateMetadataPlugin(config MetadataConfig) *metadataTransformer {
+	return &metadataTransformer{config: config}
+}
+
+type metadataTransformer struct {
+	config MetadataConfig
+}
+
+// Transform implements the TransformerPlugin interface.
+func (t *metadataTransformer) Transform(m resmap.ResMap) error {
+	for _, res := range m.Resources() {
+		if len(t.config.Labels) > 0 {
+			if err := res.SetLabels(mergeMissing(res.GetLabels(), t.config.Labels)); err != nil {
+				return fmt.Errorf("failed to set labels for resource %s/%s: %w", res.GetKind(), res.GetName(), err)
+			}
+		}
+		if len(t.config.Annotations) > 0 {
+			if err := res.SetAnnotations(mergeMissing(res.GetAnnotations(), t.config.Annotations)); err != nil {
+				return fmt.Errorf("failed to set annotations for resource %s/%s: %w", res.GetKind(), res.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// Config implements the TransformerPlugin interface.
+// This method is empty because the plugin's configuration is provided directly via `CreateMetadataPlugin`.
+func (t *metadataTransformer) Config(h *resmap.PluginHelpers, _ []byte) error {
+	return nil
+}
+
+// mergeMissing returns a copy of existing with the entries of added whose keys it does not have.
+func mergeMissing(existing, added map[string]string) map[string]string {
+	merged := maps.Clone(added)
+	maps.Copy(merged, existing)
+	return merged
+}
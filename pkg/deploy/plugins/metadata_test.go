@@ -0,0 +1,62 @@
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/kustomize/api/resmap"
+)
+
+func TestMetadataPlugin(t *testing.T) {
+	t.Run("adds labels and annotations to all resources", func(t *testing.T) {
+		resMap := resmap.New()
+		require.NoError(t, resMap.Append(newTestResource(t, "apps/v1", "Deployment", "backend", "", nil)))
+		require.NoError(t, resMap.Append(newTestResource(t, "v1", "Service", "frontend", "", nil)))
+
+		plugin := CreateMetadataPlugin(MetadataConfig{
+			Labels:      map[string]string{"cost-center": "ml-platform", "team": "inference"},
+			Annotations: map[string]string{"owner": "ml-platform@example.com"},
+		})
+		require.NoError(t, plugin.Transform(resMap))
+
+		for _, res := range resMap.Resources() {
+			assert.Equal(t, map[string]string{"cost-center": "ml-platform", "team": "inference"}, res.GetLabels(), res.GetKind())
+			assert.Equal(t, map[string]string{"owner": "ml-platform@example.com"}, res.GetAnnotations(), res.GetKind())
+		}
+	})
+
+	t.Run("existing labels and annotations take precedence", func(t *testing.T) {
+		resMap := resmap.New()
+		svc := newTestResource(t, "v1", "Service", "frontend", "", nil)
+		require.NoError(t, svc.SetLabels(map[string]string{"app.kubernetes.io/instance": "frontend"}))
+		require.NoError(t, svc.SetAnnotations(map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "nlb"}))
+		require.NoError(t, resMap.Append(svc))
+
+		plugin := CreateMetadataPlugin(MetadataConfig{
+			Labels: map[string]string{"app.kubernetes.io/instance": "other", "team": "inference"},
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type": "external",
+				"owner": "ml-platform@example.com",
+			},
+		})
+		require.NoError(t, plugin.Transform(resMap))
+
+		res := resMap.Resources()[0]
+		assert.Equal(t, map[string]string{"app.kubernetes.io/instance": "frontend", "team": "inference"}, res.GetLabels())
+		assert.Equal(t, map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+			"owner": "ml-platform@example.com",
+		}, res.GetAnnotations())
+	})
+
+	t.Run("no metadata leaves the resources unchanged", func(t *testing.T) {
+		resMap := resmap.New()
+		require.NoError(t, resMap.Append(newTestResource(t, "v1", "Service", "frontend", "", nil)))
+
+		require.NoError(t, CreateMetadataPlugin(MetadataConfig{}).Transform(resMap))
+
+		assert.Empty(t, resMap.Resources()[0].GetLabels())
+		assert.Empty(t, resMap.Resources()[0].GetAnnotations())
+	})
+}
@@ -7,22 +7,31 @@ import (
 	"sigs.k8s.io/kustomize/api/resmap"
 )
 
+// NamespaceConfig holds configuration for the namespace plugin.
+type NamespaceConfig struct {
+	// Namespace to set on the namespaced resources.
+	Namespace string
+	// PreserveExistingNamespace keeps the namespace of the resources that already have one,
+	// e.g. base manifests targeting a shared namespace. By default the namespace is overwritten.
+	PreserveExistingNamespace bool
+}
+
 // CreateNamespacePlugin creates a new namespace plugin.
-func CreateNamespacePlugin(namespace string) (*namespacePlugin, error) {
+func CreateNamespacePlugin(config NamespaceConfig) (*namespacePlugin, error) {
 	// do not transform an invalid namespace
-	if namespace == "" {
+	if config.Namespace == "" {
 		return nil, errors.New("failed to set namespace: namespace cannot be empty")
 	}
-	if err := ValidateK8sLabelName(namespace); err != nil {
+	if err := ValidateK8sLabelName(config.Namespace); err != nil {
 		return nil, fmt.Errorf("failed to set namespace: invalid namespace provided: %w", err)
 	}
 	return &namespacePlugin{
-		namespace: namespace,
+		config: config,
 	}, nil
 }
 
 type namespacePlugin struct {
-	namespace string
+	config NamespaceConfig
 }
 
 // Config implements the TransformerPlugin interface.
@@ -37,7 +46,10 @@ func (p *namespacePlugin) Transform(m resmap.ResMap) error {
 		if res.GetGvk().IsClusterScoped() {
 			continue
 		}
-		if err := res.SetNamespace(p.namespace); err != nil {
+		if p.config.PreserveExistingNamespace && res.GetNamespace() != "" {
+			continue
+		}
+		if err := res.SetNamespace(p.config.Namespace); err != nil {
 			return fmt.Errorf("failed to set namespace for resource %s/%s: %w", res.GetKind(), res.GetName(), err)
 		}
 	}
@@ -17,7 +17,7 @@ func TestNamespacePlugin(t *testing.T) {
 		dep := newTestResource(t, "apps/v1", "Deployment", "my-app", "", nil)
 		require.NoError(t, resMap.Append(dep))
 
-		plugin, err := CreateNamespacePlugin(testNamespace)
+		plugin, err := CreateNamespacePlugin(NamespaceConfig{Namespace: testNamespace})
 		require.NoError(t, err)
 		err = plugin.Transform(resMap)
 		require.NoError(t, err)
@@ -39,7 +39,7 @@ func TestNamespacePlugin(t *testing.T) {
 		clusterRole := newTestResource(t, "rbac.authorization.k8s.io/v1", "ClusterRole", "admin-role", "", nil)
 		require.NoError(t, resMap.Append(clusterRole))
 
-		plugin, err := CreateNamespacePlugin(testNamespace)
+		plugin, err := CreateNamespacePlugin(NamespaceConfig{Namespace: testNamespace})
 		require.NoError(t, err)
 		err = plugin.Transform(resMap)
 		require.NoError(t, err)
@@ -61,7 +61,7 @@ func TestNamespacePlugin(t *testing.T) {
 		svc := newTestResource(t, "v1", "Service", "my-service", "", nil)
 		require.NoError(t, resMap.Append(svc))
 
-		plugin, err := CreateNamespacePlugin(testNamespace)
+		plugin, err := CreateNamespacePlugin(NamespaceConfig{Namespace: testNamespace})
 		require.NoError(t, err)
 		err = plugin.Transform(resMap)
 		require.NoError(t, err)
@@ -83,7 +83,7 @@ func TestNamespacePlugin(t *testing.T) {
 		dep := newTestResource(t, "apps/v1", "Deployment", "my-app", "", nil)
 		require.NoError(t, resMap.Append(dep))
 
-		_, err := CreateNamespacePlugin("")
+		_, err := CreateNamespacePlugin(NamespaceConfig{})
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "namespace")
 		assert.Contains(t, err.Error(), "empty")
@@ -95,7 +95,7 @@ func TestNamespacePlugin(t *testing.T) {
 		pvc := newTestResource(t, "v1", "PersistentVolumeClaim", "my-pvc", "old-namespace", nil)
 		require.NoError(t, resMap.Append(pvc))
 
-		plugin, err := CreateNamespacePlugin(testNamespace)
+		plugin, err := CreateNamespacePlugin(NamespaceConfig{Namespace: testNamespace})
 		require.NoError(t, err)
 		err = plugin.Transform(resMap)
 		require.NoError(t, err)
@@ -110,4 +110,25 @@ func TestNamespacePlugin(t *testing.T) {
 		require.NotNil(t, transformedPvc, "transformed pvc not found in resMap")
 		assert.Equal(t, testNamespace, transformedPvc.GetNamespace())
 	})
+
+	t.Run("preserves existing namespace", func(t *testing.T) {
+		resMap := resmap.New()
+		// resource targeting a fixed namespace, and resource without a namespace
+		pvc := newTestResource(t, "v1", "PersistentVolumeClaim", "my-pvc", "shared-namespace", nil)
+		svc := newTestResource(t, "v1", "Service", "my-service", "", nil)
+		require.NoError(t, resMap.Append(pvc))
+		require.NoError(t, resMap.Append(svc))
+
+		plugin, err := CreateNamespacePlugin(NamespaceConfig{Namespace: testNamespace, PreserveExistingNamespace: true})
+		require.NoError(t, err)
+		err = plugin.Transform(resMap)
+		require.NoError(t, err)
+
+		namespaces := map[string]string{}
+		for _, r := range resMap.Resources() {
+			namespaces[r.GetKind()] = r.GetNamespace()
+		}
+		assert.Equal(t, "shared-namespace", namespaces["PersistentVolumeClaim"], "existing namespace should be kept")
+		assert.Equal(t, testNamespace, namespaces["Service"], "empty namespace should be set")
+	})
 }
@@ -41,8 +41,8 @@ func newTestResource(t *testing.T, apiVersion, kind, name, namespace string, con
 		// Overlay caller's content (e.g., "replicas") onto the base Deployment structure.
 		maps.Copy(baseDeploymentContent, content)
 		obj["spec"] = baseDeploymentContent
-	case "ClusterRole":
-		// For ClusterRole, its main content (rules) is at the top level, not under 'spec'.
+	case "ClusterRole", "ConfigMap":
+		// For ClusterRole and ConfigMap, the main content (rules, data) is at the top level, not under 'spec'.
 		maps.Copy(obj, content)
 	default:
 		// For other simple types (like Service, PVC), assume content is the 'spec'.
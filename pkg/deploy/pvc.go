@@ -0,0 +1,142 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// IsPVCRetentionRequested reports whether the instance asks to keep its PVC when it is deleted.
+func IsPVCRetentionRequested(instance *llamav1alpha1.LlamaStackDistribution) bool {
+	return instance.GetAnnotations()[llamav1alpha1.RetainPVCAnnotation] == "true"
+}
+
+// ReleasePVC handles the PVC of an instance being deleted. If retention is requested, the owner reference to the
+// instance is removed so that the PVC survives the garbage collection of the instance, otherwise the PVC is deleted.
+// A PVC not owned by the instance is left alone. It returns whether a PVC was released.
+func ReleasePVC(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, retain bool, log logr.Logger) (bool, error) {
+	existing := &corev1.PersistentVolumeClaim{}
+	key := client.ObjectKey{Name: GetPVCName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check PVC existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping release of PVC not owned by this instance", "name", existing.Name)
+		return false, nil
+	}
+
+	if !retain {
+		if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to delete PVC: %w", err)
+		}
+		log.Info("Deleted PVC of deleted instance", "name", existing.Name)
+		return true, nil
+	}
+
+	patch := client.MergeFrom(existing.DeepCopy())
+	ownerReferences := make([]metav1.OwnerReference, 0, len(existing.OwnerReferences))
+	for _, ref := range existing.OwnerReferences {
+		if ref.UID != instance.GetUID() {
+			ownerReferences = append(ownerReferences, ref)
+		}
+	}
+	existing.OwnerReferences = ownerReferences
+	if err := c.Patch(ctx, existing, patch); err != nil {
+		return false, fmt.Errorf("failed to remove owner reference from PVC: %w", err)
+	}
+	log.Info("Retained PVC of deleted instance", "name", existing.Name)
+	return true, nil
+}
+
+// PVCResizeBlockedMessage returns why the PVC cannot be resized to the requested size, or an empty string if the
+// size is unchanged or the PVC can be expanded to it. PVCs cannot shrink, and only grow if their StorageClass
+// allows volume expansion.
+func PVCResizeBlockedMessage(ctx context.Context, c client.Client, pvc *corev1.PersistentVolumeClaim, requested resource.Quantity) (string, error) {
+	current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	switch requested.Cmp(current) {
+	case 0:
+		return "", nil
+	case -1:
+		return fmt.Sprintf("Cannot shrink PVC from %s to %s, PVCs can only be expanded", current.String(), requested.String()), nil
+	}
+
+	className := ptr.Deref(pvc.Spec.StorageClassName, "")
+	if className == "" {
+		return fmt.Sprintf("Cannot expand PVC from %s to %s, the PVC has no StorageClass", current.String(), requested.String()), nil
+	}
+	storageClass := &storagev1.StorageClass{}
+	if err := c.Get(ctx, client.ObjectKey{Name: className}, storageClass); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Sprintf("Cannot expand PVC from %s to %s, StorageClass %s not found",
+				current.String(), requested.String(), className), nil
+		}
+		return "", fmt.Errorf("failed to get StorageClass %s: %w", className, err)
+	}
+	if !ptr.Deref(storageClass.AllowVolumeExpansion, false) {
+		return fmt.Sprintf("Cannot expand PVC from %s to %s, StorageClass %s does not allow volume expansion",
+			current.String(), requested.String(), className), nil
+	}
+	return "", nil
+}
+
+// resizePVC expands an existing PVC to the storage size of the desired PVC. Only the storage request is patched,
+// since the rest of the PVC spec is immutable. A resize that is not possible is skipped and reported through the
+// StorageReady condition instead.
+func resizePVC(ctx context.Context, cli client.Client, desired, existing *unstructured.Unstructured) error {
+	logger := log.FromContext(ctx)
+
+	desiredPVC := &corev1.PersistentVolumeClaim{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(desired.Object, desiredPVC); err != nil {
+		return fmt.Errorf("failed to convert desired PVC: %w", err)
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(existing.Object, pvc); err != nil {
+		return fmt.Errorf("failed to convert existing PVC: %w", err)
+	}
+
+	requested, ok := desiredPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return nil
+	}
+	message, err := PVCResizeBlockedMessage(ctx, cli, pvc, requested)
+	if err != nil {
+		return err
+	}
+	if message != "" {
+		logger.Info("Skipping PVC resize", "name", pvc.Name, "reason", message)
+		return nil
+	}
+	current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if requested.Cmp(current) == 0 {
+		return nil
+	}
+	// Kubernetes only accepts a new storage request for bound claims
+	if pvc.Status.Phase != corev1.ClaimBound {
+		logger.Info("Deferring PVC resize until the PVC is bound", "name", pvc.Name, "phase", pvc.Status.Phase)
+		return nil
+	}
+
+	patch := client.MergeFrom(pvc.DeepCopy())
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = requested
+	if err := cli.Patch(ctx, pvc, patch); err != nil {
+		return fmt.Errorf("failed to resize PVC: %w", err)
+	}
+	logger.Info("Resized PVC", "name", pvc.Name, "from", current.String(), "to", requested.String())
+	return nil
+}
@@ -0,0 +1,112 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResizePVC(t *testing.T) {
+	newPVC := func(size string, storageClass *string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pvc", Namespace: "default"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				StorageClassName: storageClass,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		}
+	}
+	toUnstructured := func(t *testing.T, pvc *corev1.PersistentVolumeClaim) *unstructured.Unstructured {
+		t.Helper()
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pvc)
+		require.NoError(t, err)
+		return &unstructured.Unstructured{Object: obj}
+	}
+	expandable := &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+		Provisioner:          "test.csi.k8s.io",
+		AllowVolumeExpansion: ptr.To(true),
+	}
+	fixed := &storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "fixed"},
+		Provisioner: "test.csi.k8s.io",
+	}
+
+	testCases := []struct {
+		name            string
+		existing        *corev1.PersistentVolumeClaim
+		desiredSize     string
+		expectedSize    string
+		expectedMessage string
+	}{
+		{
+			name:         "grow with expandable storage class",
+			existing:     newPVC("10Gi", ptr.To("expandable")),
+			desiredSize:  "50Gi",
+			expectedSize: "50Gi",
+		},
+		{
+			name:            "shrink rejected",
+			existing:        newPVC("10Gi", ptr.To("expandable")),
+			desiredSize:     "5Gi",
+			expectedSize:    "10Gi",
+			expectedMessage: "Cannot shrink PVC from 10Gi to 5Gi, PVCs can only be expanded",
+		},
+		{
+			name:         "unchanged size is a no-op",
+			existing:     newPVC("10Gi", ptr.To("fixed")),
+			desiredSize:  "10Gi",
+			expectedSize: "10Gi",
+		},
+		{
+			name:            "grow rejected without volume expansion",
+			existing:        newPVC("10Gi", ptr.To("fixed")),
+			desiredSize:     "50Gi",
+			expectedSize:    "10Gi",
+			expectedMessage: "Cannot expand PVC from 10Gi to 50Gi, StorageClass fixed does not allow volume expansion",
+		},
+		{
+			name:            "grow rejected without storage class",
+			existing:        newPVC("10Gi", nil),
+			desiredSize:     "50Gi",
+			expectedSize:    "10Gi",
+			expectedMessage: "Cannot expand PVC from 10Gi to 50Gi, the PVC has no StorageClass",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.existing, expandable, fixed).Build()
+			desired := toUnstructured(t, newPVC(tc.desiredSize, tc.existing.Spec.StorageClassName))
+			existing := &unstructured.Unstructured{}
+			existing.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"))
+			require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(tc.existing), existing))
+
+			require.NoError(t, resizePVC(t.Context(), cli, desired, existing))
+
+			updated := &corev1.PersistentVolumeClaim{}
+			require.NoError(t, cli.Get(t.Context(), client.ObjectKeyFromObject(tc.existing), updated))
+			size := updated.Spec.Resources.Requests[corev1.ResourceStorage]
+			require.Equal(t, tc.expectedSize, size.String())
+
+			message, err := PVCResizeBlockedMessage(t.Context(), cli, tc.existing, resource.MustParse(tc.desiredSize))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMessage, message)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RouteGVK is the GroupVersionKind of the OpenShift Route. Routes are handled as unstructured objects
+// so that the operator does not depend on the OpenShift API types.
+var RouteGVK = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+// NewRoute returns an empty Route object with its GroupVersionKind set.
+func NewRoute() *unstructured.Unstructured {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(RouteGVK)
+	return route
+}
+
+// ApplyRoute creates or updates an OpenShift Route. A Route with the same name that is not owned by the instance
+// is left untouched. On return route holds the state of the Route in the cluster, including its status.
+func ApplyRoute(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, route *unstructured.Unstructured, log logr.Logger) error {
+	if err := SetOwnerReference(instance, route, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, route)
+
+	existing := NewRoute()
+	err := c.Get(ctx, client.ObjectKeyFromObject(route), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, route); err != nil {
+				return fmt.Errorf("failed to create Route: %w", err)
+			}
+			log.Info("Created Route", "name", route.GetName())
+			return nil
+		}
+		return fmt.Errorf("failed to get Route: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply Route %s: %w", route.GetName(), ErrNotOwned)
+	}
+
+	// Keep the host generated by the router if none is requested, it cannot be cleared without custom host permissions
+	if host, _, _ := unstructured.NestedString(route.Object, "spec", "host"); host == "" {
+		if existingHost, found, _ := unstructured.NestedString(existing.Object, "spec", "host"); found {
+			if err := unstructured.SetNestedField(route.Object, existingHost, "spec", "host"); err != nil {
+				return fmt.Errorf("failed to keep Route host: %w", err)
+			}
+		}
+	}
+
+	route.SetResourceVersion(existing.GetResourceVersion())
+	if err := c.Update(ctx, route); err != nil {
+		return fmt.Errorf("failed to update Route: %w", classifyImmutableFieldError(err))
+	}
+	log.V(1).Info("Updated Route", "name", route.GetName())
+	return nil
+}
+
+// HandleDisabledRoute deletes the Route of the instance when exposure is disabled or removed from the spec.
+// Only a Route owned by the instance is deleted, so a user-managed Route with the same name is left alone.
+func HandleDisabledRoute(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, log logr.Logger) error {
+	existing := NewRoute()
+	key := client.ObjectKey{Name: GetRouteName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check Route existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping deletion of Route not owned by this instance", "name", existing.GetName())
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Route: %w", err)
+	}
+	log.Info("Deleted Route", "name", existing.GetName())
+	return nil
+}
@@ -0,0 +1,70 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyServiceAccount creates or updates a ServiceAccount. A ServiceAccount with the same name that is not owned
+// by the instance is left untouched. Only the labels and annotations of the ServiceAccount are managed: the
+// Secrets and image pull Secrets added to it by the cluster are kept, and it is only updated when they change.
+func ApplyServiceAccount(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, sa *corev1.ServiceAccount, log logr.Logger) error {
+	if err := SetOwnerReference(instance, sa, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, sa)
+
+	existing := &corev1.ServiceAccount{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(sa), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, sa); err != nil {
+				return fmt.Errorf("failed to create ServiceAccount: %w", err)
+			}
+			log.Info("Created ServiceAccount", "name", sa.Name)
+			return nil
+		}
+		return fmt.Errorf("failed to get ServiceAccount: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply ServiceAccount %s: %w", sa.Name, ErrNotOwned)
+	}
+
+	updated := existing.DeepCopy()
+	updated.Labels = mergeStringMaps(existing.Labels, sa.Labels)
+	updated.Annotations = mergeStringMaps(existing.Annotations, sa.Annotations)
+	if err := SetOwnerReference(instance, updated, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	if reflect.DeepEqual(updated, existing) {
+		return nil
+	}
+
+	if err := c.Update(ctx, updated); err != nil {
+		return fmt.Errorf("failed to update ServiceAccount: %w", err)
+	}
+	log.V(1).Info("Updated ServiceAccount", "name", sa.Name)
+	return nil
+}
+
+// mergeStringMaps returns a copy of existing with the entries of desired set.
+func mergeStringMaps(existing, desired map[string]string) map[string]string {
+	if len(desired) == 0 {
+		return existing
+	}
+	merged := make(map[string]string, len(existing)+len(desired))
+	maps.Copy(merged, existing)
+	maps.Copy(merged, desired)
+	return merged
+}
@@ -0,0 +1,84 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ServiceMonitorGVK is the GroupVersionKind of the Prometheus Operator ServiceMonitor. ServiceMonitors are handled
+// as unstructured objects so that the operator does not depend on the Prometheus Operator API types.
+var ServiceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+
+// NewServiceMonitor returns an empty ServiceMonitor object with its GroupVersionKind set.
+func NewServiceMonitor() *unstructured.Unstructured {
+	serviceMonitor := &unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(ServiceMonitorGVK)
+	return serviceMonitor
+}
+
+// ApplyServiceMonitor creates or updates a ServiceMonitor. A ServiceMonitor with the same name that is not owned by
+// the instance is left untouched.
+func ApplyServiceMonitor(ctx context.Context, c client.Client, scheme *runtime.Scheme, ownerRefPolicy OwnerReferencePolicy,
+	instance *llamav1alpha1.LlamaStackDistribution, serviceMonitor *unstructured.Unstructured, log logr.Logger) error {
+	if err := SetOwnerReference(instance, serviceMonitor, scheme, ownerRefPolicy); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	SetCommonMetadata(instance, serviceMonitor)
+
+	existing := NewServiceMonitor()
+	err := c.Get(ctx, client.ObjectKeyFromObject(serviceMonitor), existing)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err = c.Create(ctx, serviceMonitor); err != nil {
+				return fmt.Errorf("failed to create ServiceMonitor: %w", err)
+			}
+			log.Info("Created ServiceMonitor", "name", serviceMonitor.GetName())
+			return nil
+		}
+		return fmt.Errorf("failed to get ServiceMonitor: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		return fmt.Errorf("failed to apply ServiceMonitor %s: %w", serviceMonitor.GetName(), ErrNotOwned)
+	}
+
+	serviceMonitor.SetResourceVersion(existing.GetResourceVersion())
+	if err := c.Update(ctx, serviceMonitor); err != nil {
+		return fmt.Errorf("failed to update ServiceMonitor: %w", classifyImmutableFieldError(err))
+	}
+	log.V(1).Info("Updated ServiceMonitor", "name", serviceMonitor.GetName())
+	return nil
+}
+
+// HandleDisabledServiceMonitor deletes the ServiceMonitor of the instance when metrics are disabled or removed from
+// the spec. Only a ServiceMonitor owned by the instance is deleted, so a user-managed ServiceMonitor with the same
+// name is left alone.
+func HandleDisabledServiceMonitor(ctx context.Context, c client.Client, instance *llamav1alpha1.LlamaStackDistribution, log logr.Logger) error {
+	existing := NewServiceMonitor()
+	key := client.ObjectKey{Name: GetServiceMonitorName(instance), Namespace: instance.Namespace}
+	if err := c.Get(ctx, key, existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check ServiceMonitor existence: %w", err)
+	}
+
+	if !isOwnedBy(existing, instance) {
+		log.V(1).Info("Skipping deletion of ServiceMonitor not owned by this instance", "name", existing.GetName())
+		return nil
+	}
+
+	if err := c.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ServiceMonitor: %w", err)
+	}
+	log.Info("Deleted ServiceMonitor", "name", existing.GetName())
+	return nil
+}
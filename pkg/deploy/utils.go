@@ -3,10 +3,28 @@ package deploy
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+const (
+	// healthServicePortName is the name of the Service port exposing a health port distinct from the server port.
+	healthServicePortName = "health"
+	// metricsServicePortName is the name of the Service port exposing a metrics port distinct from the server and
+	// health ports.
+	metricsServicePortName = "metrics"
+	// managedByLabelKey is the label identifying the tool managing a resource.
+	managedByLabelKey = "app.kubernetes.io/managed-by"
+	// managedByLabelValue is the value of managedByLabelKey on the resources rendered by the operator.
+	managedByLabelValue = "llama-stack-operator"
+)
+
+// ReservedServicePortNames are the names of the Service ports managed by the operator, which extra ports may not use.
+var ReservedServicePortNames = []string{llamav1alpha1.DefaultServicePortName, healthServicePortName, metricsServicePortName}
+
 func GetOperatorNamespace() (string, error) {
 	operatorNS, exist := os.LookupEnv("OPERATOR_NAMESPACE")
 	if exist && operatorNS != "" {
@@ -25,6 +43,155 @@ func GetServicePort(instance *llamav1alpha1.LlamaStackDistribution) int32 {
 	return port
 }
 
+// GetServiceAccountName returns the name of the ServiceAccount the operator creates for the server pods of the instance.
+func GetServiceAccountName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-sa", instance.Name)
+}
+
 func GetServiceName(instance *llamav1alpha1.LlamaStackDistribution) string {
 	return fmt.Sprintf("%s-service", instance.Name)
 }
+
+func GetPVCName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-pvc", instance.Name)
+}
+
+// GetStorageSize returns the size requested for the PVC of the instance, falling back to the default size.
+func GetStorageSize(instance *llamav1alpha1.LlamaStackDistribution) resource.Quantity {
+	if storage := instance.Spec.Server.Storage; storage != nil && storage.Size != nil {
+		return storage.Size.DeepCopy()
+	}
+	return llamav1alpha1.DefaultStorageSize.DeepCopy()
+}
+
+// GetSelectorLabels returns the labels selecting the server pods of the instance.
+func GetSelectorLabels(instance *llamav1alpha1.LlamaStackDistribution) map[string]string {
+	return map[string]string{
+		llamav1alpha1.DefaultLabelKey: llamav1alpha1.DefaultLabelValue,
+		"app.kubernetes.io/instance":  instance.Name,
+	}
+}
+
+// GetClusterRoleBindingName returns the name of the ClusterRoleBinding rendered from the manifests for the instance.
+func GetClusterRoleBindingName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-crb", instance.Name)
+}
+
+func GetMaintenanceCronJobName(instance *llamav1alpha1.LlamaStackDistribution, jobName string) string {
+	return fmt.Sprintf("%s-%s", instance.Name, jobName)
+}
+
+func GetIngressName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-ingress", instance.Name)
+}
+
+func GetHorizontalPodAutoscalerName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-hpa", instance.Name)
+}
+
+// GetPodDisruptionBudgetName returns the name of the PodDisruptionBudget of the server pods of the instance.
+func GetPodDisruptionBudgetName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-pdb", instance.Name)
+}
+
+// GetRouteName returns the name of the OpenShift Route of the instance. The router derives the default host
+// from it, so it is not suffixed like the other generated resources.
+func GetRouteName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return instance.Name
+}
+
+// GetServiceMonitorName returns the name of the ServiceMonitor scraping the server metrics of the instance.
+func GetServiceMonitorName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-service-monitor", instance.Name)
+}
+
+// GetCABundleConfigMapName returns the name of the ConfigMap holding the CA bundle concatenated by the operator.
+func GetCABundleConfigMapName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-ca-bundle", instance.Name)
+}
+
+func GetDashboardConfigMapName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	return fmt.Sprintf("%s-grafana-dashboard", instance.Name)
+}
+
+// GetHealthCheckPath returns the path of the server health endpoint.
+func GetHealthCheckPath(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if hc := instance.Spec.Server.HealthCheck; hc != nil && hc.Path != "" {
+		return hc.Path
+	}
+	return llamav1alpha1.DefaultHealthCheckPath
+}
+
+// GetHealthCheckPort returns the port serving the server health endpoint.
+func GetHealthCheckPort(instance *llamav1alpha1.LlamaStackDistribution) int32 {
+	if hc := instance.Spec.Server.HealthCheck; hc != nil && hc.Port != 0 {
+		return hc.Port
+	}
+	return GetServicePort(instance)
+}
+
+// GetMetricsPort returns the container port serving the server metrics.
+func GetMetricsPort(instance *llamav1alpha1.LlamaStackDistribution) int32 {
+	if monitoring := instance.Spec.Server.Monitoring; monitoring != nil && monitoring.Metrics != nil && monitoring.Metrics.Port != 0 {
+		return monitoring.Metrics.Port
+	}
+	return GetServicePort(instance)
+}
+
+// GetMetricsPath returns the HTTP path of the server metrics.
+func GetMetricsPath(instance *llamav1alpha1.LlamaStackDistribution) string {
+	if monitoring := instance.Spec.Server.Monitoring; monitoring != nil && monitoring.Metrics != nil && monitoring.Metrics.Path != "" {
+		return monitoring.Metrics.Path
+	}
+	return llamav1alpha1.DefaultMetricsPath
+}
+
+// GetMetricsServicePortName returns the name of the Service port exposing the server metrics. The metrics get a
+// port of their own unless they are served on the server or health port.
+func GetMetricsServicePortName(instance *llamav1alpha1.LlamaStackDistribution) string {
+	switch GetMetricsPort(instance) {
+	case GetServicePort(instance):
+		return llamav1alpha1.DefaultServicePortName
+	case GetHealthCheckPort(instance):
+		return healthServicePortName
+	default:
+		return metricsServicePortName
+	}
+}
+
+// GetExtraPorts returns the additional ports of the server container with their stable names and protocols.
+func GetExtraPorts(instance *llamav1alpha1.LlamaStackDistribution) []corev1.ContainerPort {
+	if len(instance.Spec.Server.ContainerSpec.ExtraPorts) == 0 {
+		return nil
+	}
+	ports := make([]corev1.ContainerPort, 0, len(instance.Spec.Server.ContainerSpec.ExtraPorts))
+	for _, port := range instance.Spec.Server.ContainerSpec.ExtraPorts {
+		port.Name = GetExtraPortName(port)
+		if port.Protocol == "" {
+			port.Protocol = corev1.ProtocolTCP
+		}
+		ports = append(ports, port)
+	}
+	return ports
+}
+
+// GetExtraPortName returns the name of an additional port, used for both the container and the Service port.
+// Unnamed ports are named port-<number>, followed by the protocol unless it is TCP.
+func GetExtraPortName(port corev1.ContainerPort) string {
+	if port.Name != "" {
+		return port.Name
+	}
+	name := fmt.Sprintf("port-%d", port.ContainerPort)
+	if port.Protocol != "" && port.Protocol != corev1.ProtocolTCP {
+		name += "-" + strings.ToLower(string(port.Protocol))
+	}
+	return name
+}
+
+// GetHealthCheckTimeoutSeconds returns the timeout of a single health check.
+func GetHealthCheckTimeoutSeconds(instance *llamav1alpha1.LlamaStackDistribution) int32 {
+	if hc := instance.Spec.Server.HealthCheck; hc != nil && hc.TimeoutSeconds != 0 {
+		return hc.TimeoutSeconds
+	}
+	return llamav1alpha1.DefaultHealthCheckTimeoutSeconds
+}
@@ -4,11 +4,32 @@ type FeatureFlag struct {
 	Enabled bool `yaml:"enabled"`
 }
 
+// EnabledOr returns whether the flag is enabled, or defaultValue if the flag is not set.
+func (f *FeatureFlag) EnabledOr(defaultValue bool) bool {
+	if f == nil {
+		return defaultValue
+	}
+	return f.Enabled
+}
+
 // FeatureFlags represents the configuration for feature flags in the operator.
 // Add more feature flags later.
 type FeatureFlags struct {
 	// EnableNetworkPolicy controls whether NetworkPolicy resources should be created.
 	EnableNetworkPolicy FeatureFlag `yaml:"enableNetworkPolicy"`
+	// EnableQuotaPreflight controls whether the Deployment is checked against the namespace ResourceQuotas.
+	EnableQuotaPreflight FeatureFlag `yaml:"enableQuotaPreflight"`
+	// EnableRolloutSerialization controls whether at most one instance per namespace rolls out a new pod template at a time.
+	EnableRolloutSerialization FeatureFlag `yaml:"enableRolloutSerialization"`
+	// EnableSecurityContextDefaults controls whether the server container gets a restricted security context
+	// when the spec does not set one.
+	EnableSecurityContextDefaults FeatureFlag `yaml:"enableSecurityContextDefaults"`
+	// EnableDefaultTopologySpread controls whether the pods of a multi-replica server are spread across zones
+	// when the spec does not set topology spread constraints.
+	EnableDefaultTopologySpread FeatureFlag `yaml:"enableDefaultTopologySpread"`
+	// ValidateConfigMapContent controls whether every YAML entry of the user ConfigMap must parse
+	// before the server is deployed. It is enabled by default, so it is only disabled when explicitly set.
+	ValidateConfigMapContent *FeatureFlag `yaml:"validateConfigMapContent,omitempty"`
 }
 
 const (
@@ -18,4 +39,24 @@ const (
 	EnableNetworkPolicyKey = "enableNetworkPolicy"
 	// NetworkPolicyDefaultValue is the default value for the network policy feature flag.
 	NetworkPolicyDefaultValue = false
+	// EnableQuotaPreflightKey is the key for the ResourceQuota pre-flight feature flag.
+	EnableQuotaPreflightKey = "enableQuotaPreflight"
+	// QuotaPreflightDefaultValue is the default value for the ResourceQuota pre-flight feature flag.
+	QuotaPreflightDefaultValue = false
+	// EnableRolloutSerializationKey is the key for the rollout serialization feature flag.
+	EnableRolloutSerializationKey = "enableRolloutSerialization"
+	// RolloutSerializationDefaultValue is the default value for the rollout serialization feature flag.
+	RolloutSerializationDefaultValue = false
+	// EnableSecurityContextDefaultsKey is the key for the security context defaults feature flag.
+	EnableSecurityContextDefaultsKey = "enableSecurityContextDefaults"
+	// SecurityContextDefaultsDefaultValue is the default value for the security context defaults feature flag.
+	SecurityContextDefaultsDefaultValue = false
+	// EnableDefaultTopologySpreadKey is the key for the default topology spread feature flag.
+	EnableDefaultTopologySpreadKey = "enableDefaultTopologySpread"
+	// DefaultTopologySpreadDefaultValue is the default value for the default topology spread feature flag.
+	DefaultTopologySpreadDefaultValue = false
+	// ValidateConfigMapContentKey is the key for the user ConfigMap content validation feature flag.
+	ValidateConfigMapContentKey = "validateConfigMapContent"
+	// ValidateConfigMapContentDefaultValue is the default value for the user ConfigMap content validation feature flag.
+	ValidateConfigMapContentDefaultValue = true
 )
@@ -0,0 +1,85 @@
+// Package metrics defines the Prometheus metrics of the reconciler. They are served by the metrics endpoint of
+// the manager once registered with the controller-runtime metrics registry.
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// ResultSuccess labels a reconciliation or health check that succeeded.
+	ResultSuccess = "success"
+	// ResultRequeue labels a reconciliation that succeeded but is scheduled to run again.
+	ResultRequeue = "requeue"
+	// ResultError labels a reconciliation or health check that failed.
+	ResultError = "error"
+	// ResultSkipped labels a health check that was not performed because the endpoint is considered unreachable.
+	ResultSkipped = "skipped"
+)
+
+var (
+	// ReconcileTotal counts the reconciliations of the LlamaStackDistributions by result.
+	ReconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "llamastack_reconcile_total",
+		Help: "Number of reconciliations of LlamaStackDistributions, by result.",
+	}, []string{"result"})
+
+	// ReconcileDuration observes how long the reconciliations of the LlamaStackDistributions take.
+	ReconcileDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "llamastack_reconcile_duration_seconds",
+		Help:    "Duration of the reconciliations of LlamaStackDistributions in seconds.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// ReconcileErrorsTotal counts the failed reconciliations of the LlamaStackDistributions by condition reason.
+	ReconcileErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "llamastack_reconcile_errors_total",
+		Help: "Number of failed reconciliations of LlamaStackDistributions, by reason.",
+	}, []string{"reason"})
+
+	// HealthCheckTotal counts the health checks of the llama-stack servers by result.
+	HealthCheckTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "llamastack_health_check_total",
+		Help: "Number of health checks of llama-stack servers, by result.",
+	}, []string{"result"})
+
+	// ConfigMapWatchEventsTotal counts the ConfigMap events received by the watch of the reconciler.
+	ConfigMapWatchEventsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "llamastack_configmap_watch_events_total",
+		Help: "Number of ConfigMap create, update and delete events received from the watched namespaces.",
+	})
+
+	registerOnce sync.Once
+)
+
+// Register registers the metrics with the controller-runtime metrics registry. It is safe to call more than once.
+func Register() {
+	registerOnce.Do(func() {
+		ctrlmetrics.Registry.MustRegister(ReconcileTotal, ReconcileDuration, ReconcileErrorsTotal, HealthCheckTotal, ConfigMapWatchEventsTotal)
+	})
+}
+
+// RecordReconcile records a reconciliation with its result and duration.
+func RecordReconcile(result string, duration time.Duration) {
+	ReconcileTotal.WithLabelValues(result).Inc()
+	ReconcileDuration.Observe(duration.Seconds())
+}
+
+// RecordReconcileError records a failed reconciliation with the reason reported in the conditions of the instance.
+func RecordReconcileError(reason string) {
+	ReconcileErrorsTotal.WithLabelValues(reason).Inc()
+}
+
+// RecordHealthCheck records a health check with its result.
+func RecordHealthCheck(result string) {
+	HealthCheckTotal.WithLabelValues(result).Inc()
+}
+
+// RecordConfigMapWatchEvent records a ConfigMap event received by the watch.
+func RecordConfigMapWatchEvent() {
+	ConfigMapWatchEventsTotal.Inc()
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestRegister(t *testing.T) {
+	Register()
+	Register()
+
+	assert.True(t, ctrlmetrics.Registry.Unregister(ConfigMapWatchEventsTotal), "metrics should be registered once")
+	require.NoError(t, ctrlmetrics.Registry.Register(ConfigMapWatchEventsTotal))
+}
+
+func TestRecord(t *testing.T) {
+	reconciles := func(result string) float64 {
+		return testutil.ToFloat64(ReconcileTotal.WithLabelValues(result))
+	}
+	healthChecks := func(result string) float64 {
+		return testutil.ToFloat64(HealthCheckTotal.WithLabelValues(result))
+	}
+	observations := func() uint64 {
+		m := &dto.Metric{}
+		require.NoError(t, ReconcileDuration.Write(m))
+		return m.GetHistogram().GetSampleCount()
+	}
+	successes, failures := reconciles(ResultSuccess), reconciles(ResultError)
+	observed := observations()
+	failedHealthChecks, skippedHealthChecks := healthChecks(ResultError), healthChecks(ResultSkipped)
+	watchEvents := testutil.ToFloat64(ConfigMapWatchEventsTotal)
+	forbiddenErrors := testutil.ToFloat64(ReconcileErrorsTotal.WithLabelValues("DeploymentForbidden"))
+
+	// --- act ---
+	RecordReconcile(ResultSuccess, 2*time.Second)
+	RecordReconcile(ResultSuccess, time.Second)
+	RecordReconcile(ResultError, time.Second)
+	RecordHealthCheck(ResultError)
+	RecordConfigMapWatchEvent()
+	RecordConfigMapWatchEvent()
+	RecordReconcileError("DeploymentForbidden")
+
+	// --- assert ---
+	assert.InDelta(t, successes+2, reconciles(ResultSuccess), 0)
+	assert.InDelta(t, failures+1, reconciles(ResultError), 0)
+	assert.Equal(t, observed+3, observations())
+	assert.InDelta(t, failedHealthChecks+1, healthChecks(ResultError), 0)
+	assert.InDelta(t, skippedHealthChecks, healthChecks(ResultSkipped), 0)
+	assert.InDelta(t, watchEvents+2, testutil.ToFloat64(ConfigMapWatchEventsTotal), 0)
+	assert.InDelta(t, forbiddenErrors+1, testutil.ToFloat64(ReconcileErrorsTotal.WithLabelValues("DeploymentForbidden")), 0)
+}
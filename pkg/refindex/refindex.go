@@ -0,0 +1,200 @@
+// Package refindex owns the field indexes of the LlamaStackDistributions on the objects they reference.
+// Both the index functions and the lookups of the event handlers compute their keys here, so that a
+// lookup always uses the index name and key format the index was registered with.
+package refindex
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// UserConfigMapIndex indexes the LlamaStackDistributions by their user config ConfigMap.
+	UserConfigMapIndex = "spec.server.userConfig.configMapName"
+	// AdditionalConfigMapIndex indexes the LlamaStackDistributions by the ConfigMaps mounted next to their run configuration.
+	AdditionalConfigMapIndex = "spec.server.userConfig.additionalConfigMaps"
+	// MountedUserConfigMapIndex indexes the LlamaStackDistributions by the ConfigMaps of UserConfigs mounted at their own path.
+	MountedUserConfigMapIndex = "spec.server.userConfigs.configMapName"
+	// CABundleConfigMapIndex indexes the LlamaStackDistributions by their CA bundle ConfigMap.
+	CABundleConfigMapIndex = "spec.server.tlsConfig.caBundle.configMapName"
+	// UserSecretIndex indexes the LlamaStackDistributions by the Secret whose changes restart their pods.
+	UserSecretIndex = "spec.server.secretRef.secretName"
+	// ServerCertSecretIndex indexes the LlamaStackDistributions by the Secret holding their serving certificate.
+	ServerCertSecretIndex = "spec.server.tlsConfig.serverCert.secretName"
+	// EnvSecretIndex indexes the LlamaStackDistributions by the Secrets their environment variables read from.
+	EnvSecretIndex = "spec.server.containerSpec.env.valueFrom.secretKeyRef.name"
+)
+
+// Index is a field index of the LlamaStackDistributions on a kind of referenced object.
+type Index struct {
+	// Name is the field the index is registered on.
+	Name string
+	// Keys returns the keys of the objects referenced by a LlamaStackDistribution.
+	Keys func(llsd *llamav1alpha1.LlamaStackDistribution) []string
+}
+
+// ConfigMapIndexes are the indexes on the ConfigMaps referenced by the LlamaStackDistributions.
+var ConfigMapIndexes = []Index{
+	{Name: UserConfigMapIndex, Keys: UserConfigMapKeys},
+	{Name: AdditionalConfigMapIndex, Keys: AdditionalConfigMapKeys},
+	{Name: MountedUserConfigMapIndex, Keys: MountedUserConfigMapKeys},
+	{Name: CABundleConfigMapIndex, Keys: CABundleConfigMapKeys},
+}
+
+// SecretIndexes are the indexes on the Secrets referenced by the LlamaStackDistributions.
+var SecretIndexes = []Index{
+	{Name: UserSecretIndex, Keys: UserSecretKeys},
+	{Name: ServerCertSecretIndex, Keys: ServerCertSecretKeys},
+	{Name: EnvSecretIndex, Keys: EnvSecretKeys},
+}
+
+// WatchedSecretIndexes are the indexes on the Secrets whose changes restart the pods of the LlamaStackDistributions.
+var WatchedSecretIndexes = []Index{
+	{Name: UserSecretIndex, Keys: UserSecretKeys},
+	{Name: ServerCertSecretIndex, Keys: ServerCertSecretKeys},
+}
+
+// Key returns the index key of the object with the given namespace and name.
+func Key(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// KeyForObject returns the index key to look up the LlamaStackDistributions referencing the object.
+func KeyForObject(obj client.Object) string {
+	return Key(obj.GetNamespace(), obj.GetName())
+}
+
+// UserConfigMapKeys returns the key of the user config ConfigMap of the LlamaStackDistribution, if any.
+func UserConfigMapKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	userConfig := llsd.RunUserConfig()
+	if userConfig == nil {
+		return nil
+	}
+	return []string{Key(namespaceOr(userConfig.ConfigMapNamespace, llsd.Namespace), userConfig.ConfigMapName)}
+}
+
+// AdditionalConfigMapKeys returns the keys of the ConfigMaps mounted next to the run configuration of the
+// LlamaStackDistribution, without duplicates. They are read from the namespace of the user config ConfigMap.
+func AdditionalConfigMapKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	userConfig := llsd.RunUserConfig()
+	if userConfig == nil {
+		return nil
+	}
+	var keys []string
+	for _, name := range userConfig.AdditionalConfigMaps {
+		key := Key(namespaceOr(userConfig.ConfigMapNamespace, llsd.Namespace), name)
+		if name != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// MountedUserConfigMapKeys returns the keys of the ConfigMaps of UserConfigs mounted at their own path in the
+// LlamaStackDistribution, without duplicates.
+func MountedUserConfigMapKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	var keys []string
+	for _, userConfig := range llsd.MountedUserConfigs() {
+		key := Key(namespaceOr(userConfig.ConfigMapNamespace, llsd.Namespace), userConfig.ConfigMapName)
+		if userConfig.ConfigMapName != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// CABundleConfigMapKeys returns the key of the CA bundle ConfigMap of the LlamaStackDistribution, if any.
+func CABundleConfigMapKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	tlsConfig := llsd.Spec.Server.TLSConfig
+	if tlsConfig == nil || tlsConfig.CABundle == nil || tlsConfig.CABundle.ConfigMapName == "" {
+		return nil
+	}
+	return []string{Key(namespaceOr(tlsConfig.CABundle.ConfigMapNamespace, llsd.Namespace), tlsConfig.CABundle.ConfigMapName)}
+}
+
+// UserSecretKeys returns the key of the Secret whose changes restart the pods of the LlamaStackDistribution, if any.
+// The Secret is always read from the namespace of the instance.
+func UserSecretKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	if llsd.Spec.Server.SecretRef == nil || llsd.Spec.Server.SecretRef.SecretName == "" {
+		return nil
+	}
+	return []string{Key(llsd.Namespace, llsd.Spec.Server.SecretRef.SecretName)}
+}
+
+// ServerCertSecretKeys returns the key of the Secret holding the serving certificate of the LlamaStackDistribution,
+// if any. The Secret is always read from the namespace of the instance.
+func ServerCertSecretKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	tlsConfig := llsd.Spec.Server.TLSConfig
+	if tlsConfig == nil || tlsConfig.ServerCert == nil || tlsConfig.ServerCert.SecretName == "" {
+		return nil
+	}
+	return []string{Key(llsd.Namespace, tlsConfig.ServerCert.SecretName)}
+}
+
+// WatchedSecretKeys returns the keys of the Secrets whose changes restart the pods of the LlamaStackDistribution.
+func WatchedSecretKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	var keys []string
+	for _, index := range WatchedSecretIndexes {
+		keys = append(keys, index.Keys(llsd)...)
+	}
+	return keys
+}
+
+// EnvSecretKeys returns the keys of the Secrets the environment variables of the LlamaStackDistribution
+// read from, without duplicates. Secrets are always read from the namespace of the instance.
+func EnvSecretKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, env := range llsd.Spec.Server.ContainerSpec.Env {
+		if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil || env.ValueFrom.SecretKeyRef.Name == "" {
+			continue
+		}
+		key := Key(llsd.Namespace, env.ValueFrom.SecretKeyRef.Name)
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// ConfigMapKeys returns the keys of all the ConfigMaps referenced by the LlamaStackDistribution.
+func ConfigMapKeys(llsd *llamav1alpha1.LlamaStackDistribution) []string {
+	var keys []string
+	for _, index := range ConfigMapIndexes {
+		keys = append(keys, index.Keys(llsd)...)
+	}
+	return keys
+}
+
+// Register registers all the indexes on the LlamaStackDistributions with the indexer. It stops at the first
+// index that cannot be registered, the callers are then expected to fall back to listing all instances.
+func Register(ctx context.Context, indexer client.FieldIndexer) error {
+	for _, index := range append(append([]Index{}, ConfigMapIndexes...), SecretIndexes...) {
+		if err := indexer.IndexField(ctx, &llamav1alpha1.LlamaStackDistribution{}, index.Name, index.extract); err != nil {
+			return fmt.Errorf("failed to register field index %s: %w", index.Name, err)
+		}
+	}
+	return nil
+}
+
+// extract is the index function of the index.
+func (i Index) extract(obj client.Object) []string {
+	llsd, ok := obj.(*llamav1alpha1.LlamaStackDistribution)
+	if !ok {
+		return nil
+	}
+	return i.Keys(llsd)
+}
+
+// namespaceOr returns the namespace, or the fallback namespace when it is empty.
+func namespaceOr(namespace, fallback string) string {
+	if namespace != "" {
+		return namespace
+	}
+	return fallback
+}
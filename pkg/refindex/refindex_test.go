@@ -0,0 +1,269 @@
+package refindex
+
+import (
+	"context"
+	"testing"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// builderIndexer registers the indexes with a fake client builder.
+type builderIndexer struct {
+	builder *fake.ClientBuilder
+}
+
+func (b builderIndexer) IndexField(_ context.Context, obj client.Object, field string, extract client.IndexerFunc) error {
+	b.builder.WithIndex(obj, field, extract)
+	return nil
+}
+
+func secretEnv(name, secretName string) corev1.EnvVar {
+	return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{
+		SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secretName}, Key: "value"},
+	}}
+}
+
+func newFixture() *llamav1alpha1.LlamaStackDistribution {
+	return &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "team"},
+		Spec: llamav1alpha1.LlamaStackDistributionSpec{
+			Server: llamav1alpha1.ServerSpec{
+				UserConfig: &llamav1alpha1.UserConfigSpec{
+					ConfigMapName:        "run-config",
+					AdditionalConfigMaps: []string{"provider-files", "prompts", "provider-files"},
+				},
+				UserConfigs: []llamav1alpha1.UserConfigSpec{
+					{ConfigMapName: "models", MountPath: "/etc/models"},
+					{ConfigMapName: "prompts", ConfigMapNamespace: "configs", MountPath: "/etc/prompts"},
+					{ConfigMapName: "models", MountPath: "/etc/models-copy"},
+				},
+				SecretRef: &llamav1alpha1.SecretRefSpec{SecretName: "provider-credentials"},
+				TLSConfig: &llamav1alpha1.TLSConfig{
+					CABundle:   &llamav1alpha1.CABundleConfig{ConfigMapName: "ca-bundle", ConfigMapNamespace: "shared"},
+					ServerCert: &llamav1alpha1.ServerCertConfig{SecretName: "serving-cert"},
+				},
+				ContainerSpec: llamav1alpha1.ContainerSpec{
+					Env: []corev1.EnvVar{
+						{Name: "INFERENCE_MODEL", Value: "llama3"},
+						secretEnv("API_KEY", "provider-credentials"),
+						secretEnv("API_URL", "provider-credentials"),
+						secretEnv("DB_PASSWORD", "db-credentials"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestKeys(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mutate   func(llsd *llamav1alpha1.LlamaStackDistribution)
+		keys     func(llsd *llamav1alpha1.LlamaStackDistribution) []string
+		expected []string
+	}{
+		{
+			name:     "user config defaults to the instance namespace",
+			keys:     UserConfigMapKeys,
+			expected: []string{"team/run-config"},
+		},
+		{
+			name: "user config in another namespace",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) {
+				llsd.Spec.Server.UserConfig.ConfigMapNamespace = "configs"
+			},
+			keys:     UserConfigMapKeys,
+			expected: []string{"configs/run-config"},
+		},
+		{
+			name: "no user config",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) {
+				llsd.Spec.Server.UserConfig = nil
+				llsd.Spec.Server.UserConfigs = nil
+			},
+			keys: UserConfigMapKeys,
+		},
+		{
+			name:     "additional ConfigMaps without duplicates",
+			keys:     AdditionalConfigMapKeys,
+			expected: []string{"team/provider-files", "team/prompts"},
+		},
+		{
+			name: "additional ConfigMaps in the namespace of the user config",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) {
+				llsd.Spec.Server.UserConfig.ConfigMapNamespace = "configs"
+			},
+			keys:     AdditionalConfigMapKeys,
+			expected: []string{"configs/provider-files", "configs/prompts"},
+		},
+		{
+			name: "run configuration in the first entry of userConfigs",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) {
+				llsd.Spec.Server.UserConfigs = []llamav1alpha1.UserConfigSpec{*llsd.Spec.Server.UserConfig}
+				llsd.Spec.Server.UserConfig = nil
+			},
+			keys:     UserConfigMapKeys,
+			expected: []string{"team/run-config"},
+		},
+		{
+			name:     "mounted user configs without duplicates",
+			keys:     MountedUserConfigMapKeys,
+			expected: []string{"team/models", "configs/prompts"},
+		},
+		{
+			name: "mounted user configs skip the run configuration entry",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) {
+				llsd.Spec.Server.UserConfig = nil
+			},
+			keys:     MountedUserConfigMapKeys,
+			expected: []string{"configs/prompts", "team/models"},
+		},
+		{
+			name:     "CA bundle in another namespace",
+			keys:     CABundleConfigMapKeys,
+			expected: []string{"shared/ca-bundle"},
+		},
+		{
+			name:   "TLS config without CA bundle",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) { llsd.Spec.Server.TLSConfig.CABundle = nil },
+			keys:   CABundleConfigMapKeys,
+		},
+		{
+			name:     "user Secret",
+			keys:     UserSecretKeys,
+			expected: []string{"team/provider-credentials"},
+		},
+		{
+			name:   "no user Secret",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) { llsd.Spec.Server.SecretRef = nil },
+			keys:   UserSecretKeys,
+		},
+		{
+			name:     "server certificate Secret",
+			keys:     ServerCertSecretKeys,
+			expected: []string{"team/serving-cert"},
+		},
+		{
+			name:   "TLS config without server certificate",
+			mutate: func(llsd *llamav1alpha1.LlamaStackDistribution) { llsd.Spec.Server.TLSConfig.ServerCert = nil },
+			keys:   ServerCertSecretKeys,
+		},
+		{
+			name:     "watched Secrets",
+			keys:     WatchedSecretKeys,
+			expected: []string{"team/provider-credentials", "team/serving-cert"},
+		},
+		{
+			name:     "env Secrets without duplicates",
+			keys:     EnvSecretKeys,
+			expected: []string{"team/provider-credentials", "team/db-credentials"},
+		},
+		{
+			name: "all ConfigMaps",
+			keys: ConfigMapKeys,
+			expected: []string{
+				"team/run-config", "team/provider-files", "team/prompts", "team/models", "configs/prompts", "shared/ca-bundle",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			llsd := newFixture()
+			if tc.mutate != nil {
+				tc.mutate(llsd)
+			}
+			assert.Equal(t, tc.expected, tc.keys(llsd))
+		})
+	}
+}
+
+func TestRegisterLookupSymmetry(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, llamav1alpha1.AddToScheme(testScheme))
+
+	referencing := newFixture()
+	other := newFixture()
+	other.Name = "other-instance"
+	other.Namespace = "other-team"
+	other.Spec.Server.TLSConfig = nil
+
+	builder := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(referencing, other)
+	require.NoError(t, Register(t.Context(), builderIndexer{builder: builder}))
+	cli := builder.Build()
+
+	testCases := []struct {
+		name     string
+		index    string
+		object   client.Object
+		expected []string
+	}{
+		{
+			name:     "user config ConfigMap",
+			index:    UserConfigMapIndex,
+			object:   &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "run-config", Namespace: "team"}},
+			expected: []string{"test-instance"},
+		},
+		{
+			name:   "ConfigMap with the same name in another namespace",
+			index:  UserConfigMapIndex,
+			object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "run-config", Namespace: "shared"}},
+		},
+		{
+			name:     "additional ConfigMap",
+			index:    AdditionalConfigMapIndex,
+			object:   &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "prompts", Namespace: "team"}},
+			expected: []string{"test-instance"},
+		},
+		{
+			name:     "mounted user config ConfigMap",
+			index:    MountedUserConfigMapIndex,
+			object:   &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "models", Namespace: "team"}},
+			expected: []string{"test-instance"},
+		},
+		{
+			name:     "CA bundle ConfigMap",
+			index:    CABundleConfigMapIndex,
+			object:   &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle", Namespace: "shared"}},
+			expected: []string{"test-instance"},
+		},
+		{
+			name:     "user Secret",
+			index:    UserSecretIndex,
+			object:   &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "provider-credentials", Namespace: "team"}},
+			expected: []string{"test-instance"},
+		},
+		{
+			name:     "server certificate Secret",
+			index:    ServerCertSecretIndex,
+			object:   &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "serving-cert", Namespace: "team"}},
+			expected: []string{"test-instance"},
+		},
+		{
+			name:     "env Secret",
+			index:    EnvSecretIndex,
+			object:   &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "other-team"}},
+			expected: []string{"other-instance"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list := &llamav1alpha1.LlamaStackDistributionList{}
+			require.NoError(t, cli.List(t.Context(), list, client.MatchingFields{tc.index: KeyForObject(tc.object)}))
+
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package statusexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConfigKey is the key used in the operator ConfigMap to configure the status export.
+	ConfigKey = "statusExport"
+	// DefaultTimeout bounds a single publish so an unreachable sink cannot stall a reconciliation.
+	DefaultTimeout = 5 * time.Second
+	// EventType identifies the snapshots published by the operator.
+	EventType = "llamastack.io/status-snapshot"
+)
+
+// Config configures the sink status snapshots are published to.
+type Config struct {
+	// URL is the HTTP(S) endpoint receiving the snapshots as JSON POST requests.
+	URL string `yaml:"url"`
+	// Timeout bounds a single publish. Defaults to DefaultTimeout.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// Snapshot is the structured status of a LlamaStackDistribution published to external consumers.
+type Snapshot struct {
+	Type       string                            `json:"type"`
+	Timestamp  metav1.Time                       `json:"timestamp"`
+	Name       string                            `json:"name"`
+	Namespace  string                            `json:"namespace"`
+	UID        string                            `json:"uid"`
+	Generation int64                             `json:"generation"`
+	Phase      llamav1alpha1.DistributionPhase   `json:"phase"`
+	Conditions []metav1.Condition                `json:"conditions,omitempty"`
+	Providers  []llamav1alpha1.ProviderInfo      `json:"providers,omitempty"`
+	Version    llamav1alpha1.VersionInfo         `json:"version"`
+	Replicas   int32                             `json:"availableReplicas"`
+	URLs       map[string]string                 `json:"urls,omitempty"`
+	References []llamav1alpha1.ExternalReference `json:"references,omitempty"`
+}
+
+// NewSnapshot builds a snapshot from the current status of the instance.
+func NewSnapshot(instance *llamav1alpha1.LlamaStackDistribution, urls map[string]string) Snapshot {
+	status := instance.Status
+	return Snapshot{
+		Type:       EventType,
+		Timestamp:  metav1.NewTime(time.Now().UTC()),
+		Name:       instance.Name,
+		Namespace:  instance.Namespace,
+		UID:        string(instance.UID),
+		Generation: instance.Generation,
+		Phase:      status.Phase,
+		Conditions: status.Conditions,
+		Providers:  status.DistributionConfig.Providers,
+		Version:    status.Version,
+		Replicas:   status.AvailableReplicas,
+		URLs:       urls,
+		References: status.References,
+	}
+}
+
+// IsSignificantTransition reports whether the status changed in a way external consumers care about:
+// the phase, the status or reason of a condition, the server version, or the health of a provider.
+// Timestamps and messages alone are not considered significant.
+func IsSignificantTransition(previous, current *llamav1alpha1.LlamaStackDistributionStatus) bool {
+	if previous.Phase != current.Phase {
+		return true
+	}
+	if previous.Version.LlamaStackServerVersion != current.Version.LlamaStackServerVersion {
+		return true
+	}
+	if len(previous.Conditions) != len(current.Conditions) {
+		return true
+	}
+	for _, condition := range current.Conditions {
+		old := findCondition(previous.Conditions, condition.Type)
+		if old == nil || old.Status != condition.Status || old.Reason != condition.Reason {
+			return true
+		}
+	}
+	return providerHealth(previous.DistributionConfig.Providers) != providerHealth(current.DistributionConfig.Providers)
+}
+
+func findCondition(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// providerHealth returns a comparable summary of the provider health states.
+func providerHealth(providers []llamav1alpha1.ProviderInfo) string {
+	var buf bytes.Buffer
+	for _, provider := range providers {
+		fmt.Fprintf(&buf, "%s/%s=%s;", provider.API, provider.ProviderID, provider.Health.Status)
+	}
+	return buf.String()
+}
+
+// Publisher publishes status snapshots to an external sink.
+type Publisher interface {
+	Publish(ctx context.Context, snapshot Snapshot) error
+}
+
+// HTTPPublisher posts snapshots as JSON to an HTTP webhook.
+type HTTPPublisher struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewHTTPPublisher creates a publisher for the sink described by config.
+func NewHTTPPublisher(config Config) (*HTTPPublisher, error) {
+	if config.URL == "" {
+		return nil, errors.New("failed to configure status export: url must be set")
+	}
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse status export url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("failed to configure status export: unsupported url scheme %q", u.Scheme)
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &HTTPPublisher{
+		url:        u.String(),
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Publish implements the Publisher interface.
+func (p *HTTPPublisher) Publish(ctx context.Context, snapshot Snapshot) error {
+	body, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal status snapshot: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create status export request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to publish status snapshot: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("failed to publish status snapshot: sink returned status %d", resp.StatusCode)
+	}
+	return nil
+}
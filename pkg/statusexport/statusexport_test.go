@@ -0,0 +1,186 @@
+package statusexport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	llamav1alpha1 "github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestInstance() *llamav1alpha1.LlamaStackDistribution {
+	return &llamav1alpha1.LlamaStackDistribution{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default", UID: "test-uid", Generation: 3},
+		Status: llamav1alpha1.LlamaStackDistributionStatus{
+			Phase:             llamav1alpha1.LlamaStackDistributionPhaseReady,
+			AvailableReplicas: 1,
+			Version:           llamav1alpha1.VersionInfo{LlamaStackServerVersion: "0.2.0"},
+			Conditions: []metav1.Condition{
+				{Type: "DeploymentReady", Status: metav1.ConditionTrue, Reason: "DeploymentReady"},
+			},
+			DistributionConfig: llamav1alpha1.DistributionConfig{
+				Providers: []llamav1alpha1.ProviderInfo{
+					{API: "inference", ProviderID: "ollama", Health: llamav1alpha1.ProviderHealthStatus{Status: "OK"}},
+				},
+			},
+		},
+	}
+}
+
+func TestHTTPPublisherPublish(t *testing.T) {
+	t.Run("posts the snapshot as JSON", func(t *testing.T) {
+		received := make(chan Snapshot, 1)
+		sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			var snapshot Snapshot
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&snapshot))
+			received <- snapshot
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer sink.Close()
+
+		publisher, err := NewHTTPPublisher(Config{URL: sink.URL})
+		require.NoError(t, err)
+
+		urls := map[string]string{"service": "http://test-instance-service.default.svc.cluster.local:8321"}
+		require.NoError(t, publisher.Publish(t.Context(), NewSnapshot(newTestInstance(), urls)))
+
+		snapshot := <-received
+		assert.Equal(t, EventType, snapshot.Type)
+		assert.Equal(t, "test-instance", snapshot.Name)
+		assert.Equal(t, "default", snapshot.Namespace)
+		assert.Equal(t, "test-uid", snapshot.UID)
+		assert.Equal(t, int64(3), snapshot.Generation)
+		assert.Equal(t, llamav1alpha1.LlamaStackDistributionPhaseReady, snapshot.Phase)
+		assert.Equal(t, "0.2.0", snapshot.Version.LlamaStackServerVersion)
+		assert.Equal(t, int32(1), snapshot.Replicas)
+		assert.Equal(t, urls, snapshot.URLs)
+		require.Len(t, snapshot.Conditions, 1)
+		require.Len(t, snapshot.Providers, 1)
+		assert.Equal(t, "ollama", snapshot.Providers[0].ProviderID)
+	})
+
+	t.Run("sink error status", func(t *testing.T) {
+		sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer sink.Close()
+
+		publisher, err := NewHTTPPublisher(Config{URL: sink.URL})
+		require.NoError(t, err)
+		err = publisher.Publish(t.Context(), NewSnapshot(newTestInstance(), nil))
+		require.ErrorContains(t, err, "503")
+	})
+
+	t.Run("unreachable sink", func(t *testing.T) {
+		sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		sinkURL := sink.URL
+		sink.Close()
+
+		publisher, err := NewHTTPPublisher(Config{URL: sinkURL, Timeout: time.Second})
+		require.NoError(t, err)
+		require.Error(t, publisher.Publish(t.Context(), NewSnapshot(newTestInstance(), nil)))
+	})
+}
+
+func TestNewHTTPPublisher(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      Config
+		expectError bool
+	}{
+		{name: "http url", config: Config{URL: "http://sink.example.com/events"}},
+		{name: "https url with timeout", config: Config{URL: "https://sink.example.com/events", Timeout: time.Second}},
+		{name: "missing url", config: Config{}, expectError: true},
+		{name: "unsupported scheme", config: Config{URL: "ftp://sink.example.com"}, expectError: true},
+		{name: "malformed url", config: Config{URL: "http://[::1"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			publisher, err := NewHTTPPublisher(tc.config)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			expectedTimeout := tc.config.Timeout
+			if expectedTimeout == 0 {
+				expectedTimeout = DefaultTimeout
+			}
+			assert.Equal(t, expectedTimeout, publisher.httpClient.Timeout)
+		})
+	}
+}
+
+func TestIsSignificantTransition(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mutate   func(status *llamav1alpha1.LlamaStackDistributionStatus)
+		expected bool
+	}{
+		{
+			name:     "unchanged",
+			mutate:   func(status *llamav1alpha1.LlamaStackDistributionStatus) {},
+			expected: false,
+		},
+		{
+			name: "timestamp and message only",
+			mutate: func(status *llamav1alpha1.LlamaStackDistributionStatus) {
+				status.Version.LastUpdated = metav1.Now()
+				status.Conditions[0].Message = "updated message"
+			},
+			expected: false,
+		},
+		{
+			name: "phase changed",
+			mutate: func(status *llamav1alpha1.LlamaStackDistributionStatus) {
+				status.Phase = llamav1alpha1.LlamaStackDistributionPhaseFailed
+			},
+			expected: true,
+		},
+		{
+			name: "condition status changed",
+			mutate: func(status *llamav1alpha1.LlamaStackDistributionStatus) {
+				status.Conditions[0].Status = metav1.ConditionFalse
+			},
+			expected: true,
+		},
+		{
+			name: "condition added",
+			mutate: func(status *llamav1alpha1.LlamaStackDistributionStatus) {
+				status.Conditions = append(status.Conditions, metav1.Condition{Type: "HealthCheck", Status: metav1.ConditionTrue})
+			},
+			expected: true,
+		},
+		{
+			name: "server version changed",
+			mutate: func(status *llamav1alpha1.LlamaStackDistributionStatus) {
+				status.Version.LlamaStackServerVersion = "0.3.0"
+			},
+			expected: true,
+		},
+		{
+			name: "provider health changed",
+			mutate: func(status *llamav1alpha1.LlamaStackDistributionStatus) {
+				status.DistributionConfig.Providers[0].Health.Status = "Error"
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			previous := newTestInstance().Status
+			current := newTestInstance().Status
+			tc.mutate(&current)
+			assert.Equal(t, tc.expected, IsSignificantTransition(&previous, &current))
+		})
+	}
+}
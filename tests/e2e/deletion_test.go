@@ -2,14 +2,19 @@
 package e2e
 
 import (
+	"context"
 	"testing"
 
 	"github.com/llamastack/llama-stack-k8s-operator/api/v1alpha1"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 func TestDeletionSuite(t *testing.T) {
@@ -68,5 +73,74 @@ func TestDeletionSuite(t *testing.T) {
 		for _, cm := range configMapList.Items {
 			require.NotEqual(t, instance.Name, cm.Labels["app"], "Found orphaned configmap")
 		}
+
+		// Verify no orphaned cluster-scoped resources
+		bindingList := &rbacv1.ClusterRoleBindingList{}
+		err = TestEnv.Client.List(TestEnv.Ctx, bindingList, client.MatchingLabels{
+			v1alpha1.InstanceNamespaceLabel: instance.Namespace,
+			v1alpha1.InstanceNameLabel:      instance.Name,
+		})
+		require.NoError(t, err)
+		require.Empty(t, bindingList.Items, "Found orphaned ClusterRoleBinding")
+	})
+	t.Run("should retain the PVC when requested by annotation", func(t *testing.T) {
+		testPVCDeletion(t, "llamastack-retain-pvc", true)
+	})
+
+	t.Run("should delete the PVC with the LlamaStackDistribution CR", func(t *testing.T) {
+		testPVCDeletion(t, "llamastack-delete-pvc", false)
+	})
+}
+
+// testPVCDeletion creates a LlamaStackDistribution with storage, deletes it and verifies that the PVC protection
+// finalizer retains or deletes the PVC depending on the retain annotation.
+func testPVCDeletion(t *testing.T, name string, retain bool) {
+	t.Helper()
+
+	instance := GetSampleCR(t)
+	instance.ObjectMeta = metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "llama-stack-test",
+	}
+	if retain {
+		instance.Annotations = map[string]string{v1alpha1.RetainPVCAnnotation: "true"}
+	}
+	instance.Spec.Server.Storage = &v1alpha1.StorageSpec{}
+	require.NoError(t, TestEnv.Client.Create(TestEnv.Ctx, instance))
+
+	pvcKey := client.ObjectKey{Name: instance.Name + "-pvc", Namespace: instance.Namespace}
+	err := wait.PollUntilContextTimeout(TestEnv.Ctx, pollInterval, ResourceReadyTimeout, true, func(ctx context.Context) (bool, error) {
+		if err := TestEnv.Client.Get(ctx, client.ObjectKeyFromObject(instance), instance); err != nil {
+			return false, nil //nolint:nilerr // keep polling until the CR is reconciled
+		}
+		return controllerutil.ContainsFinalizer(instance, v1alpha1.PVCProtectionFinalizer), nil
 	})
+	require.NoError(t, err, "PVC protection finalizer should be added")
+	err = EnsureResourceReady(t, TestEnv, schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"},
+		pvcKey.Name, pvcKey.Namespace, ResourceReadyTimeout, func(*unstructured.Unstructured) bool { return true })
+	require.NoError(t, err, "PVC should be created")
+
+	// Delete the instance
+	require.NoError(t, TestEnv.Client.Delete(TestEnv.Ctx, instance))
+	err = EnsureResourceDeleted(t, TestEnv, schema.GroupVersionKind{
+		Group:   "llamastack.io",
+		Version: "v1alpha1",
+		Kind:    "LlamaStackDistribution",
+	}, instance.Name, instance.Namespace, ResourceReadyTimeout)
+	require.NoError(t, err, "CR should be deleted")
+
+	if !retain {
+		err = EnsureResourceDeleted(t, TestEnv, schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"},
+			pvcKey.Name, pvcKey.Namespace, ResourceReadyTimeout)
+		require.NoError(t, err, "PVC should be deleted")
+		return
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	require.NoError(t, TestEnv.Client.Get(TestEnv.Ctx, pvcKey, pvc), "PVC should be retained")
+	require.Nil(t, pvc.DeletionTimestamp, "PVC should not be deleted")
+	require.Empty(t, pvc.OwnerReferences, "PVC should no longer be owned by the deleted CR")
+
+	// Clean up the retained PVC
+	require.NoError(t, TestEnv.Client.Delete(TestEnv.Ctx, pvc))
 }